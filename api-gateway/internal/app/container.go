@@ -13,6 +13,7 @@ import (
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/observability/metrics"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/observability/tracing"
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/session"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/swagger"
 )
 
@@ -44,11 +45,13 @@ func NewContainer() *Container {
 		logger.Fatal(context.Background(), "Metrics init error", logging.Error(err))
 	}
 	swaggerHandler := swagger.NewHandler(config, logger)
+	sessionChecker := session.NewChecker(microservice.USER_HTTP_ADDRESS)
 
 	router := router.Register(
 		logger, config,
 		metricsHandler,
 		swaggerHandler,
+		sessionChecker,
 		userMicroserviceHandler,
 		catalogMicroserviceHandler,
 		subscriptionMicroserviceHandler,
@@ -3,8 +3,9 @@ package user
 import "github.com/gin-gonic/gin"
 
 type User struct {
-	ID      uint64
-	IsAdmin bool
+	ID        uint64
+	IsAdmin   bool
+	SessionID uint64
 }
 
 const userKey = "user"
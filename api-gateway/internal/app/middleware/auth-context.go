@@ -1,12 +1,14 @@
 package middleware
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/jwt"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/observability/tracing"
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/session"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/errs"
@@ -16,7 +18,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func AuthContext(config *config.Config) gin.HandlerFunc {
+func AuthContext(config *config.Config, sessionChecker *session.Checker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.Request.Header.Get("Authorization")
 		if authHeader == "" {
@@ -44,6 +46,27 @@ func AuthContext(config *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// Tokens issued before sessions were tracked have no session ID and can't be revoked, so they are rejected
+		sessionID, err := strconv.ParseUint(claims.ID, 10, 64)
+		if err != nil {
+			err := errs.NewUnauthorizedError().WithCause(err)
+			tracing.Error(span, err)
+			httpInfrastructure.RenderError(c, err)
+			c.Abort()
+			return
+		}
+
+		if err := sessionChecker.Check(c.Request.Context(), sessionID, c.ClientIP(), c.Request.UserAgent()); err != nil {
+			var renderedErr error = errs.NewUnauthorizedError().WithCause(err)
+			if !errors.Is(err, session.ErrInactive) {
+				renderedErr = errs.NewInternalServerError().WithCause(err)
+			}
+			tracing.Error(span, renderedErr)
+			httpInfrastructure.RenderError(c, renderedErr)
+			c.Abort()
+			return
+		}
+
 		userID, _ := strconv.ParseUint(claims.Subject, 10, 64)
 		isAdmin := false
 		if len(claims.Audience) > 0 {
@@ -51,8 +74,9 @@ func AuthContext(config *config.Config) gin.HandlerFunc {
 		}
 
 		userContext.Set(c, userContext.User{
-			ID:      userID,
-			IsAdmin: isAdmin,
+			ID:        userID,
+			IsAdmin:   isAdmin,
+			SessionID: sessionID,
 		})
 
 		c.Next()
@@ -1,44 +1,192 @@
 package router
 
 import (
-	"github.com/Yarik7610/library-backend-common/transport/http/route"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/app/middleware"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/core"
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/transport/http/route"
 	"github.com/gin-gonic/gin"
 )
 
 func registerCatalogRoutes(r *gin.Engine, catalogMicroserviceHandler gin.HandlerFunc) {
-	catalogGroup := r.Group(route.CATALOG)
+	catalogGroup := r.Group(sharedRoute.CATALOG)
 	{
-		bookGroup := catalogGroup.Group(route.BOOKS)
+		bookGroup := catalogGroup.Group(sharedRoute.BOOKS)
 		{
-			bookGroup.GET(route.CATEGORIES, catalogMicroserviceHandler)
-			bookGroup.GET(route.CATEGORIES+"/:categoryName", catalogMicroserviceHandler)
-			bookGroup.GET("/:bookID"+route.PREVIEW, core.InjectHeaders(), catalogMicroserviceHandler)
-			bookGroup.GET("/:bookID", catalogMicroserviceHandler)
-			bookGroup.GET(route.SEARCH, catalogMicroserviceHandler)
-			bookGroup.GET(route.NEW, catalogMicroserviceHandler)
-			bookGroup.GET(route.POPULAR, catalogMicroserviceHandler)
-			bookGroup.GET("/:bookID"+route.VIEWS, catalogMicroserviceHandler)
+			bookGroup.GET(sharedRoute.CATEGORIES, catalogMicroserviceHandler)
+			bookGroup.GET(sharedRoute.CATEGORIES+"/:categoryName", catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+sharedRoute.PREVIEW, core.InjectHeaders(), catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID", core.InjectHeaders(), catalogMicroserviceHandler)
+			bookGroup.GET(sharedRoute.SEARCH, catalogMicroserviceHandler)
+			bookGroup.GET(sharedRoute.NEW, catalogMicroserviceHandler)
+			bookGroup.GET(sharedRoute.POPULAR, catalogMicroserviceHandler)
+			bookGroup.GET(route.TRENDING, catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+route.SIMILAR, catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+sharedRoute.VIEWS, catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+route.AVAILABILITY, catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+route.TAGS, catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+route.COVER, catalogMicroserviceHandler)
+			bookGroup.GET("/:bookID"+route.DOWNLOAD, catalogMicroserviceHandler)
 
 			adminGroup := bookGroup.Group("")
 			adminGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
 			{
 				adminGroup.DELETE("/:bookID", catalogMicroserviceHandler)
 				adminGroup.POST("", catalogMicroserviceHandler)
+				adminGroup.PUT("/:bookID"+route.INVENTORY, catalogMicroserviceHandler)
+				adminGroup.PUT("/:bookID"+route.CONTRIBUTORS, catalogMicroserviceHandler)
+				adminGroup.PUT("/:bookID"+route.METADATA, catalogMicroserviceHandler)
+				adminGroup.PUT("/:bookID"+route.COVER, catalogMicroserviceHandler)
+				adminGroup.DELETE("/:bookID"+route.COVER, catalogMicroserviceHandler)
+				adminGroup.PUT("/:bookID"+route.TAGS, catalogMicroserviceHandler)
+				adminGroup.GET("/:bookID"+route.HISTORY, catalogMicroserviceHandler)
+				adminGroup.POST("/:bookID"+route.HISTORY+"/:revisionID"+route.REVERT, catalogMicroserviceHandler)
+				adminGroup.GET(route.EXPORT, catalogMicroserviceHandler)
 			}
+
+			lendingGroup := bookGroup.Group("/:bookID")
+			lendingGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+			{
+				lendingGroup.POST(route.LOANS, catalogMicroserviceHandler)
+				lendingGroup.POST(route.HOLDS, catalogMicroserviceHandler)
+				lendingGroup.DELETE(route.HOLDS, catalogMicroserviceHandler)
+			}
+
+			annotationGroup := bookGroup.Group("/:bookID" + route.ANNOTATIONS)
+			annotationGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+			{
+				annotationGroup.GET("", catalogMicroserviceHandler)
+				annotationGroup.POST("", catalogMicroserviceHandler)
+				annotationGroup.PUT("/:annotationID", catalogMicroserviceHandler)
+				annotationGroup.DELETE("/:annotationID", catalogMicroserviceHandler)
+			}
+
+			reviewGroup := bookGroup.Group("/:bookID" + route.REVIEWS)
+			{
+				reviewGroup.GET("", catalogMicroserviceHandler)
+
+				privateGroup := reviewGroup.Group("")
+				privateGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+				{
+					privateGroup.POST("", catalogMicroserviceHandler)
+					privateGroup.PUT("/:reviewID", catalogMicroserviceHandler)
+					privateGroup.DELETE("/:reviewID", catalogMicroserviceHandler)
+				}
+			}
+		}
+
+		catalogGroup.GET(sharedRoute.SEARCH+route.SUGGEST, catalogMicroserviceHandler)
+
+		reviewGroup := catalogGroup.Group(route.REVIEWS)
+		{
+			adminGroup := reviewGroup.Group("")
+			adminGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
+			{
+				adminGroup.PUT("/:reviewID"+route.VISIBILITY, catalogMicroserviceHandler)
+				adminGroup.DELETE("/:reviewID", catalogMicroserviceHandler)
+			}
+		}
+
+		loanGroup := catalogGroup.Group(route.LOANS)
+		loanGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+		{
+			loanGroup.POST("/:loanID"+route.RETURN, catalogMicroserviceHandler)
+			loanGroup.POST("/:loanID"+route.RENEWAL, catalogMicroserviceHandler)
+		}
+
+		importGroup := catalogGroup.Group(route.IMPORTS)
+		importGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
+		{
+			importGroup.GET("", catalogMicroserviceHandler)
+			importGroup.POST("", catalogMicroserviceHandler)
+			importGroup.GET("/:importJobID", catalogMicroserviceHandler)
+		}
+
+		trashGroup := catalogGroup.Group(route.TRASH)
+		trashGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
+		{
+			trashGroup.GET(sharedRoute.BOOKS, catalogMicroserviceHandler)
+			trashGroup.GET(sharedRoute.AUTHORS, catalogMicroserviceHandler)
+			trashGroup.POST(sharedRoute.BOOKS+"/:bookID"+route.RESTORE, catalogMicroserviceHandler)
+			trashGroup.POST(sharedRoute.AUTHORS+"/:authorID"+route.RESTORE, catalogMicroserviceHandler)
 		}
 
-		authorGroup := catalogGroup.Group(route.AUTHORS)
+		analyticsGroup := catalogGroup.Group(route.ANALYTICS)
+		analyticsGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
 		{
-			authorGroup.GET("/:authorID"+route.BOOKS, catalogMicroserviceHandler)
+			analyticsGroup.GET(sharedRoute.BOOKS+"/:bookID", catalogMicroserviceHandler)
+			analyticsGroup.GET(sharedRoute.CATEGORIES+"/:categoryID", catalogMicroserviceHandler)
+		}
+
+		opdsGroup := catalogGroup.Group(route.OPDS)
+		{
+			opdsGroup.GET("", catalogMicroserviceHandler)
+			opdsGroup.GET(sharedRoute.CATEGORIES, catalogMicroserviceHandler)
+			opdsGroup.GET(sharedRoute.CATEGORIES+"/:categoryName", catalogMicroserviceHandler)
+			opdsGroup.GET(sharedRoute.NEW, catalogMicroserviceHandler)
+			opdsGroup.GET(sharedRoute.POPULAR, catalogMicroserviceHandler)
+		}
+
+		shelfGroup := catalogGroup.Group(route.SHELVES)
+		{
+			shelfGroup.GET(route.SHARED+"/:shareToken", catalogMicroserviceHandler)
+
+			privateGroup := shelfGroup.Group("")
+			privateGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+			{
+				privateGroup.GET("", catalogMicroserviceHandler)
+				privateGroup.POST("", catalogMicroserviceHandler)
+				privateGroup.GET("/:shelfID", catalogMicroserviceHandler)
+				privateGroup.PUT("/:shelfID", catalogMicroserviceHandler)
+				privateGroup.DELETE("/:shelfID", catalogMicroserviceHandler)
+				privateGroup.POST("/:shelfID"+sharedRoute.BOOKS, catalogMicroserviceHandler)
+				privateGroup.PUT("/:shelfID"+sharedRoute.BOOKS+route.ORDER, catalogMicroserviceHandler)
+				privateGroup.DELETE("/:shelfID"+sharedRoute.BOOKS+"/:bookID", catalogMicroserviceHandler)
+			}
+		}
+
+		categoryGroup := catalogGroup.Group(sharedRoute.CATEGORIES)
+		{
+			categoryGroup.GET("", catalogMicroserviceHandler)
+			categoryGroup.GET("/:categoryID", catalogMicroserviceHandler)
+
+			adminGroup := categoryGroup.Group("")
+			adminGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
+			{
+				adminGroup.POST("", catalogMicroserviceHandler)
+				adminGroup.PUT("/:categoryID", catalogMicroserviceHandler)
+				adminGroup.DELETE("/:categoryID", catalogMicroserviceHandler)
+			}
+		}
+
+		tagGroup := catalogGroup.Group(route.TAGS)
+		{
+			tagGroup.GET(sharedRoute.POPULAR, catalogMicroserviceHandler)
+			tagGroup.GET("/:tagName", catalogMicroserviceHandler)
+		}
+
+		authorGroup := catalogGroup.Group(sharedRoute.AUTHORS)
+		{
+			authorGroup.GET("", catalogMicroserviceHandler)
+			authorGroup.GET("/:authorID", catalogMicroserviceHandler)
+			authorGroup.GET("/:authorID"+sharedRoute.BOOKS, catalogMicroserviceHandler)
 
 			adminGroup := authorGroup.Group("")
 			adminGroup.Use(middleware.AuthRequired(), middleware.AdminRequired(), core.InjectHeaders())
 			{
+				adminGroup.PUT("/:authorID", catalogMicroserviceHandler)
 				adminGroup.DELETE("/:authorID", catalogMicroserviceHandler)
 				adminGroup.POST("", catalogMicroserviceHandler)
 			}
 		}
 	}
+
+	meGroup := r.Group(sharedRoute.ME)
+	meGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+	{
+		meGroup.GET(route.READING, catalogMicroserviceHandler)
+		meGroup.GET(route.LOANS, catalogMicroserviceHandler)
+		meGroup.GET(route.HOLDS, catalogMicroserviceHandler)
+		meGroup.GET(route.RECOMMENDATIONS, catalogMicroserviceHandler)
+	}
 }
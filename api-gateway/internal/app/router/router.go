@@ -7,6 +7,7 @@ import (
 	"github.com/Yarik7610/library-backend/api-gateway/internal/app/middleware"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/session"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/swagger"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
@@ -17,6 +18,7 @@ func Register(
 	config *config.Config,
 	metricsHandler http.Handler,
 	swaggerHandler swagger.Handler,
+	sessionChecker *session.Checker,
 	userMicroserviceHandler gin.HandlerFunc,
 	catalogMicroserviceHandler gin.HandlerFunc,
 	subscriptionMicroserviceHandler gin.HandlerFunc,
@@ -31,7 +33,7 @@ func Register(
 				path != "/swagger/*any"
 		}),
 	))
-	r.Use(middleware.AuthContext(config))
+	r.Use(middleware.AuthContext(config, sessionChecker))
 
 	r.GET(route.METRICS, gin.WrapH(metricsHandler))
 
@@ -1,21 +1,30 @@
 package router
 
 import (
-	"github.com/Yarik7610/library-backend-common/transport/http/route"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/app/middleware"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/core"
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/transport/http/route"
 	"github.com/gin-gonic/gin"
 )
 
 func registerSubscriptionRoutes(r *gin.Engine, subscriptionMicroserviceHandler gin.HandlerFunc) {
-	subscriptionGroup := r.Group(route.SUBSCRIPTIONS)
+	subscriptionGroup := r.Group(sharedRoute.SUBSCRIPTIONS)
 	{
-		bookCategoryGroup := subscriptionGroup.Group(route.BOOKS + route.CATEGORIES)
+		bookCategoryGroup := subscriptionGroup.Group(sharedRoute.BOOKS + sharedRoute.CATEGORIES)
 		bookCategoryGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
 		{
 			bookCategoryGroup.GET("", subscriptionMicroserviceHandler)
 			bookCategoryGroup.POST("", subscriptionMicroserviceHandler)
 			bookCategoryGroup.DELETE("/:categoryName", subscriptionMicroserviceHandler)
 		}
+
+		bookTagGroup := subscriptionGroup.Group(sharedRoute.BOOKS + route.TAGS)
+		bookTagGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
+		{
+			bookTagGroup.GET("", subscriptionMicroserviceHandler)
+			bookTagGroup.POST("", subscriptionMicroserviceHandler)
+			bookTagGroup.DELETE("/:tagName", subscriptionMicroserviceHandler)
+		}
 	}
 }
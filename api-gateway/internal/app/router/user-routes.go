@@ -1,22 +1,36 @@
 package router
 
 import (
-	"github.com/Yarik7610/library-backend-common/transport/http/route"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/app/middleware"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/core"
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/transport/http/route"
 	"github.com/gin-gonic/gin"
 )
 
 func registerUserRoutes(r *gin.Engine, userMicroserviceHandler gin.HandlerFunc) {
 	userGroup := r.Group("")
 	{
-		userGroup.POST(route.SIGN_UP, userMicroserviceHandler)
-		userGroup.POST(route.SIGN_IN, userMicroserviceHandler)
+		userGroup.POST(sharedRoute.SIGN_UP, userMicroserviceHandler)
+		userGroup.POST(sharedRoute.SIGN_IN, userMicroserviceHandler)
+		userGroup.GET(route.OIDC+route.LOGIN, userMicroserviceHandler)
+		userGroup.GET(route.OIDC+route.CALLBACK, userMicroserviceHandler)
+		userGroup.POST(route.SETUP, userMicroserviceHandler)
+		userGroup.POST(sharedRoute.SIGN_IN+route.PASSWORD, userMicroserviceHandler)
+		userGroup.POST(sharedRoute.SIGN_IN+route.TWO_FACTOR, userMicroserviceHandler)
+		userGroup.POST(sharedRoute.SIGN_IN+route.TWO_FACTOR+route.ENROLLMENT, userMicroserviceHandler)
 
 		privateGroup := userGroup.Group("")
 		privateGroup.Use(middleware.AuthRequired(), core.InjectHeaders())
 		{
-			privateGroup.GET(route.ME, userMicroserviceHandler)
+			privateGroup.GET(sharedRoute.ME, userMicroserviceHandler)
+			privateGroup.PUT(sharedRoute.ME+route.PASSWORD, userMicroserviceHandler)
+			privateGroup.GET(sharedRoute.ME+route.OIDC+route.LINK, userMicroserviceHandler)
+			privateGroup.POST(sharedRoute.ME+route.TWO_FACTOR+route.ENROLLMENT, userMicroserviceHandler)
+			privateGroup.POST(sharedRoute.ME+route.TWO_FACTOR+route.ENROLLMENT+route.CONFIRM, userMicroserviceHandler)
+			privateGroup.POST(sharedRoute.ME+route.TWO_FACTOR+route.DISABLE, userMicroserviceHandler)
+			privateGroup.GET(sharedRoute.ME+route.SESSIONS, userMicroserviceHandler)
+			privateGroup.DELETE(sharedRoute.ME+route.SESSIONS+"/:sessionID", userMicroserviceHandler)
 		}
 	}
 }
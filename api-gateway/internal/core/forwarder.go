@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/Yarik7610/library-backend-common/transport/http/header"
 	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/observability/logging"
+	clientHeader "github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/transport/http/header"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
@@ -46,6 +48,12 @@ func ForwardTo(logger *logging.Logger, target string) gin.HandlerFunc {
 		if ok {
 			req.Header.Set(header.IS_ADMIN, strconv.FormatBool(isAdmin))
 		}
+
+		// Clients can't pick their own address, it's always taken from the connection
+		req.Header.Del(clientHeader.CLIENT_IP)
+		if clientIP, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+			req.Header.Set(clientHeader.CLIENT_IP, clientIP)
+		}
 	}
 
 	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
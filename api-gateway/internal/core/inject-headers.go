@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	userContext "github.com/Yarik7610/library-backend/api-gateway/internal/app/context/user"
+	sessionHeader "github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/transport/http/header"
 )
 
 func InjectHeaders() gin.HandlerFunc {
@@ -15,6 +16,12 @@ func InjectHeaders() gin.HandlerFunc {
 		if ok {
 			c.Request.Header.Set(header.USER_ID, strconv.FormatUint(user.ID, 10))
 			c.Request.Header.Set(header.IS_ADMIN, strconv.FormatBool(user.IsAdmin))
+			c.Request.Header.Set(sessionHeader.SESSION_ID, strconv.FormatUint(user.SessionID, 10))
+		} else {
+			// Anonymous requests must not impersonate users on routes where authorization is optional
+			c.Request.Header.Del(header.USER_ID)
+			c.Request.Header.Del(header.IS_ADMIN)
+			c.Request.Header.Del(sessionHeader.SESSION_ID)
 		}
 		c.Next()
 	}
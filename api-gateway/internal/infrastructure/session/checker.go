@@ -0,0 +1,101 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Yarik7610/library-backend/api-gateway/internal/infrastructure/transport/http/route"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const activeSessionTTL = 15 * time.Second
+
+var ErrInactive = errors.New("session is revoked or expired")
+
+// Checker asks user-service whether a session is still active.
+// Positive answers are cached for a short time, so a revoked session stops working within activeSessionTTL
+type Checker struct {
+	userServiceURL string
+	httpClient     *http.Client
+
+	mu          sync.Mutex
+	activeUntil map[uint64]time.Time
+}
+
+func NewChecker(userServiceURL string) *Checker {
+	return &Checker{
+		userServiceURL: userServiceURL,
+		httpClient:     &http.Client{Timeout: 3 * time.Second},
+		activeUntil:    make(map[uint64]time.Time),
+	}
+}
+
+// Check returns ErrInactive for revoked or expired sessions and any other error when user-service can't answer
+func (c *Checker) Check(ctx context.Context, sessionID uint64, clientIP, userAgent string) error {
+	now := time.Now()
+	if c.isCached(sessionID, now) {
+		return nil
+	}
+
+	url := c.userServiceURL + route.INTERNAL + route.SESSIONS + "/" + strconv.FormatUint(sessionID, 10) + route.ACTIVITY
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Forwarded-For", clientIP)
+	req.Header.Set("User-Agent", userAgent)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		c.cache(sessionID, now)
+		return nil
+	case http.StatusNotFound:
+		c.forget(sessionID)
+		return ErrInactive
+	default:
+		return fmt.Errorf("unexpected status %d from session check", resp.StatusCode)
+	}
+}
+
+func (c *Checker) isCached(sessionID uint64, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	activeUntil, ok := c.activeUntil[sessionID]
+	return ok && now.Before(activeUntil)
+}
+
+func (c *Checker) cache(sessionID uint64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Drop stale entries from time to time, so the map doesn't grow with every session ever seen
+	if len(c.activeUntil) > 10000 {
+		for id, activeUntil := range c.activeUntil {
+			if now.After(activeUntil) {
+				delete(c.activeUntil, id)
+			}
+		}
+	}
+	c.activeUntil[sessionID] = now.Add(activeSessionTTL)
+}
+
+func (c *Checker) forget(sessionID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.activeUntil, sessionID)
+}
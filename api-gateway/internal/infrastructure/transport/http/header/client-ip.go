@@ -0,0 +1,4 @@
+package header
+
+// CLIENT_IP isn't shared through library-backend-common, catalog-service declares the same header
+const CLIENT_IP = "X-Client-IP"
@@ -0,0 +1,4 @@
+package header
+
+// SESSION_ID isn't shared through library-backend-common, user-service declares the same header
+const SESSION_ID = "X-Session-ID"
@@ -0,0 +1,55 @@
+package route
+
+// Routes which are specific to library-backend and aren't shared through library-backend-common
+const (
+	OIDC     = "/oidc"
+	LOGIN    = "/login"
+	CALLBACK = "/callback"
+	LINK     = "/link"
+
+	TWO_FACTOR = "/2fa"
+	ENROLLMENT = "/enrollment"
+	CONFIRM    = "/confirm"
+	DISABLE    = "/disable"
+
+	SETUP    = "/setup"
+	PASSWORD = "/password"
+
+	SESSIONS = "/sessions"
+	INTERNAL = "/internal"
+	ACTIVITY = "/activity"
+
+	READING      = "/reading"
+	CONTRIBUTORS = "/contributors"
+	METADATA     = "/metadata"
+	COVER        = "/cover"
+	IMPORTS      = "/imports"
+	ANNOTATIONS  = "/annotations"
+	REVIEWS      = "/reviews"
+	VISIBILITY   = "/visibility"
+	TAGS         = "/tags"
+	EXPORT       = "/export"
+	OPDS         = "/opds"
+	DOWNLOAD     = "/download"
+	TRASH        = "/trash"
+	RESTORE      = "/restore"
+	HISTORY      = "/history"
+	REVERT       = "/revert"
+	TRENDING     = "/trending"
+	ANALYTICS    = "/analytics"
+
+	SIMILAR         = "/similar"
+	RECOMMENDATIONS = "/recommendations"
+	SUGGEST         = "/suggest"
+
+	SHELVES = "/shelves"
+	SHARED  = "/shared"
+	ORDER   = "/order"
+
+	AVAILABILITY = "/availability"
+	INVENTORY    = "/inventory"
+	LOANS        = "/loans"
+	RETURN       = "/return"
+	RENEWAL      = "/renewal"
+	HOLDS        = "/holds"
+)
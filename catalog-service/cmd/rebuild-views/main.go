@@ -0,0 +1,43 @@
+// Command rebuild-views restores the popular ranking and the trending buckets in Redis from the views
+// persisted in Postgres. Run it after Redis lost its data, the catalog service may keep running meanwhile
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/redis"
+)
+
+func main() {
+	config, err := config.Parse()
+	if err != nil {
+		log.Fatalf("Config parse error: %v\n", err)
+	}
+
+	logger := logging.NewLogger(config.Env)
+
+	postgresDB, err := postgres.Connect(config)
+	if err != nil {
+		logger.Fatal(context.Background(), "Postgres connect error", logging.Error(err))
+	}
+
+	redisClient, err := redis.Connect(config)
+	if err != nil {
+		logger.Fatal(context.Background(), "Redis connect error", logging.Error(err))
+	}
+	defer redisClient.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	analyticsService := analytics.NewService(logger, postgresDB, redisClient)
+	if err := analyticsService.RebuildViews(ctx); err != nil {
+		logger.Fatal(ctx, "Views rebuild error", logging.Error(err))
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+const (
+	AnnotationKindBookmark  = "bookmark"
+	AnnotationKindHighlight = "highlight"
+	AnnotationKindNote      = "note"
+)
+
+type Annotation struct {
+	ID         uint
+	UserID     uint
+	BookID     uint
+	PageNumber uint
+	Kind       string
+	Range      *TextRange
+	Note       string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TextRange is a half-open [Start, End) range of characters on a page
+type TextRange struct {
+	Start uint
+	End   uint
+}
@@ -1,6 +1,11 @@
 package domain
 
 type Author struct {
-	ID       uint
-	Fullname string
+	ID          uint
+	Fullname    string
+	Biography   string
+	BirthYear   *int
+	DeathYear   *int
+	Nationality string
+	Aliases     []string
 }
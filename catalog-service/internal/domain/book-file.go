@@ -0,0 +1,12 @@
+package domain
+
+const (
+	BookFileFormatEPUB = "epub"
+	BookFileFormatPDF  = "pdf"
+)
+
+// BookCover is a cover image embedded into generated book files
+type BookCover struct {
+	Content     []byte
+	ContentType string
+}
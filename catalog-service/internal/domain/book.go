@@ -1,10 +1,38 @@
 package domain
 
+const (
+	ContributorRoleAuthor      = "author"
+	ContributorRoleCoAuthor    = "co-author"
+	ContributorRoleEditor      = "editor"
+	ContributorRoleTranslator  = "translator"
+	ContributorRoleIllustrator = "illustrator"
+)
+
 type Book struct {
-	ID       uint
+	ID            uint
+	Author        Author
+	Contributors  []Contributor
+	Title         string
+	Year          int
+	Category      string
+	CategoryID    uint
+	Metadata      BookMetadata
+	Pages         []Page
+	RatingAverage float64
+	RatingCount   uint
+}
+
+type BookMetadata struct {
+	ISBN        string
+	Publisher   string
+	Language    string
+	PageCount   uint
+	Description string
+	CoverKey    string
+}
+
+type Contributor struct {
 	Author   Author
-	Title    string
-	Year     int
-	Category string
-	Pages    []Page
+	Role     string
+	Position uint
 }
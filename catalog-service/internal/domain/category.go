@@ -0,0 +1,11 @@
+package domain
+
+type Category struct {
+	ID          uint
+	ParentID    *uint
+	Slug        string
+	Name        string
+	Description string
+	BooksCount  uint
+	Children    []Category
+}
@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+const (
+	ImportFormatEPUB = "epub"
+	ImportFormatText = "txt"
+	ImportFormatCSV  = "csv"
+)
+
+const (
+	ImportJobStatusQueued    = "queued"
+	ImportJobStatusRunning   = "running"
+	ImportJobStatusCompleted = "completed"
+	ImportJobStatusFailed    = "failed"
+)
+
+type ImportJob struct {
+	ID           uint
+	UserID       uint
+	Format       string
+	FileName     string
+	Defaults     ImportDefaults
+	Status       string
+	TotalRows    uint
+	ImportedRows uint
+	FailedRows   uint
+	Error        string
+	RowErrors    []ImportRowError
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+}
+
+// ImportDefaults fill book fields which the imported file doesn't have
+type ImportDefaults struct {
+	Author   string
+	Title    string
+	Category string
+	PageSize uint
+}
+
+// ImportRowError is a book which wasn't imported. Row is the CSV line, EPUB and text files have a single row
+type ImportRowError struct {
+	Row     uint
+	Message string
+}
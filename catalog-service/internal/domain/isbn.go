@@ -0,0 +1,69 @@
+package domain
+
+import "strings"
+
+// NormalizeISBN validates the checksum of an ISBN-10 or ISBN-13 and returns it as 13 digits,
+// so both forms of the same book are stored and searched as one
+func NormalizeISBN(isbn string) (string, bool) {
+	isbn = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isbn))
+
+	switch len(isbn) {
+	case 10:
+		if !isValidISBN10(isbn) {
+			return "", false
+		}
+		isbn13 := "978" + isbn[:9]
+		return isbn13 + string(rune('0'+isbn13CheckDigit(isbn13))), true
+	case 13:
+		if !isDigits(isbn) || (!strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979")) {
+			return "", false
+		}
+		if isbn13CheckDigit(isbn[:12]) != int(isbn[12]-'0') {
+			return "", false
+		}
+		return isbn, true
+	default:
+		return "", false
+	}
+}
+
+func isValidISBN10(isbn string) bool {
+	if !isDigits(isbn[:9]) {
+		return false
+	}
+
+	sum := 0
+	for i := range 9 {
+		sum += (10 - i) * int(isbn[i]-'0')
+	}
+	switch checkDigit := isbn[9]; {
+	case checkDigit == 'X':
+		sum += 10
+	case checkDigit >= '0' && checkDigit <= '9':
+		sum += int(checkDigit - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+func isbn13CheckDigit(first12Digits string) int {
+	sum := 0
+	for i := range 12 {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(first12Digits[i]-'0')
+	}
+	return (10 - sum%10) % 10
+}
+
+func isDigits(s string) bool {
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
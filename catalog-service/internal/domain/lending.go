@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+type BookAvailability struct {
+	BookID          uint
+	CopiesCount     uint
+	AvailableCount  uint
+	LoansCount      uint
+	HoldsCount      uint
+	ReadyHoldsCount uint
+}
+
+type Loan struct {
+	ID            uint
+	UserID        uint
+	BookID        uint
+	BorrowedAt    time.Time
+	DueAt         time.Time
+	RenewalsCount uint
+	ReturnedAt    *time.Time
+}
+
+type Hold struct {
+	ID        uint
+	UserID    uint
+	BookID    uint
+	Position  uint
+	ReadyAt   *time.Time
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
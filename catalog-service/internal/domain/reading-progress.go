@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+type ReadingProgress struct {
+	Book       Book
+	LastPage   uint
+	Percentage float64
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+}
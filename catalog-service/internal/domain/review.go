@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+type Review struct {
+	ID        uint
+	UserID    uint
+	BookID    uint
+	Rating    uint8
+	Text      string
+	Hidden    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	RevisionEntityBook   = "book"
+	RevisionEntityAuthor = "author"
+)
+
+const (
+	RevisionActionCreate  = "create"
+	RevisionActionUpdate  = "update"
+	RevisionActionDelete  = "delete"
+	RevisionActionRestore = "restore"
+	RevisionActionRevert  = "revert"
+)
+
+// Revision records a single catalog change, Before is empty for creations and After for deletions
+type Revision struct {
+	ID          uint
+	EntityType  string
+	EntityID    uint
+	Action      string
+	ActorUserID uint
+	Before      json.RawMessage
+	After       json.RawMessage
+	CreatedAt   time.Time
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	ShelfKindWantToRead = "want_to_read"
+	ShelfKindReading    = "reading"
+	ShelfKindFinished   = "finished"
+	ShelfKindCustom     = "custom"
+)
+
+type Shelf struct {
+	ID         uint
+	UserID     uint
+	Name       string
+	Kind       string
+	IsPublic   bool
+	ShareToken string
+	BooksCount uint
+	Books      []ShelfBook
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ShelfBook struct {
+	Book     Book
+	Position uint
+	AddedAt  time.Time
+}
@@ -0,0 +1,19 @@
+package domain
+
+const (
+	SuggestionTypeTitle  = "title"
+	SuggestionTypeAuthor = "author"
+)
+
+// Suggestion completes a search box query, EntityID is the book of a title or the author of a name
+type Suggestion struct {
+	Type     string
+	Text     string
+	EntityID uint
+}
+
+// SearchCorrection holds search parameters with misspelled author name or title replaced by the closest known one
+type SearchCorrection struct {
+	Author string
+	Title  string
+}
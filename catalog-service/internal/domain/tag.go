@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+type Tag struct {
+	ID         uint
+	Name       string
+	BooksCount uint
+}
+
+// NormalizeTagName lowercases the name and joins its words with dashes, so "Young Adult" becomes "young-adult"
+func NormalizeTagName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
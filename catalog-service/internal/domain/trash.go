@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+type TrashedBook struct {
+	Book      Book
+	DeletedAt time.Time
+	PurgeAt   time.Time
+}
+
+type TrashedAuthor struct {
+	Author    Author
+	DeletedAt time.Time
+	PurgeAt   time.Time
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+const (
+	TrendingWindowDay   = "day"
+	TrendingWindowWeek  = "week"
+	TrendingWindowMonth = "month"
+)
+
+// Viewer identifies who viewed a book, anonymous viewers are told apart by a session fingerprint
+// and rate limited by their client address
+type Viewer struct {
+	UserID      uint
+	Fingerprint string
+	ClientIP    string
+}
+
+type DailyViews struct {
+	Day           time.Time
+	UniqueViewers int64
+}
+
+type BookViewStats struct {
+	BookID        uint
+	UniqueViewers int64
+	Daily         []DailyViews
+}
+
+type CategoryViewStats struct {
+	CategoryID uint
+	Daily      []DailyViews
+}
+
+// TrendingWindowDays returns how many daily buckets the window spans
+func TrendingWindowDays(window string) int {
+	switch window {
+	case TrendingWindowDay:
+		return 1
+	case TrendingWindowMonth:
+		return 30
+	default:
+		return 7
+	}
+}
@@ -0,0 +1,41 @@
+package analytics
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/transport/http"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogRedis "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// Register wires view analytics into the HTTP router of the catalog feature.
+// The returned job persists views from Redis and has to be run by the container
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	redisClient *redis.Client,
+	httpRouter *gin.Engine,
+) job.Job {
+	analyticsService := NewService(logger, postgresDB, redisClient)
+
+	httpAnalyticsHandler := httpTransport.NewAnalyticsHandler(config, logger, analyticsService)
+	httpTransport.RegisterRoutes(httpRouter, httpAnalyticsHandler)
+
+	return job.NewJob(config, logger, analyticsService)
+}
+
+// NewService is shared with the views rebuild command, which runs without the HTTP router
+func NewService(logger *logging.Logger, postgresDB *gorm.DB, redisClient *redis.Client) service.AnalyticsService {
+	redisBookRepository := catalogRedis.NewBookRepository(redisClient)
+	postgresCategoryRepository := catalogPostgres.NewCategoryRepository(postgresDB)
+	postgresBookViewsRepository := postgres.NewBookViewsRepository(postgresDB)
+
+	return service.NewAnalyticsService(logger, redisBookRepository, postgresCategoryRepository, postgresBookViewsRepository)
+}
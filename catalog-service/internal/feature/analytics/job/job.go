@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+)
+
+// Job periodically persists book views from Redis to Postgres
+type Job interface {
+	Run(ctx context.Context)
+}
+
+type job struct {
+	config           *config.Config
+	logger           *logging.Logger
+	analyticsService service.AnalyticsService
+}
+
+func NewJob(config *config.Config, logger *logging.Logger, analyticsService service.AnalyticsService) Job {
+	return &job{
+		config:           config,
+		logger:           logger,
+		analyticsService: analyticsService,
+	}
+}
+
+// Run blocks until ctx is cancelled. The first pass starts right away to catch up after a restart
+func (j *job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.config.ViewRollupJobInterval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *job) runOnce(ctx context.Context) {
+	ctx, span := tracing.Span(ctx, j.config.ServiceName, "job.ViewRollup")
+	defer span.End()
+
+	if err := j.analyticsService.RollupViews(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		tracing.Error(span, err)
+		j.logger.Error(ctx, "Views rollup error", logging.Error(err))
+	}
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/repository/postgres/model"
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookViewsRepository interface {
+	SaveTotals(ctx context.Context, bookViews []model.BookViews) error
+	SaveDaily(ctx context.Context, bookDailyViews []model.BookDailyViews) error
+	FindTotalByBookID(ctx context.Context, bookID uint) (int64, error)
+	ListTotals(ctx context.Context) ([]model.BookViews, error)
+	ListDailySince(ctx context.Context, since time.Time) ([]model.BookDailyViewsWithCategory, error)
+	ListDailyByBookID(ctx context.Context, bookID uint, from, to time.Time) ([]model.DailyViews, error)
+	ListDailyByCategoryIDs(ctx context.Context, categoryIDs []uint, from, to time.Time) ([]model.DailyViews, error)
+	ListExistingBookIDs(ctx context.Context, bookIDs []uint) ([]uint, error)
+}
+
+type bookViewsRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewBookViewsRepository(db *gorm.DB) BookViewsRepository {
+	return &bookViewsRepository{name: "Book views", timeout: 1 * time.Second, db: db}
+}
+
+// SaveTotals never lowers stored counts, Redis starts from zero after it loses its data
+func (r *bookViewsRepository) SaveTotals(ctx context.Context, bookViews []model.BookViews) error {
+	if len(bookViews) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Omit("Book").
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "book_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"unique_viewers": gorm.Expr("GREATEST(book_views.unique_viewers, excluded.unique_viewers)"),
+				"updated_at":     gorm.Expr("excluded.updated_at"),
+			}),
+		}).
+		Create(&bookViews).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// SaveDaily never lowers stored counts, Redis starts from zero after it loses its data
+func (r *bookViewsRepository) SaveDaily(ctx context.Context, bookDailyViews []model.BookDailyViews) error {
+	if len(bookDailyViews) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Omit("Book").
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "book_id"}, {Name: "day"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"unique_viewers": gorm.Expr("GREATEST(book_daily_views.unique_viewers, excluded.unique_viewers)"),
+			}),
+		}).
+		Create(&bookDailyViews).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *bookViewsRepository) FindTotalByBookID(ctx context.Context, bookID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var uniqueViewers []int64
+	err := r.db.WithContext(ctx).
+		Model(&model.BookViews{}).
+		Where("book_id = ?", bookID).
+		Pluck("unique_viewers", &uniqueViewers).Error
+	if err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	if len(uniqueViewers) == 0 {
+		return 0, nil
+	}
+	return uniqueViewers[0], nil
+}
+
+// ListTotals skips trashed books, they aren't ranked until restored
+func (r *bookViewsRepository) ListTotals(ctx context.Context) ([]model.BookViews, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookViews []model.BookViews
+	err := r.db.WithContext(ctx).
+		Model(&model.BookViews{}).
+		Joins("JOIN books ON books.id = book_views.book_id AND books.deleted_at IS NULL").
+		Find(&bookViews).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookViews, nil
+}
+
+// ListDailySince skips trashed books, they aren't ranked until restored
+func (r *bookViewsRepository) ListDailySince(ctx context.Context, since time.Time) ([]model.BookDailyViewsWithCategory, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookDailyViews []model.BookDailyViewsWithCategory
+	err := r.db.WithContext(ctx).
+		Model(&model.BookDailyViews{}).
+		Select("book_daily_views.book_id, books.category_id, book_daily_views.day, book_daily_views.unique_viewers").
+		Joins("JOIN books ON books.id = book_daily_views.book_id AND books.deleted_at IS NULL").
+		Where("book_daily_views.day >= ?", since).
+		Order("book_daily_views.day").
+		Scan(&bookDailyViews).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookDailyViews, nil
+}
+
+func (r *bookViewsRepository) ListDailyByBookID(ctx context.Context, bookID uint, from, to time.Time) ([]model.DailyViews, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var dailyViews []model.DailyViews
+	err := r.db.WithContext(ctx).
+		Model(&model.BookDailyViews{}).
+		Select("day, unique_viewers").
+		Where("book_id = ? AND day BETWEEN ? AND ?", bookID, from, to).
+		Order("day").
+		Scan(&dailyViews).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return dailyViews, nil
+}
+
+// ListDailyByCategoryIDs sums views of books in the categories, trashed books viewed back then included
+func (r *bookViewsRepository) ListDailyByCategoryIDs(ctx context.Context, categoryIDs []uint, from, to time.Time) ([]model.DailyViews, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var dailyViews []model.DailyViews
+	err := r.db.WithContext(ctx).
+		Model(&model.BookDailyViews{}).
+		Select("book_daily_views.day, SUM(book_daily_views.unique_viewers) AS unique_viewers").
+		Joins("JOIN books ON books.id = book_daily_views.book_id").
+		Where("books.category_id IN ? AND book_daily_views.day BETWEEN ? AND ?", categoryIDs, from, to).
+		Group("book_daily_views.day").
+		Order("book_daily_views.day").
+		Scan(&dailyViews).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return dailyViews, nil
+}
+
+// ListExistingBookIDs keeps trashed books, only purged ones are gone
+func (r *bookViewsRepository) ListExistingBookIDs(ctx context.Context, bookIDs []uint) ([]uint, error) {
+	if len(bookIDs) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var existingBookIDs []uint
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&catalogModel.Book{}).
+		Where("id IN ?", bookIDs).
+		Pluck("id", &existingBookIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return existingBookIDs, nil
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+// BookViews is the last known all-time unique viewers count of a book
+type BookViews struct {
+	BookID        uint  `gorm:"primaryKey;autoIncrement:false"`
+	UniqueViewers int64 `gorm:"not null"`
+	UpdatedAt     time.Time
+	Book          catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// BookDailyViews is the unique viewers count of a book within a UTC day
+type BookDailyViews struct {
+	BookID        uint              `gorm:"primaryKey;autoIncrement:false"`
+	Day           time.Time         `gorm:"primaryKey;type:date;index"`
+	UniqueViewers int64             `gorm:"not null"`
+	Book          catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type BookDailyViewsWithCategory struct {
+	BookID        uint
+	CategoryID    *uint
+	Day           time.Time
+	UniqueViewers int64
+}
+
+type DailyViews struct {
+	Day           time.Time
+	UniqueViewers int64
+}
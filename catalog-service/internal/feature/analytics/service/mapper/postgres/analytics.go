@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/repository/postgres/model"
+	catalogRedisModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis/model"
+)
+
+func RedisBookViewsModelsToDailyModels(redisViewsModels []catalogRedisModel.BookViews, day time.Time) []model.BookDailyViews {
+	bookDailyViewsModels := make([]model.BookDailyViews, len(redisViewsModels))
+	for i := range redisViewsModels {
+		bookDailyViewsModels[i] = model.BookDailyViews{
+			BookID:        redisViewsModels[i].BookID,
+			Day:           day,
+			UniqueViewers: redisViewsModels[i].UniqueViewers,
+		}
+	}
+	return bookDailyViewsModels
+}
+
+func RedisBookViewsModelsToTotalModels(redisViewsModels []catalogRedisModel.BookViews) []model.BookViews {
+	bookViewsModels := make([]model.BookViews, len(redisViewsModels))
+	for i := range redisViewsModels {
+		bookViewsModels[i] = model.BookViews{
+			BookID:        redisViewsModels[i].BookID,
+			UniqueViewers: redisViewsModels[i].UniqueViewers,
+		}
+	}
+	return bookViewsModels
+}
+
+func BookViewsModelsToRedisModels(bookViewsModels []model.BookViews) []catalogRedisModel.BookViews {
+	redisViewsModels := make([]catalogRedisModel.BookViews, len(bookViewsModels))
+	for i := range bookViewsModels {
+		redisViewsModels[i] = catalogRedisModel.BookViews{
+			BookID:        bookViewsModels[i].BookID,
+			UniqueViewers: bookViewsModels[i].UniqueViewers,
+		}
+	}
+	return redisViewsModels
+}
+
+func BookDailyViewsModelToRedisModel(bookDailyViewsModel *model.BookDailyViewsWithCategory) catalogRedisModel.BookDailyViews {
+	redisDailyViewsModel := catalogRedisModel.BookDailyViews{
+		BookID:        bookDailyViewsModel.BookID,
+		UniqueViewers: bookDailyViewsModel.UniqueViewers,
+	}
+	if bookDailyViewsModel.CategoryID != nil {
+		redisDailyViewsModel.CategoryID = *bookDailyViewsModel.CategoryID
+	}
+	return redisDailyViewsModel
+}
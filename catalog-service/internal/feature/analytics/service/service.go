@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/service/mapper/postgres"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogRedis "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	catalogRedisModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis/model"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+)
+
+const (
+	BOOK_ENTITY_NAME = "Book(s)"
+
+	// Yesterday is rolled up once more after midnight, so its last views aren't lost
+	ROLLUP_DAYS = 2
+
+	DEFAULT_ANALYTICS_DAYS = 30
+	MAX_ANALYTICS_DAYS     = 366
+)
+
+type AnalyticsService interface {
+	RollupViews(ctx context.Context) error
+	RebuildViews(ctx context.Context) error
+	GetBookViews(ctx context.Context, bookID uint, from, to time.Time) (*domain.BookViewStats, error)
+	GetCategoryViews(ctx context.Context, categoryID uint, from, to time.Time) (*domain.CategoryViewStats, error)
+}
+
+type analyticsService struct {
+	logger                      *logging.Logger
+	redisBookRepository         catalogRedis.BookRepository
+	postgresCategoryRepository  catalogPostgres.CategoryRepository
+	postgresBookViewsRepository postgres.BookViewsRepository
+}
+
+func NewAnalyticsService(
+	logger *logging.Logger,
+	redisBookRepository catalogRedis.BookRepository,
+	postgresCategoryRepository catalogPostgres.CategoryRepository,
+	postgresBookViewsRepository postgres.BookViewsRepository,
+) AnalyticsService {
+	return &analyticsService{
+		logger:                      logger,
+		redisBookRepository:         redisBookRepository,
+		postgresCategoryRepository:  postgresCategoryRepository,
+		postgresBookViewsRepository: postgresBookViewsRepository,
+	}
+}
+
+// RollupViews persists unique viewers of the recent days and all-time counts of the books viewed on them
+func (s *analyticsService) RollupViews(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	var viewedBookIDs []uint
+	for dayOffset := range ROLLUP_DAYS {
+		day := today.AddDate(0, 0, -dayOffset)
+
+		redisDailyViewsModels, err := s.redisBookRepository.GetDailyViews(ctx, day)
+		if err != nil {
+			return err
+		}
+		redisDailyViewsModels, err = s.withoutPurgedBooks(ctx, redisDailyViewsModels)
+		if err != nil {
+			return err
+		}
+
+		if err := s.postgresBookViewsRepository.SaveDaily(ctx, postgresMapper.RedisBookViewsModelsToDailyModels(redisDailyViewsModels, day)); err != nil {
+			return err
+		}
+		for i := range redisDailyViewsModels {
+			if !slices.Contains(viewedBookIDs, redisDailyViewsModels[i].BookID) {
+				viewedBookIDs = append(viewedBookIDs, redisDailyViewsModels[i].BookID)
+			}
+		}
+	}
+
+	redisViewsModels, err := s.redisBookRepository.GetViews(ctx, viewedBookIDs)
+	if err != nil {
+		return err
+	}
+	return s.postgresBookViewsRepository.SaveTotals(ctx, postgresMapper.RedisBookViewsModelsToTotalModels(redisViewsModels))
+}
+
+// RebuildViews restores the popular ranking and the trending buckets from Postgres after Redis lost its data.
+// Unique viewer sets can't be restored, so viewers coming back are counted once more
+func (s *analyticsService) RebuildViews(ctx context.Context) error {
+	postgresBookViewsModels, err := s.postgresBookViewsRepository.ListTotals(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.redisBookRepository.RestorePopularViews(ctx, postgresMapper.BookViewsModelsToRedisModels(postgresBookViewsModels)); err != nil {
+		return err
+	}
+
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -domain.TrendingWindowDays(domain.TrendingWindowMonth))
+	postgresBookDailyViewsModels, err := s.postgresBookViewsRepository.ListDailySince(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	redisDailyViewsModelsByDay := make(map[time.Time][]catalogRedisModel.BookDailyViews)
+	for i := range postgresBookDailyViewsModels {
+		day := postgresBookDailyViewsModels[i].Day.UTC()
+		redisDailyViewsModelsByDay[day] = append(redisDailyViewsModelsByDay[day], postgresMapper.BookDailyViewsModelToRedisModel(&postgresBookDailyViewsModels[i]))
+	}
+	for day, redisDailyViewsModels := range redisDailyViewsModelsByDay {
+		if err := s.redisBookRepository.RestoreDailyViews(ctx, day, redisDailyViewsModels); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info(ctx, "Views rebuilt",
+		logging.Int("books", len(postgresBookViewsModels)),
+		logging.Int("days", len(redisDailyViewsModelsByDay)),
+	)
+	return nil
+}
+
+// GetBookViews works for trashed books too, their history is kept until the purge
+func (s *analyticsService) GetBookViews(ctx context.Context, bookID uint, from, to time.Time) (*domain.BookViewStats, error) {
+	from, to, err := normalizeRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	existingBookIDs, err := s.postgresBookViewsRepository.ListExistingBookIDs(ctx, []uint{bookID})
+	if err != nil {
+		return nil, err
+	}
+	if len(existingBookIDs) == 0 {
+		return nil, errs.NewEntityNotFoundError(BOOK_ENTITY_NAME)
+	}
+
+	uniqueViewers, err := s.postgresBookViewsRepository.FindTotalByBookID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	dailyViewsModels, err := s.postgresBookViewsRepository.ListDailyByBookID(ctx, bookID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.BookViewStats{
+		BookID:        bookID,
+		UniqueViewers: uniqueViewers,
+		Daily:         fillDailyViews(from, to, dailyViewsModels),
+	}, nil
+}
+
+// GetCategoryViews sums views of the category and all its subcategories
+func (s *analyticsService) GetCategoryViews(ctx context.Context, categoryID uint, from, to time.Time) (*domain.CategoryViewStats, error) {
+	from, to, err := normalizeRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.postgresCategoryRepository.FindByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+	categoryIDs, err := s.postgresCategoryRepository.ListSubtreeIDs(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyViewsModels, err := s.postgresBookViewsRepository.ListDailyByCategoryIDs(ctx, categoryIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CategoryViewStats{
+		CategoryID: categoryID,
+		Daily:      fillDailyViews(from, to, dailyViewsModels),
+	}, nil
+}
+
+// withoutPurgedBooks drops books which are still ranked in Redis but are gone from Postgres
+func (s *analyticsService) withoutPurgedBooks(ctx context.Context, redisViewsModels []catalogRedisModel.BookViews) ([]catalogRedisModel.BookViews, error) {
+	bookIDs := make([]uint, len(redisViewsModels))
+	for i := range redisViewsModels {
+		bookIDs[i] = redisViewsModels[i].BookID
+	}
+
+	existingBookIDs, err := s.postgresBookViewsRepository.ListExistingBookIDs(ctx, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(redisViewsModels, func(redisViewsModel catalogRedisModel.BookViews) bool {
+		return !slices.Contains(existingBookIDs, redisViewsModel.BookID)
+	}), nil
+}
+
+// normalizeRange defaults to the last DEFAULT_ANALYTICS_DAYS days ending today
+func normalizeRange(from, to time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	to = to.UTC().Truncate(24 * time.Hour)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(DEFAULT_ANALYTICS_DAYS - 1))
+	}
+	from = from.UTC().Truncate(24 * time.Hour)
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errs.NewBadRequestError("Range start must not be after its end")
+	}
+	if to.Sub(from) >= MAX_ANALYTICS_DAYS*24*time.Hour {
+		return time.Time{}, time.Time{}, errs.NewBadRequestError("Range must not be longer than a year")
+	}
+	return from, to, nil
+}
+
+// fillDailyViews adds days without views, so the series has a point for every day of the range
+func fillDailyViews(from, to time.Time, dailyViewsModels []model.DailyViews) []domain.DailyViews {
+	uniqueViewersByDay := make(map[string]int64, len(dailyViewsModels))
+	for i := range dailyViewsModels {
+		uniqueViewersByDay[dailyViewsModels[i].Day.UTC().Format(time.DateOnly)] = dailyViewsModels[i].UniqueViewers
+	}
+
+	dailyViewsDomains := make([]domain.DailyViews, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dailyViewsDomains = append(dailyViewsDomains, domain.DailyViews{Day: day, UniqueViewers: uniqueViewersByDay[day.Format(time.DateOnly)]})
+	}
+	return dailyViewsDomains
+}
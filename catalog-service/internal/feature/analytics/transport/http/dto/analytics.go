@@ -0,0 +1,17 @@
+package dto
+
+type DailyViews struct {
+	Day           string `json:"day" example:"2025-01-31"`
+	UniqueViewers int64  `json:"uniqueViewers"`
+}
+
+type BookViewStats struct {
+	BookID        uint         `json:"bookId"`
+	UniqueViewers int64        `json:"uniqueViewers"`
+	Daily         []DailyViews `json:"daily"`
+}
+
+type CategoryViewStats struct {
+	CategoryID uint         `json:"categoryId"`
+	Daily      []DailyViews `json:"daily"`
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+type AnalyticsHandler interface {
+	GetBookViews(c *gin.Context)
+	GetCategoryViews(c *gin.Context)
+}
+
+type analyticsHandler struct {
+	config           *config.Config
+	logger           *logging.Logger
+	analyticsService service.AnalyticsService
+}
+
+func NewAnalyticsHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	analyticsService service.AnalyticsService,
+) AnalyticsHandler {
+	return &analyticsHandler{
+		config:           config,
+		logger:           logger,
+		analyticsService: analyticsService,
+	}
+}
+
+// GetBookViews godoc
+//
+//	@Summary		Get book views
+//	@Description	Returns all-time unique viewers of a book and its unique viewers per UTC day, persisted from Redis periodically
+//	@Tags			analytics
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Param			from	query	string	false	"First day of the range (YYYY-MM-DD, default=29 days before the end)"
+//	@Param			to		query	string	false	"Last day of the range (YYYY-MM-DD, default=today), the range spans at most a year"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.BookViewStats
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/analytics/books/{bookID} [get]
+func (h *analyticsHandler) GetBookViews(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.GetViews
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetBookViews")
+	defer span.End()
+
+	bookViewStatsDomain, err := h.analyticsService.GetBookViews(ctx, uint(bookID), query.From, query.To)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get book views error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookViewStatsDomainToDTO(bookViewStatsDomain))
+}
+
+// GetCategoryViews godoc
+//
+//	@Summary		Get category views
+//	@Description	Returns unique viewers per UTC day summed over books of a category and all its subcategories
+//	@Tags			analytics
+//	@Param			categoryID	path	uint	true	"Category ID"
+//	@Param			from		query	string	false	"First day of the range (YYYY-MM-DD, default=29 days before the end)"
+//	@Param			to			query	string	false	"Last day of the range (YYYY-MM-DD, default=today), the range spans at most a year"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.CategoryViewStats
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/analytics/categories/{categoryID} [get]
+func (h *analyticsHandler) GetCategoryViews(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	categoryIDString := c.Param("categoryID")
+	categoryID, err := strconv.ParseUint(categoryIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.GetViews
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetCategoryViews")
+	defer span.End()
+
+	categoryViewStatsDomain, err := h.analyticsService.GetCategoryViews(ctx, uint(categoryID), query.From, query.To)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get category views error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.CategoryViewStatsDomainToDTO(categoryViewStatsDomain))
+}
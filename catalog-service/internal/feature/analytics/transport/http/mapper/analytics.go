@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/transport/http/dto"
+)
+
+func BookViewStatsDomainToDTO(bookViewStatsDomain *domain.BookViewStats) dto.BookViewStats {
+	return dto.BookViewStats{
+		BookID:        bookViewStatsDomain.BookID,
+		UniqueViewers: bookViewStatsDomain.UniqueViewers,
+		Daily:         dailyViewsDomainsToDTOs(bookViewStatsDomain.Daily),
+	}
+}
+
+func CategoryViewStatsDomainToDTO(categoryViewStatsDomain *domain.CategoryViewStats) dto.CategoryViewStats {
+	return dto.CategoryViewStats{
+		CategoryID: categoryViewStatsDomain.CategoryID,
+		Daily:      dailyViewsDomainsToDTOs(categoryViewStatsDomain.Daily),
+	}
+}
+
+func dailyViewsDomainsToDTOs(dailyViewsDomains []domain.DailyViews) []dto.DailyViews {
+	dailyViewsDTOs := make([]dto.DailyViews, len(dailyViewsDomains))
+	for i := range dailyViewsDomains {
+		dailyViewsDTOs[i] = dto.DailyViews{
+			Day:           dailyViewsDomains[i].Day.Format(time.DateOnly),
+			UniqueViewers: dailyViewsDomains[i].UniqueViewers,
+		}
+	}
+	return dailyViewsDTOs
+}
@@ -0,0 +1,8 @@
+package query
+
+import "time"
+
+type GetViews struct {
+	From time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To   time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts analytics routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, analyticsHandler AnalyticsHandler) {
+	analyticsGroup := r.Group(sharedRoute.CATALOG + route.ANALYTICS)
+	{
+		analyticsGroup.GET(sharedRoute.BOOKS+"/:bookID", analyticsHandler.GetBookViews)
+		analyticsGroup.GET(sharedRoute.CATEGORIES+"/:categoryID", analyticsHandler.GetCategoryViews)
+	}
+}
@@ -0,0 +1,24 @@
+package annotation
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/transport/http"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires the annotation feature into the HTTP router of the catalog feature,
+// so both are served by the same server
+func Register(config *config.Config, logger *logging.Logger, postgresDB *gorm.DB, httpRouter *gin.Engine) {
+	postgresPageRepository := catalogPostgres.NewPageRepository(postgresDB)
+	postgresAnnotationRepository := postgres.NewAnnotationRepository(postgresDB)
+
+	annotationService := service.NewAnnotationService(postgresPageRepository, postgresAnnotationRepository)
+
+	httpAnnotationHandler := httpTransport.NewAnnotationHandler(config, logger, annotationService)
+	httpTransport.RegisterRoutes(httpRouter, httpAnnotationHandler)
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type AnnotationRepository interface {
+	Create(ctx context.Context, annotation *model.Annotation) error
+	FindByID(ctx context.Context, userID, bookID, annotationID uint) (*model.Annotation, error)
+	ListByUserIDAndBookID(ctx context.Context, userID, bookID, pageNumber uint) ([]model.Annotation, error)
+	Update(ctx context.Context, annotation *model.Annotation) error
+	Delete(ctx context.Context, userID, bookID, annotationID uint) error
+}
+
+type annotationRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewAnnotationRepository(db *gorm.DB) AnnotationRepository {
+	return &annotationRepository{name: "Annotation(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *annotationRepository) Create(ctx context.Context, annotation *model.Annotation) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("Book").Create(annotation).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *annotationRepository) FindByID(ctx context.Context, userID, bookID, annotationID uint) (*model.Annotation, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var annotation model.Annotation
+	err := r.db.WithContext(ctx).
+		Where("id = ?", annotationID).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID).
+		First(&annotation).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &annotation, nil
+}
+
+// ListByUserIDAndBookID returns annotations of the whole book when pageNumber is 0
+func (r *annotationRepository) ListByUserIDAndBookID(ctx context.Context, userID, bookID, pageNumber uint) ([]model.Annotation, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID)
+	if pageNumber > 0 {
+		query = query.Where("page_number = ?", pageNumber)
+	}
+
+	var annotations []model.Annotation
+	if err := query.Order("page_number, range_start NULLS FIRST, id").Find(&annotations).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return annotations, nil
+}
+
+func (r *annotationRepository) Update(ctx context.Context, annotation *model.Annotation) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(annotation).
+		Select("range_start", "range_end", "note", "updated_at").
+		Updates(annotation).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *annotationRepository) Delete(ctx context.Context, userID, bookID, annotationID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID).
+		Delete(&model.Annotation{}, annotationID)
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+type Annotation struct {
+	ID         uint `gorm:"primarykey"`
+	UserID     uint `gorm:"index:user_id_book_id_index"`
+	BookID     uint `gorm:"index:user_id_book_id_index"`
+	PageNumber uint
+	Kind       string
+	RangeStart *uint
+	RangeEnd   *uint
+	Note       string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Book       catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
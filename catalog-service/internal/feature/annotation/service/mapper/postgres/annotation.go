@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/repository/postgres/model"
+)
+
+func AnnotationModelToDomain(annotationModel *model.Annotation) domain.Annotation {
+	annotationDomain := domain.Annotation{
+		ID:         annotationModel.ID,
+		UserID:     annotationModel.UserID,
+		BookID:     annotationModel.BookID,
+		PageNumber: annotationModel.PageNumber,
+		Kind:       annotationModel.Kind,
+		Note:       annotationModel.Note,
+		CreatedAt:  annotationModel.CreatedAt,
+		UpdatedAt:  annotationModel.UpdatedAt,
+	}
+	if annotationModel.RangeStart != nil && annotationModel.RangeEnd != nil {
+		annotationDomain.Range = &domain.TextRange{Start: *annotationModel.RangeStart, End: *annotationModel.RangeEnd}
+	}
+	return annotationDomain
+}
+
+func AnnotationModelsToDomains(annotationModels []model.Annotation) []domain.Annotation {
+	annotationDomains := make([]domain.Annotation, len(annotationModels))
+	for i := range annotationModels {
+		annotationDomains[i] = AnnotationModelToDomain(&annotationModels[i])
+	}
+	return annotationDomains
+}
+
+func AnnotationDomainToModel(annotationDomain *domain.Annotation) model.Annotation {
+	annotationModel := model.Annotation{
+		ID:         annotationDomain.ID,
+		UserID:     annotationDomain.UserID,
+		BookID:     annotationDomain.BookID,
+		PageNumber: annotationDomain.PageNumber,
+		Kind:       annotationDomain.Kind,
+		Note:       annotationDomain.Note,
+	}
+	if annotationDomain.Range != nil {
+		annotationModel.RangeStart = &annotationDomain.Range.Start
+		annotationModel.RangeEnd = &annotationDomain.Range.End
+	}
+	return annotationModel
+}
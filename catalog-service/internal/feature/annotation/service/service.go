@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/repository/postgres"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/service/mapper/postgres"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+)
+
+type AnnotationService interface {
+	CreateAnnotation(ctx context.Context, annotationDomain *domain.Annotation) error
+	GetAnnotations(ctx context.Context, userID, bookID, pageNumber uint) ([]domain.Annotation, error)
+	UpdateAnnotation(ctx context.Context, annotationDomain *domain.Annotation) error
+	DeleteAnnotation(ctx context.Context, userID, bookID, annotationID uint) error
+}
+
+type annotationService struct {
+	postgresPageRepository       catalogPostgres.PageRepository
+	postgresAnnotationRepository postgres.AnnotationRepository
+}
+
+func NewAnnotationService(
+	postgresPageRepository catalogPostgres.PageRepository,
+	postgresAnnotationRepository postgres.AnnotationRepository) AnnotationService {
+	return &annotationService{
+		postgresPageRepository:       postgresPageRepository,
+		postgresAnnotationRepository: postgresAnnotationRepository,
+	}
+}
+
+func (s *annotationService) CreateAnnotation(ctx context.Context, annotationDomain *domain.Annotation) error {
+	if err := s.validateAnnotation(ctx, annotationDomain); err != nil {
+		return err
+	}
+
+	annotationModel := postgresMapper.AnnotationDomainToModel(annotationDomain)
+	if err := s.postgresAnnotationRepository.Create(ctx, &annotationModel); err != nil {
+		return err
+	}
+
+	*annotationDomain = postgresMapper.AnnotationModelToDomain(&annotationModel)
+	return nil
+}
+
+func (s *annotationService) GetAnnotations(ctx context.Context, userID, bookID, pageNumber uint) ([]domain.Annotation, error) {
+	annotationModels, err := s.postgresAnnotationRepository.ListByUserIDAndBookID(ctx, userID, bookID, pageNumber)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.AnnotationModelsToDomains(annotationModels), nil
+}
+
+// UpdateAnnotation changes range and note only, page and kind are fixed once the annotation is created
+func (s *annotationService) UpdateAnnotation(ctx context.Context, annotationDomain *domain.Annotation) error {
+	annotationModel, err := s.postgresAnnotationRepository.FindByID(ctx, annotationDomain.UserID, annotationDomain.BookID, annotationDomain.ID)
+	if err != nil {
+		return err
+	}
+
+	annotationDomain.PageNumber = annotationModel.PageNumber
+	annotationDomain.Kind = annotationModel.Kind
+	if err := s.validateAnnotation(ctx, annotationDomain); err != nil {
+		return err
+	}
+
+	updatedAnnotationModel := postgresMapper.AnnotationDomainToModel(annotationDomain)
+	updatedAnnotationModel.CreatedAt = annotationModel.CreatedAt
+	if err := s.postgresAnnotationRepository.Update(ctx, &updatedAnnotationModel); err != nil {
+		return err
+	}
+
+	*annotationDomain = postgresMapper.AnnotationModelToDomain(&updatedAnnotationModel)
+	return nil
+}
+
+func (s *annotationService) DeleteAnnotation(ctx context.Context, userID, bookID, annotationID uint) error {
+	return s.postgresAnnotationRepository.Delete(ctx, userID, bookID, annotationID)
+}
+
+// validateAnnotation checks that the page exists and the character range fits its content
+func (s *annotationService) validateAnnotation(ctx context.Context, annotationDomain *domain.Annotation) error {
+	switch annotationDomain.Kind {
+	case domain.AnnotationKindBookmark:
+		if annotationDomain.Range != nil {
+			return errs.NewBadRequestError("Bookmark can't have a character range")
+		}
+	case domain.AnnotationKindHighlight:
+		if annotationDomain.Range == nil {
+			return errs.NewBadRequestError("Highlight requires a character range")
+		}
+	case domain.AnnotationKindNote:
+		if annotationDomain.Note == "" {
+			return errs.NewBadRequestError("Note requires text")
+		}
+	default:
+		return errs.NewBadRequestError(fmt.Sprintf("Unknown annotation kind %q", annotationDomain.Kind))
+	}
+
+	pageModel, err := s.postgresPageRepository.FindByBookIDAndPageNumber(ctx, annotationDomain.BookID, annotationDomain.PageNumber)
+	if err != nil {
+		return err
+	}
+
+	if annotationDomain.Range != nil {
+		pageLength := uint(utf8.RuneCountInString(pageModel.Content))
+		if annotationDomain.Range.Start >= annotationDomain.Range.End || annotationDomain.Range.End > pageLength {
+			return errs.NewBadRequestError(fmt.Sprintf("Character range must satisfy 0 <= start < end <= %d", pageLength))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+type Annotation struct {
+	ID         uint       `json:"id"`
+	BookID     uint       `json:"bookId"`
+	PageNumber uint       `json:"pageNumber"`
+	Kind       string     `json:"kind" enums:"bookmark,highlight,note"`
+	Range      *TextRange `json:"range,omitempty"`
+	Note       string     `json:"note,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+type TextRange struct {
+	Start uint `json:"start"`
+	End   uint `json:"end" binding:"required"`
+}
+
+type CreateAnnotationRequest struct {
+	PageNumber uint       `json:"pageNumber" binding:"required,min=1"`
+	Kind       string     `json:"kind" binding:"required,oneof=bookmark highlight note" enums:"bookmark,highlight,note"`
+	Range      *TextRange `json:"range"`
+	Note       string     `json:"note" binding:"max=10000"`
+}
+
+type UpdateAnnotationRequest struct {
+	Range *TextRange `json:"range"`
+	Note  string     `json:"note" binding:"max=10000"`
+}
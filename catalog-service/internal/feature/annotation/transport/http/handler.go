@@ -0,0 +1,257 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+type AnnotationHandler interface {
+	CreateAnnotation(c *gin.Context)
+	GetAnnotations(c *gin.Context)
+	UpdateAnnotation(c *gin.Context)
+	DeleteAnnotation(c *gin.Context)
+}
+
+type annotationHandler struct {
+	config            *config.Config
+	logger            *logging.Logger
+	annotationService service.AnnotationService
+}
+
+func NewAnnotationHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	annotationService service.AnnotationService,
+) AnnotationHandler {
+	return &annotationHandler{
+		config:            config,
+		logger:            logger,
+		annotationService: annotationService,
+	}
+}
+
+// CreateAnnotation godoc
+//
+//	@Summary		Annotate a book page
+//	@Description	Creates a bookmark, a highlight or a note on a book page. Character range is half-open and counted in characters of the page content
+//	@Tags			annotation
+//	@Param			bookID		path	uint						true	"Book ID"
+//	@Param			annotation	body	dto.CreateAnnotationRequest	true	"Annotation info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	dto.Annotation
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/annotations [post]
+func (h *annotationHandler) CreateAnnotation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var createAnnotationRequestDTO dto.CreateAnnotationRequest
+	if err := c.ShouldBindJSON(&createAnnotationRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CreateAnnotation")
+	defer span.End()
+
+	annotationDomain := mapper.CreateAnnotationRequestDTOToDomain(uint(userID), uint(bookID), &createAnnotationRequestDTO)
+	if err := h.annotationService.CreateAnnotation(ctx, &annotationDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Create annotation error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.AnnotationDomainToDTO(&annotationDomain))
+}
+
+// GetAnnotations godoc
+//
+//	@Summary		Get book annotations
+//	@Description	Returns bookmarks, highlights and notes of the authorized user for a book, ordered by position
+//	@Tags			annotation
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Param			page	query	int		false	"Page number, all pages when omitted"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Annotation
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/annotations [get]
+func (h *annotationHandler) GetAnnotations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.GetAnnotations
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetAnnotations")
+	defer span.End()
+
+	annotationDomains, err := h.annotationService.GetAnnotations(ctx, uint(userID), uint(bookID), query.PageNumber)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get annotations error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.AnnotationDomainsToDTOs(annotationDomains))
+}
+
+// UpdateAnnotation godoc
+//
+//	@Summary		Update an annotation
+//	@Description	Replaces character range and note text. Page and kind can't be changed
+//	@Tags			annotation
+//	@Param			bookID			path	uint						true	"Book ID"
+//	@Param			annotationID	path	uint						true	"Annotation ID"
+//	@Param			annotation		body	dto.UpdateAnnotationRequest	true	"Annotation info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Annotation
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/annotations/{annotationID} [put]
+func (h *annotationHandler) UpdateAnnotation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	annotationIDString := c.Param("annotationID")
+	annotationID, err := strconv.ParseUint(annotationIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var updateAnnotationRequestDTO dto.UpdateAnnotationRequest
+	if err := c.ShouldBindJSON(&updateAnnotationRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UpdateAnnotation")
+	defer span.End()
+
+	annotationDomain := mapper.UpdateAnnotationRequestDTOToDomain(uint(userID), uint(bookID), uint(annotationID), &updateAnnotationRequestDTO)
+	if err := h.annotationService.UpdateAnnotation(ctx, &annotationDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Update annotation error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.AnnotationDomainToDTO(&annotationDomain))
+}
+
+// DeleteAnnotation godoc
+//
+//	@Summary		Delete an annotation
+//	@Description	Deletes a bookmark, a highlight or a note of the authorized user
+//	@Tags			annotation
+//	@Param			bookID			path	uint	true	"Book ID"
+//	@Param			annotationID	path	uint	true	"Annotation ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/annotations/{annotationID} [delete]
+func (h *annotationHandler) DeleteAnnotation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	annotationIDString := c.Param("annotationID")
+	annotationID, err := strconv.ParseUint(annotationIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteAnnotation")
+	defer span.End()
+
+	if err := h.annotationService.DeleteAnnotation(ctx, uint(userID), uint(bookID), uint(annotationID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete annotation error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/transport/http/dto"
+)
+
+func AnnotationDomainToDTO(annotationDomain *domain.Annotation) dto.Annotation {
+	return dto.Annotation{
+		ID:         annotationDomain.ID,
+		BookID:     annotationDomain.BookID,
+		PageNumber: annotationDomain.PageNumber,
+		Kind:       annotationDomain.Kind,
+		Range:      textRangeDomainToDTO(annotationDomain.Range),
+		Note:       annotationDomain.Note,
+		CreatedAt:  annotationDomain.CreatedAt,
+		UpdatedAt:  annotationDomain.UpdatedAt,
+	}
+}
+
+func AnnotationDomainsToDTOs(annotationDomains []domain.Annotation) []dto.Annotation {
+	annotationDTOs := make([]dto.Annotation, len(annotationDomains))
+	for i := range annotationDomains {
+		annotationDTOs[i] = AnnotationDomainToDTO(&annotationDomains[i])
+	}
+	return annotationDTOs
+}
+
+func CreateAnnotationRequestDTOToDomain(userID, bookID uint, createAnnotationRequestDTO *dto.CreateAnnotationRequest) domain.Annotation {
+	return domain.Annotation{
+		UserID:     userID,
+		BookID:     bookID,
+		PageNumber: createAnnotationRequestDTO.PageNumber,
+		Kind:       createAnnotationRequestDTO.Kind,
+		Range:      textRangeDTOToDomain(createAnnotationRequestDTO.Range),
+		Note:       createAnnotationRequestDTO.Note,
+	}
+}
+
+func UpdateAnnotationRequestDTOToDomain(userID, bookID, annotationID uint, updateAnnotationRequestDTO *dto.UpdateAnnotationRequest) domain.Annotation {
+	return domain.Annotation{
+		ID:     annotationID,
+		UserID: userID,
+		BookID: bookID,
+		Range:  textRangeDTOToDomain(updateAnnotationRequestDTO.Range),
+		Note:   updateAnnotationRequestDTO.Note,
+	}
+}
+
+func textRangeDomainToDTO(textRangeDomain *domain.TextRange) *dto.TextRange {
+	if textRangeDomain == nil {
+		return nil
+	}
+	return &dto.TextRange{Start: textRangeDomain.Start, End: textRangeDomain.End}
+}
+
+func textRangeDTOToDomain(textRangeDTO *dto.TextRange) *domain.TextRange {
+	if textRangeDTO == nil {
+		return nil
+	}
+	return &domain.TextRange{Start: textRangeDTO.Start, End: textRangeDTO.End}
+}
@@ -0,0 +1,5 @@
+package query
+
+type GetAnnotations struct {
+	PageNumber uint `form:"page"`
+}
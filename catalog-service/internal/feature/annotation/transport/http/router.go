@@ -0,0 +1,18 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts annotation routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, annotationHandler AnnotationHandler) {
+	annotationGroup := r.Group(sharedRoute.CATALOG + sharedRoute.BOOKS + "/:bookID" + route.ANNOTATIONS)
+	{
+		annotationGroup.GET("", annotationHandler.GetAnnotations)
+		annotationGroup.POST("", annotationHandler.CreateAnnotation)
+		annotationGroup.PUT("/:annotationID", annotationHandler.UpdateAnnotation)
+		annotationGroup.DELETE("/:annotationID", annotationHandler.DeleteAnnotation)
+	}
+}
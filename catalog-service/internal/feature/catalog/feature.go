@@ -9,11 +9,14 @@ import (
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
 	grpcTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/grpc"
 	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http"
+	kafkaTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/kafka"
 	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/metrics"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres/seed"
+	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -21,8 +24,11 @@ import (
 )
 
 type Feature struct {
-	HTTPServer *http.Server
-	GRPCServer *grpc.Server
+	HTTPServer          *http.Server
+	HTTPRouter          *gin.Engine
+	GRPCServer          *grpc.Server
+	CatalogService      service.CatalogService
+	BookChangedConsumer kafkaTransport.BookChangedConsumer
 }
 
 func NewFeature(
@@ -30,21 +36,32 @@ func NewFeature(
 	logger *logging.Logger,
 	postgresDB *gorm.DB,
 	redisClient *redis.Client,
+	blobStorage blob.Storage,
 	bookAddedWriter *kafkaInfrastructure.OtelWriter,
+	categoryRenamedWriter *kafkaInfrastructure.OtelWriter,
+	bookChangedWriter *kafkaInfrastructure.BookChangedWriter,
 ) (*Feature, error) {
 	redisBookRepository := redisRepositories.NewBookRepository(redisClient)
 	postgresBookRepository := postgresRepositories.NewBookRepository(postgresDB)
+	postgresBookContributorRepository := postgresRepositories.NewBookContributorRepository(postgresDB)
 	postgresPageRepository := postgresRepositories.NewPageRepository(postgresDB)
 	postgresAuthorRepository := postgresRepositories.NewAuthorRepository(postgresDB)
+	postgresCategoryRepository := postgresRepositories.NewCategoryRepository(postgresDB)
+	postgresReadingProgressRepository := postgresRepositories.NewReadingProgressRepository(postgresDB)
+	postgresUserBookViewRepository := postgresRepositories.NewUserBookViewRepository(postgresDB)
+	postgresRevisionRepository := postgresRepositories.NewRevisionRepository(postgresDB)
+	postgresSuggestionRepository := postgresRepositories.NewSuggestionRepository(postgresDB)
 
-	if err := seed.Books(postgresBookRepository, postgresPageRepository, postgresAuthorRepository); err != nil {
+	if err := seed.Books(postgresBookRepository, postgresPageRepository, postgresAuthorRepository, postgresCategoryRepository); err != nil {
 		return nil, err
 	}
 
 	catalogService := service.NewCatalogService(
-		logger, postgresDB,
-		bookAddedWriter, redisBookRepository,
-		postgresAuthorRepository, postgresBookRepository, postgresPageRepository,
+		logger, postgresDB, blobStorage,
+		bookAddedWriter, categoryRenamedWriter, bookChangedWriter, redisBookRepository,
+		postgresAuthorRepository, postgresBookRepository, postgresBookContributorRepository, postgresPageRepository,
+		postgresReadingProgressRepository, postgresUserBookViewRepository, postgresCategoryRepository, postgresRevisionRepository,
+		postgresSuggestionRepository,
 	)
 
 	metricsHandler, err := metrics.Init()
@@ -63,5 +80,14 @@ func NewFeature(
 	gRPCServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	pb.RegisterCatalogServiceServer(gRPCServer, gRPCCatalogHandler)
 
-	return &Feature{HTTPServer: httpServer, GRPCServer: gRPCServer}, nil
+	bookChangedReader := kafkaInfrastructure.NewOtelReader(config, kafkaInfrastructure.BOOK_CHANGED_TOPIC, kafkaInfrastructure.BOOK_CHANGED_CONSUMER_GROUP_ID)
+	bookChangedConsumer := kafkaTransport.NewBookChangedConsumer(logger, bookChangedReader, catalogService)
+
+	return &Feature{
+		HTTPServer:          httpServer,
+		HTTPRouter:          httpRouter,
+		GRPCServer:          gRPCServer,
+		CatalogService:      catalogService,
+		BookChangedConsumer: bookChangedConsumer,
+	}, nil
 }
@@ -13,7 +13,17 @@ type AuthorRepository interface {
 	WithinTX(tx *gorm.DB) AuthorRepository
 	Create(ctx context.Context, author *model.Author) error
 	FindByID(ctx context.Context, authorID uint) (*model.Author, error)
-	Delete(ctx context.Context, authorID uint) error
+	FindByIDWithAliases(ctx context.Context, authorID uint) (*model.Author, error)
+	FindByFullname(ctx context.Context, fullname string) (*model.Author, error)
+	List(ctx context.Context, name string, page, count uint) ([]model.Author, error)
+	Update(ctx context.Context, author *model.Author) error
+	ReplaceAliases(ctx context.Context, authorID uint, aliases []string) error
+	Delete(ctx context.Context, authorID uint, deletedAt time.Time) error
+	ListDeleted(ctx context.Context, page, count uint) ([]model.Author, error)
+	ListDeletedBefore(ctx context.Context, before time.Time, count int) ([]model.Author, error)
+	FindDeletedByID(ctx context.Context, authorID uint) (*model.Author, error)
+	Restore(ctx context.Context, authorID uint) error
+	Purge(ctx context.Context, authorID uint) error
 }
 
 type authorRepository struct {
@@ -51,12 +61,202 @@ func (r *authorRepository) FindByID(ctx context.Context, authorID uint) (*model.
 	return &author, nil
 }
 
-func (r *authorRepository) Delete(ctx context.Context, authorID uint) error {
+func (r *authorRepository) FindByIDWithAliases(ctx context.Context, authorID uint) (*model.Author, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	if err := r.db.WithContext(ctx).Delete(&model.Author{}, authorID).Error; err != nil {
+	var author model.Author
+	err := r.db.WithContext(ctx).
+		Preload("Aliases", orderAliasesByName).
+		Where("id = ?", authorID).
+		First(&author).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &author, nil
+}
+
+// FindByFullname ignores case, the oldest author wins if several share the name
+func (r *authorRepository) FindByFullname(ctx context.Context, fullname string) (*model.Author, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var author model.Author
+	err := r.db.WithContext(ctx).
+		Where("LOWER(fullname) = LOWER(?)", fullname).
+		Order("id").
+		First(&author).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &author, nil
+}
+
+// List matches name against both the full name and the aliases of an author
+func (r *authorRepository) List(ctx context.Context, name string, page, count uint) ([]model.Author, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := r.db.WithContext(ctx).Preload("Aliases", orderAliasesByName)
+	if name != "" {
+		pattern := "%" + name + "%"
+		query = query.Where(`
+			authors.fullname ILIKE ? OR EXISTS (
+				SELECT 1 FROM author_aliases aa
+				WHERE aa.author_id = authors.id AND aa.name ILIKE ?
+			)`, pattern, pattern)
+	}
+
+	var authors []model.Author
+	offset := (page - 1) * count
+	err := query.
+		Order("authors.fullname").
+		Order("authors.id").
+		Offset(int(offset)).
+		Limit(int(count)).
+		Find(&authors).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return authors, nil
+}
+
+func (r *authorRepository) Update(ctx context.Context, author *model.Author) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(author).
+		Select("fullname", "biography", "birth_year", "death_year", "nationality", "updated_at").
+		Updates(author).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *authorRepository) ReplaceAliases(ctx context.Context, authorID uint, aliases []string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	db := r.db.WithContext(ctx)
+	if err := db.Where("author_id = ?", authorID).Delete(&model.AuthorAlias{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	aliasModels := make([]model.AuthorAlias, len(aliases))
+	for i := range aliases {
+		aliasModels[i] = model.AuthorAlias{AuthorID: authorID, Name: aliases[i]}
+	}
+	if err := db.Create(&aliasModels).Error; err != nil {
 		return postgresInfrastructure.NewError(err, r.name)
 	}
 	return nil
 }
+
+// Delete moves the author to the trash, deletedAt is shared with the author's books trashed along
+func (r *authorRepository) Delete(ctx context.Context, authorID uint, deletedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.Author{}).
+		Where("id = ?", authorID).
+		UpdateColumn("deleted_at", deletedAt)
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
+
+func (r *authorRepository) ListDeleted(ctx context.Context, page, count uint) ([]model.Author, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var authors []model.Author
+	offset := (page - 1) * count
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Preload("Aliases", orderAliasesByName).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Offset(int(offset)).
+		Limit(int(count)).
+		Find(&authors).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return authors, nil
+}
+
+func (r *authorRepository) ListDeletedBefore(ctx context.Context, before time.Time, count int) ([]model.Author, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var authors []model.Author
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at").
+		Limit(count).
+		Find(&authors).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return authors, nil
+}
+
+func (r *authorRepository) FindDeletedByID(ctx context.Context, authorID uint) (*model.Author, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var author model.Author
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Preload("Aliases", orderAliasesByName).
+		Where("id = ? AND deleted_at IS NOT NULL", authorID).
+		First(&author).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &author, nil
+}
+
+func (r *authorRepository) Restore(ctx context.Context, authorID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&model.Author{}).
+		Where("id = ? AND deleted_at IS NOT NULL", authorID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
+
+// Purge deletes the author for good, cascading to aliases, contributions and books left
+func (r *authorRepository) Purge(ctx context.Context, authorID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Unscoped().Delete(&model.Author{}, authorID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func orderAliasesByName(db *gorm.DB) *gorm.DB {
+	return db.Order("author_aliases.name")
+}
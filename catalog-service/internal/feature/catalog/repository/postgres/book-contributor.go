@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type BookContributorRepository interface {
+	WithinTX(tx *gorm.DB) BookContributorRepository
+	ReplaceByBookID(ctx context.Context, bookID uint, contributors []model.BookContributor) error
+	ListBookIDsByAuthorID(ctx context.Context, authorID uint) ([]uint, error)
+}
+
+type bookContributorRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewBookContributorRepository(db *gorm.DB) BookContributorRepository {
+	return &bookContributorRepository{name: "Book contributor(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *bookContributorRepository) WithinTX(tx *gorm.DB) BookContributorRepository {
+	return &bookContributorRepository{name: "Book contributor(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *bookContributorRepository) ReplaceByBookID(ctx context.Context, bookID uint, contributors []model.BookContributor) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	db := r.db.WithContext(ctx)
+	if err := db.Where("book_id = ?", bookID).Delete(&model.BookContributor{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	if len(contributors) == 0 {
+		return nil
+	}
+
+	if err := db.Create(&contributors).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *bookContributorRepository) ListBookIDsByAuthorID(ctx context.Context, authorID uint) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookIDs []uint
+	err := r.db.WithContext(ctx).
+		Model(&model.BookContributor{}).
+		Where("author_id = ?", authorID).
+		Distinct().
+		Pluck("book_id", &bookIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs, nil
+}
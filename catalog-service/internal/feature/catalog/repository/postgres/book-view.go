@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserBookViewRepository interface {
+	Save(ctx context.Context, userBookView *model.UserBookView) error
+}
+
+type userBookViewRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewUserBookViewRepository(db *gorm.DB) UserBookViewRepository {
+	return &userBookViewRepository{name: "User book view(s)", timeout: 1 * time.Second, db: db}
+}
+
+// Save keeps a single row per user and book, repeated views only move its time
+func (r *userBookViewRepository) Save(ctx context.Context, userBookView *model.UserBookView) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Omit("Book").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "book_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"viewed_at"}),
+		}).
+		Create(userBookView).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
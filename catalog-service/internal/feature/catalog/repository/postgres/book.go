@@ -11,23 +11,40 @@ import (
 
 	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BookRepository interface {
 	WithinTX(tx *gorm.DB) BookRepository
-	GetCategories(ctx context.Context) ([]string, error)
-	CategoryExists(ctx context.Context, category string) (bool, error)
 	GetNew(ctx context.Context) ([]model.BookWithAuthor, error)
 	GetBooksByIDs(ctx context.Context, bookIDs []string) ([]model.BookWithAuthor, error)
 	GetBooksByAuthorID(ctx context.Context, authorID uint) ([]model.BookWithAuthor, error)
 	FindByID(ctx context.Context, bookID uint) (*model.BookWithAuthor, error)
+	ListAfterID(ctx context.Context, afterID uint, count int) ([]model.BookWithAuthor, error)
 	Count(ctx context.Context) (int64, error)
 	Create(ctx context.Context, book *model.Book) error
+	UpdateAuthorID(ctx context.Context, bookID, authorID uint) error
+	UpdateMetadata(ctx context.Context, book *model.Book) error
+	UpdateCoverKey(ctx context.Context, bookID uint, coverKey string) error
+	RenameCategory(ctx context.Context, categoryID uint, name string) ([]uint, error)
+	CountByCategoryID(ctx context.Context, categoryID uint) (int64, error)
 	Delete(ctx context.Context, bookID uint) error
+	DeleteByAuthorID(ctx context.Context, authorID uint, deletedAt time.Time) ([]uint, error)
+	ListDeleted(ctx context.Context, page, count uint) ([]model.DeletedBookWithAuthor, error)
+	ListDeletedByAuthorID(ctx context.Context, authorID uint) ([]model.DeletedBookWithAuthor, error)
+	ListDeletedBefore(ctx context.Context, before time.Time, count int) ([]model.Book, error)
+	ListCategoryIDsByIDs(ctx context.Context, bookIDs []uint) ([]model.Book, error)
+	FindDeletedByID(ctx context.Context, bookID uint) (*model.DeletedBookWithAuthor, error)
+	Restore(ctx context.Context, bookID uint) error
+	RestoreByAuthorID(ctx context.Context, authorID uint, deletedAt time.Time) ([]uint, error)
+	Purge(ctx context.Context, bookID uint) error
 	ListByAuthorName(ctx context.Context, authorName string, page, count uint, sort, order string) ([]model.BookWithAuthor, error)
 	ListByTitle(ctx context.Context, title string, page, count uint, sort, order string) ([]model.BookWithAuthor, error)
 	ListByAuthorNameAndTitle(ctx context.Context, authorName, title string, page, count uint, sort, order string) ([]model.BookWithAuthor, error)
-	ListByCategory(ctx context.Context, categoryName string, page, count uint, sort, order string) ([]model.BookWithAuthor, error)
+	ListByCategoryIDs(ctx context.Context, categoryIDs []uint, page, count uint, sort, order string) ([]model.BookWithAuthor, error)
+	ListTopRatedIDs(ctx context.Context, categoryIDs, excludedBookIDs []uint, count int) ([]uint, error)
+	ListByTags(ctx context.Context, authorName, title string, tagNames []string, matchAllTags bool, page, count uint, sort, order string) ([]model.BookWithAuthor, error)
+	ListByISBN(ctx context.Context, isbn string) ([]model.BookWithAuthor, error)
 }
 
 type bookRepository struct {
@@ -44,30 +61,6 @@ func (r *bookRepository) WithinTX(tx *gorm.DB) BookRepository {
 	return &bookRepository{name: "Books(s)", timeout: 1 * time.Second, db: tx}
 }
 
-func (r *bookRepository) GetCategories(ctx context.Context) ([]string, error) {
-	ctx, cancel := context.WithTimeout(ctx, r.timeout)
-	defer cancel()
-
-	var categories []string
-	if err := r.db.WithContext(ctx).Model(&model.Book{}).Distinct().Order("category").Pluck("category", &categories).Error; err != nil {
-		return nil, postgresInfrastructure.NewError(err, r.name)
-	}
-	return categories, nil
-}
-
-func (r *bookRepository) CategoryExists(ctx context.Context, category string) (bool, error) {
-	ctx, cancel := context.WithTimeout(ctx, r.timeout)
-	defer cancel()
-
-	query := `SELECT EXISTS (SELECT 1 FROM books WHERE category = ?)`
-
-	var exists bool
-	if err := r.db.WithContext(ctx).Raw(query, category).Scan(&exists).Error; err != nil {
-		return false, postgresInfrastructure.NewError(err, r.name)
-	}
-	return exists, nil
-}
-
 func (r *bookRepository) GetNew(ctx context.Context) ([]model.BookWithAuthor, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -110,7 +103,7 @@ func (r *bookRepository) GetBooksByAuthorID(ctx context.Context, authorID uint)
 	var booksWithAuthor []model.BookWithAuthor
 
 	err := r.buildBaseBookWithAuthorQuery(ctx).
-		Where("books.author_id = ?", authorID).
+		Where("books.id IN (SELECT book_id FROM book_contributors WHERE author_id = ?)", authorID).
 		Find(&booksWithAuthor).Error
 	if err != nil {
 		return nil, postgresInfrastructure.NewError(err, r.name)
@@ -135,12 +128,32 @@ func (r *bookRepository) FindByID(ctx context.Context, bookID uint) (*model.Book
 	return &bookWithAuthor, nil
 }
 
+// ListAfterID pages through all books by ID, so long exports don't slow down on big offsets
+func (r *bookRepository) ListAfterID(ctx context.Context, afterID uint, count int) ([]model.BookWithAuthor, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var booksWithAuthor []model.BookWithAuthor
+
+	err := r.buildBaseBookWithAuthorQuery(ctx).
+		Where("books.id > ?", afterID).
+		Order("books.id").
+		Limit(count).
+		Find(&booksWithAuthor).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+
+	return booksWithAuthor, nil
+}
+
 func (r *bookRepository) Count(ctx context.Context) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
+	// Trashed books are counted too, otherwise the seed would run again once the catalog is emptied into the trash
 	var booksCount int64
-	if err := r.db.WithContext(ctx).Model(&model.Book{}).Count(&booksCount).Error; err != nil {
+	if err := r.db.WithContext(ctx).Unscoped().Model(&model.Book{}).Count(&booksCount).Error; err != nil {
 		return 0, postgresInfrastructure.NewError(err, r.name)
 	}
 	return booksCount, nil
@@ -157,6 +170,79 @@ func (r *bookRepository) Create(ctx context.Context, book *model.Book) error {
 	return nil
 }
 
+func (r *bookRepository) UpdateAuthorID(ctx context.Context, bookID, authorID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Book{}).
+		Where("id = ?", bookID).
+		Update("author_id", authorID).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *bookRepository) UpdateMetadata(ctx context.Context, book *model.Book) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(book).
+		Select("isbn", "publisher", "language", "page_count", "description").
+		Updates(book).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *bookRepository) UpdateCoverKey(ctx context.Context, bookID uint, coverKey string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Book{}).
+		Where("id = ?", bookID).
+		Update("cover_key", coverKey).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// RenameCategory renames trashed books too, so they come back with the current category name
+func (r *bookRepository) RenameCategory(ctx context.Context, categoryID uint, name string) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var books []model.Book
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&books).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("category_id = ?", categoryID).
+		Update("category", name).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs(books), nil
+}
+
+func (r *bookRepository) CountByCategoryID(ctx context.Context, categoryID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	// Trashed books still reference the category until they are purged
+	var count int64
+	if err := r.db.WithContext(ctx).Unscoped().Model(&model.Book{}).Where("category_id = ?", categoryID).Count(&count).Error; err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return count, nil
+}
+
+// Delete moves the book to the trash, it stays there until it's restored or purged
 func (r *bookRepository) Delete(ctx context.Context, bookID uint) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -167,23 +253,218 @@ func (r *bookRepository) Delete(ctx context.Context, bookID uint) error {
 	return nil
 }
 
+// DeleteByAuthorID moves all the author's books to the trash with the same deletion time as the author,
+// so restoring the author brings back exactly these books
+func (r *bookRepository) DeleteByAuthorID(ctx context.Context, authorID uint, deletedAt time.Time) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var books []model.Book
+	err := r.db.WithContext(ctx).
+		Model(&books).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("author_id = ?", authorID).
+		Update("deleted_at", deletedAt).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs(books), nil
+}
+
+func (r *bookRepository) ListDeleted(ctx context.Context, page, count uint) ([]model.DeletedBookWithAuthor, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var deletedBooksWithAuthor []model.DeletedBookWithAuthor
+
+	offset := (page - 1) * count
+	err := r.buildDeletedBookWithAuthorQuery(ctx).
+		Order("books.deleted_at DESC").
+		Limit(int(count)).
+		Offset(int(offset)).
+		Find(&deletedBooksWithAuthor).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return deletedBooksWithAuthor, nil
+}
+
+func (r *bookRepository) ListDeletedByAuthorID(ctx context.Context, authorID uint) ([]model.DeletedBookWithAuthor, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var deletedBooksWithAuthor []model.DeletedBookWithAuthor
+	err := r.buildDeletedBookWithAuthorQuery(ctx).
+		Where("books.author_id = ?", authorID).
+		Find(&deletedBooksWithAuthor).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return deletedBooksWithAuthor, nil
+}
+
+func (r *bookRepository) ListDeletedBefore(ctx context.Context, before time.Time, count int) ([]model.Book, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var books []model.Book
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at").
+		Limit(count).
+		Find(&books).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return books, nil
+}
+
+// ListCategoryIDsByIDs selects only IDs and category IDs of books, trashed ones included
+func (r *bookRepository) ListCategoryIDsByIDs(ctx context.Context, bookIDs []uint) ([]model.Book, error) {
+	if len(bookIDs) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var books []model.Book
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Select("id", "category_id").
+		Where("id IN ?", bookIDs).
+		Find(&books).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return books, nil
+}
+
+func (r *bookRepository) FindDeletedByID(ctx context.Context, bookID uint) (*model.DeletedBookWithAuthor, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var deletedBookWithAuthor model.DeletedBookWithAuthor
+
+	err := r.buildDeletedBookWithAuthorQuery(ctx).
+		Where("books.id = ?", bookID).
+		First(&deletedBookWithAuthor).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &deletedBookWithAuthor, nil
+}
+
+func (r *bookRepository) Restore(ctx context.Context, bookID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&model.Book{}).
+		Where("id = ? AND deleted_at IS NOT NULL", bookID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
+
+// RestoreByAuthorID brings back the author's books trashed together with the author,
+// books trashed on their own before that stay in the trash
+func (r *bookRepository) RestoreByAuthorID(ctx context.Context, authorID uint, deletedAt time.Time) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var books []model.Book
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&books).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("author_id = ? AND deleted_at = ?", authorID, deletedAt).
+		Update("deleted_at", nil).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs(books), nil
+}
+
+// Purge deletes the book for good together with its pages, contributors and reading progresses
+func (r *bookRepository) Purge(ctx context.Context, bookID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Unscoped().Delete(&model.Book{}, bookID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
 func (r *bookRepository) ListByAuthorName(ctx context.Context, authorName string, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
-	return r.listBooksBy(ctx, map[string]string{"author": authorName}, page, count, sort, order)
+	return r.listBooksBy(ctx, map[string]any{"author": authorName}, page, count, sort, order)
 }
 
 func (r *bookRepository) ListByTitle(ctx context.Context, title string, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
-	return r.listBooksBy(ctx, map[string]string{"title": title}, page, count, sort, order)
+	return r.listBooksBy(ctx, map[string]any{"title": title}, page, count, sort, order)
 }
 
 func (r *bookRepository) ListByAuthorNameAndTitle(ctx context.Context, authorName, title string, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
-	return r.listBooksBy(ctx, map[string]string{"author": authorName, "title": title}, page, count, sort, order)
+	return r.listBooksBy(ctx, map[string]any{"author": authorName, "title": title}, page, count, sort, order)
+}
+
+func (r *bookRepository) ListByCategoryIDs(ctx context.Context, categoryIDs []uint, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
+	return r.listBooksBy(ctx, map[string]any{"categoryIDs": categoryIDs}, page, count, sort, order)
+}
+
+// ListTopRatedIDs breaks rating ties by the number of ratings and then by ID, so the order is stable
+func (r *bookRepository) ListTopRatedIDs(ctx context.Context, categoryIDs, excludedBookIDs []uint, count int) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := r.db.WithContext(ctx).
+		Model(&model.Book{}).
+		Where("category_id IN ?", categoryIDs)
+	if len(excludedBookIDs) > 0 {
+		query = query.Where("id NOT IN ?", excludedBookIDs)
+	}
+
+	var bookIDs []uint
+	err := query.
+		Order("rating_average DESC, rating_count DESC, id").
+		Limit(count).
+		Pluck("id", &bookIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs, nil
+}
+
+// ListByTags lists books having any (or all, if matchAllTags is set) of the tags, author name and title are optional
+func (r *bookRepository) ListByTags(ctx context.Context, authorName, title string, tagNames []string, matchAllTags bool, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
+	return r.listBooksBy(ctx, map[string]any{"author": authorName, "title": title, "tags": tagNames, "allTags": matchAllTags}, page, count, sort, order)
 }
 
-func (r *bookRepository) ListByCategory(ctx context.Context, category string, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
-	return r.listBooksBy(ctx, map[string]string{"category": category}, page, count, sort, order)
+func (r *bookRepository) ListByISBN(ctx context.Context, isbn string) ([]model.BookWithAuthor, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var booksWithAuthor []model.BookWithAuthor
+
+	err := r.buildBaseBookWithAuthorQuery(ctx).
+		Where("books.isbn = ?", isbn).
+		Find(&booksWithAuthor).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+
+	return booksWithAuthor, nil
 }
 
-func (r *bookRepository) listBooksBy(ctx context.Context, filters map[string]string, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
+func (r *bookRepository) listBooksBy(ctx context.Context, filters map[string]any, page, count uint, sort, order string) ([]model.BookWithAuthor, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
@@ -192,42 +473,54 @@ func (r *bookRepository) listBooksBy(ctx context.Context, filters map[string]str
 
 	sort, order = sanitizeListBooksParams(sort, order)
 
-	whereClauses := []string{}
+	whereClauses := []string{"books.deleted_at IS NULL"}
 	args := []any{}
 
-	if v, ok := filters["author"]; ok && v != "" {
-		whereClauses = append(whereClauses, "a.fullname ILIKE ?")
-		args = append(args, "%"+v+"%")
+	if v, ok := filters["author"].(string); ok && v != "" {
+		whereClauses = append(whereClauses, `EXISTS (
+			SELECT 1 FROM book_contributors fbc
+			INNER JOIN authors fa ON fa.id = fbc.author_id
+			WHERE fbc.book_id = books.id AND fa.deleted_at IS NULL AND (fa.fullname ILIKE ? OR EXISTS (
+				SELECT 1 FROM author_aliases aa
+				WHERE aa.author_id = fa.id AND aa.name ILIKE ?
+			))
+		)`)
+		args = append(args, "%"+v+"%", "%"+v+"%")
 	}
-	if v, ok := filters["title"]; ok && v != "" {
-		whereClauses = append(whereClauses, "b.title ILIKE ?")
+	if v, ok := filters["title"].(string); ok && v != "" {
+		whereClauses = append(whereClauses, "books.title ILIKE ?")
 		args = append(args, "%"+v+"%")
 	}
-	if v, ok := filters["category"]; ok && v != "" {
-		whereClauses = append(whereClauses, "b.category ILIKE ?")
-		args = append(args, "%"+v+"%")
+	if v, ok := filters["categoryIDs"].([]uint); ok {
+		whereClauses = append(whereClauses, "books.category_id IN ?")
+		args = append(args, v)
 	}
-
-	whereSQL := ""
-	if len(whereClauses) > 0 {
-		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	if v, ok := filters["tags"].([]string); ok && len(v) > 0 {
+		tagsSubquery := `
+			SELECT COUNT(DISTINCT ft.id) FROM book_tags fbt
+			INNER JOIN tags ft ON ft.id = fbt.tag_id
+			WHERE fbt.book_id = books.id AND ft.name IN ?
+		`
+		if allTags, _ := filters["allTags"].(bool); allTags {
+			whereClauses = append(whereClauses, "("+tagsSubquery+") = ?")
+			args = append(args, v, len(v))
+		} else {
+			whereClauses = append(whereClauses, "("+tagsSubquery+") > 0")
+			args = append(args, v)
+		}
 	}
 
+	whereSQL := "WHERE " + strings.Join(whereClauses, " AND ")
+
 	query := fmt.Sprintf(`
-		SELECT 
-			b.id,
-			b.author_id,
-			a.fullname AS author_fullname,
-			b.title, 
-			b.year,
-			b.category
-		FROM books b
-		INNER JOIN authors a 
-		ON b.author_id = a.id
+		SELECT %s
+		FROM books
+		INNER JOIN authors
+		ON books.author_id = authors.id
 		%s
-		ORDER BY %s %s
+		ORDER BY books.%s %s
 		LIMIT ? OFFSET ?
-	`, whereSQL, sort, order)
+	`, model.BookWithAuthorColumns(), whereSQL, sort, order)
 
 	args = append(args, count, offset)
 	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&bookModels).Error; err != nil {
@@ -239,17 +532,37 @@ func (r *bookRepository) listBooksBy(ctx context.Context, filters map[string]str
 func (r *bookRepository) buildBaseBookWithAuthorQuery(ctx context.Context) *gorm.DB {
 	return r.db.WithContext(ctx).
 		Model(&model.Book{}).
-		Select("books.id, books.author_id, authors.fullname AS author_fullname, books.title, books.year, books.category").
+		Select(model.BookWithAuthorColumns()).
 		Joins("LEFT JOIN authors ON books.author_id = authors.id")
 }
 
+func (r *bookRepository) buildDeletedBookWithAuthorQuery(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Unscoped().
+		Model(&model.Book{}).
+		Select(model.BookWithAuthorColumns() + ", books.deleted_at").
+		Joins("LEFT JOIN authors ON books.author_id = authors.id").
+		Where("books.deleted_at IS NOT NULL")
+}
+
+func bookIDs(books []model.Book) []uint {
+	ids := make([]uint, len(books))
+	for i := range books {
+		ids[i] = books[i].ID
+	}
+	return ids
+}
+
 func sanitizeListBooksParams(sort, order string) (string, string) {
-	allowedSortColumnValues := []string{"title", "year", "category"}
+	allowedSortColumnValues := []string{"title", "year", "category", "rating"}
 
 	sort = strings.ToLower(sort)
 	if !slices.Contains(allowedSortColumnValues, sort) {
 		sort = "title"
 	}
+	if sort == "rating" {
+		sort = "rating_average"
+	}
 
 	order = strings.ToUpper(order)
 	if order != "ASC" && order != "DESC" {
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type CategoryRepository interface {
+	WithinTX(tx *gorm.DB) CategoryRepository
+	List(ctx context.Context) ([]model.CategoryWithBooksCount, error)
+	ListNames(ctx context.Context) ([]string, error)
+	ListSubtreeIDs(ctx context.Context, categoryID uint) ([]uint, error)
+	FindByID(ctx context.Context, categoryID uint) (*model.Category, error)
+	FindBySlugOrName(ctx context.Context, slugOrName string) (*model.Category, error)
+	ExistsByName(ctx context.Context, name string) (bool, error)
+	CountChildren(ctx context.Context, categoryID uint) (int64, error)
+	Create(ctx context.Context, category *model.Category) error
+	Update(ctx context.Context, category *model.Category) error
+	Delete(ctx context.Context, categoryID uint) error
+}
+
+type categoryRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &categoryRepository{name: "Category(ies)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *categoryRepository) WithinTX(tx *gorm.DB) CategoryRepository {
+	return &categoryRepository{name: "Category(ies)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *categoryRepository) List(ctx context.Context) ([]model.CategoryWithBooksCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var categories []model.CategoryWithBooksCount
+	err := r.db.WithContext(ctx).
+		Model(&model.Category{}).
+		Select("categories.*, (SELECT COUNT(*) FROM books WHERE books.category_id = categories.id AND books.deleted_at IS NULL) AS books_count").
+		Order("categories.name").
+		Scan(&categories).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) ListNames(ctx context.Context) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var names []string
+	if err := r.db.WithContext(ctx).Model(&model.Category{}).Order("name").Pluck("name", &names).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return names, nil
+}
+
+// ListSubtreeIDs returns the category ID itself followed by IDs of all its descendants
+func (r *categoryRepository) ListSubtreeIDs(ctx context.Context, categoryID uint) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth FROM categories WHERE id = ?
+			UNION ALL
+			SELECT c.id, s.depth + 1 FROM categories c
+			INNER JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT id FROM subtree ORDER BY depth, id
+	`
+
+	var categoryIDs []uint
+	if err := r.db.WithContext(ctx).Raw(query, categoryID).Scan(&categoryIDs).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return categoryIDs, nil
+}
+
+func (r *categoryRepository) FindByID(ctx context.Context, categoryID uint) (*model.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var category model.Category
+	if err := r.db.WithContext(ctx).Where("id = ?", categoryID).First(&category).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &category, nil
+}
+
+// FindBySlugOrName prefers the slug match, then falls back to the case-insensitive name
+func (r *categoryRepository) FindBySlugOrName(ctx context.Context, slugOrName string) (*model.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var category model.Category
+	err := r.db.WithContext(ctx).Where("slug = LOWER(?)", slugOrName).First(&category).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = r.db.WithContext(ctx).Where("LOWER(name) = LOWER(?)", slugOrName).Order("id").First(&category).Error
+	}
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &category, nil
+}
+
+func (r *categoryRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `SELECT EXISTS (SELECT 1 FROM categories WHERE LOWER(name) = LOWER(?))`
+
+	var exists bool
+	if err := r.db.WithContext(ctx).Raw(query, name).Scan(&exists).Error; err != nil {
+		return false, postgresInfrastructure.NewError(err, r.name)
+	}
+	return exists, nil
+}
+
+func (r *categoryRepository) CountChildren(ctx context.Context, categoryID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Category{}).Where("parent_id = ?", categoryID).Count(&count).Error; err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return count, nil
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *model.Category) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Create(category).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *model.Category) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(category).
+		Select("parent_id", "slug", "name", "description", "updated_at").
+		Updates(category).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, categoryID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Delete(&model.Category{}, categoryID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
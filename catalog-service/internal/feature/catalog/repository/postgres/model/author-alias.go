@@ -0,0 +1,7 @@
+package model
+
+type AuthorAlias struct {
+	ID       uint   `gorm:"primarykey"`
+	AuthorID uint   `gorm:"not null;uniqueIndex:author_id_name_index"`
+	Name     string `gorm:"not null;uniqueIndex:author_id_name_index"`
+}
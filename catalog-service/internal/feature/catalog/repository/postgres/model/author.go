@@ -1,10 +1,22 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Author struct {
-	ID        uint `gorm:"primarykey"`
-	Fullname  string
-	CreatedAt time.Time
-	Books     []Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	ID            uint `gorm:"primarykey"`
+	Fullname      string
+	Biography     string `gorm:"type:text"`
+	BirthYear     *int
+	DeathYear     *int
+	Nationality   string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt    `gorm:"index"`
+	Aliases       []AuthorAlias     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Books         []Book            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Contributions []BookContributor `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
@@ -0,0 +1,33 @@
+package model
+
+import "fmt"
+
+type BookContributor struct {
+	BookID   uint   `gorm:"primaryKey;autoIncrement:false"`
+	AuthorID uint   `gorm:"primaryKey;autoIncrement:false;index"`
+	Role     string `gorm:"primaryKey"`
+	Position uint   `gorm:"not null;default:0"`
+}
+
+type BookContributorWithAuthor struct {
+	AuthorID       uint   `json:"author_id"`
+	AuthorFullname string `json:"author_fullname"`
+	Role           string `json:"role"`
+	Position       uint   `json:"position"`
+}
+
+// BookContributorsColumn selects ordered contributors of the book identified by bookIDColumn
+// as a single "contributors" column, so book projections don't need a second query
+func BookContributorsColumn(bookIDColumn string) string {
+	return fmt.Sprintf(`COALESCE((
+			SELECT json_agg(json_build_object(
+				'author_id', bc.author_id,
+				'author_fullname', ca.fullname,
+				'role', bc.role,
+				'position', bc.position
+			) ORDER BY bc.position, bc.author_id)
+			FROM book_contributors bc
+			INNER JOIN authors ca ON ca.id = bc.author_id
+			WHERE bc.book_id = %s AND ca.deleted_at IS NULL
+		), '[]') AS contributors`, bookIDColumn)
+}
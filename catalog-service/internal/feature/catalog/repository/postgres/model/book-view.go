@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// UserBookView is the latest view of a book by a signed-in user, recommendations are built from these
+type UserBookView struct {
+	UserID   uint      `gorm:"primaryKey;autoIncrement:false"`
+	BookID   uint      `gorm:"primaryKey;autoIncrement:false;index"`
+	ViewedAt time.Time `gorm:"not null;index"`
+	Book     Book      `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -2,23 +2,60 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Book struct {
-	ID        uint   `gorm:"primarykey"`
-	AuthorID  uint   `gorm:"uniqueIndex:author_id_title_index"`
-	Title     string `gorm:"uniqueIndex:author_id_title_index"`
-	Year      int
-	Category  string
-	CreatedAt time.Time
-	Pages     []Page `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID                uint   `gorm:"primarykey"`
+	AuthorID          uint   `gorm:"uniqueIndex:author_id_title_active_index,where:deleted_at IS NULL"`
+	Title             string `gorm:"uniqueIndex:author_id_title_active_index"`
+	Year              int
+	CategoryID        *uint `gorm:"index"`
+	Category          string
+	ISBN              *string `gorm:"uniqueIndex:isbn_active_index,where:deleted_at IS NULL"`
+	Publisher         string
+	Language          string
+	PageCount         uint
+	Description       string `gorm:"type:text"`
+	CoverKey          string
+	RatingAverage     float64 `gorm:"not null;default:0"`
+	RatingCount       uint    `gorm:"not null;default:0"`
+	CreatedAt         time.Time
+	DeletedAt         gorm.DeletedAt    `gorm:"index"`
+	Pages             []Page            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Contributors      []BookContributor `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ReadingProgresses []ReadingProgress `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
 type BookWithAuthor struct {
 	ID             uint
 	AuthorID       uint
 	AuthorFullname string
+	Contributors   []BookContributorWithAuthor `gorm:"serializer:json"`
 	Title          string
 	Year           int
 	Category       string
+	CategoryID     *uint
+	ISBN           *string
+	Publisher      string
+	Language       string
+	PageCount      uint
+	Description    string
+	CoverKey       string
+	RatingAverage  float64
+	RatingCount    uint
+}
+
+// BookWithAuthorColumns selects BookWithAuthor from books joined with authors,
+// every projection embedding it builds its select from here, so none of them misses a column
+func BookWithAuthorColumns() string {
+	return "books.id, books.author_id, authors.fullname AS author_fullname, books.title, books.year, books.category, books.category_id, books.rating_average, books.rating_count, " +
+		"books.isbn, books.publisher, books.language, books.page_count, books.description, books.cover_key, " +
+		BookContributorsColumn("books.id")
+}
+
+type DeletedBookWithAuthor struct {
+	BookWithAuthor
+	DeletedAt time.Time
 }
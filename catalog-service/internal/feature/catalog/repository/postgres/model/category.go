@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type Category struct {
+	ID          uint   `gorm:"primarykey"`
+	ParentID    *uint  `gorm:"index"`
+	Slug        string `gorm:"not null;uniqueIndex"`
+	Name        string `gorm:"not null"`
+	Description string `gorm:"type:text"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Children    []Category `gorm:"foreignKey:ParentID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Books       []Book     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+}
+
+type CategoryWithBooksCount struct {
+	Category
+	BooksCount uint
+}
@@ -11,3 +11,8 @@ type Page struct {
 	Content   string
 	CreatedAt time.Time
 }
+
+type PagesFingerprint struct {
+	PagesCount int64
+	Hash       string
+}
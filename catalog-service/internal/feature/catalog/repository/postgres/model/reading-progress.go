@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type ReadingProgress struct {
+	UserID     uint `gorm:"primaryKey;autoIncrement:false"`
+	BookID     uint `gorm:"primaryKey;autoIncrement:false"`
+	LastPage   uint
+	Percentage float64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ReadingProgressWithBook struct {
+	BookWithAuthor
+	LastPage   uint
+	Percentage float64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
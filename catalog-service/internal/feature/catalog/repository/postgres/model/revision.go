@@ -0,0 +1,47 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Revision struct {
+	ID          uint            `gorm:"primarykey"`
+	EntityType  string          `gorm:"not null;index:entity_type_entity_id_index"`
+	EntityID    uint            `gorm:"not null;index:entity_type_entity_id_index"`
+	Action      string          `gorm:"not null"`
+	ActorUserID uint            `gorm:"not null;index"`
+	Before      json.RawMessage `gorm:"type:jsonb"`
+	After       json.RawMessage `gorm:"type:jsonb"`
+	CreatedAt   time.Time
+}
+
+// BookSnapshot is the stored state of a book, reverting applies its metadata and contributors
+type BookSnapshot struct {
+	Title        string                `json:"title"`
+	AuthorID     uint                  `json:"authorId"`
+	Year         int                   `json:"year"`
+	Category     string                `json:"category"`
+	ISBN         *string               `json:"isbn"`
+	Publisher    string                `json:"publisher"`
+	Language     string                `json:"language"`
+	PageCount    uint                  `json:"pageCount"`
+	Description  string                `json:"description"`
+	CoverKey     string                `json:"coverKey"`
+	Contributors []ContributorSnapshot `json:"contributors"`
+}
+
+type ContributorSnapshot struct {
+	AuthorID uint   `json:"authorId"`
+	Role     string `json:"role"`
+	Position uint   `json:"position"`
+}
+
+type AuthorSnapshot struct {
+	Fullname    string   `json:"fullname"`
+	Biography   string   `json:"biography"`
+	BirthYear   *int     `json:"birthYear"`
+	DeathYear   *int     `json:"deathYear"`
+	Nationality string   `json:"nationality"`
+	Aliases     []string `json:"aliases"`
+}
@@ -0,0 +1,7 @@
+package model
+
+type Suggestion struct {
+	Type     string
+	Text     string
+	EntityID uint
+}
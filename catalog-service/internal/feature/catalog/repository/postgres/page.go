@@ -14,6 +14,9 @@ type PageRepository interface {
 	WithinTX(tx *gorm.DB) PageRepository
 	Create(ctx context.Context, page *model.Page) error
 	FindByBookIDAndPageNumber(ctx context.Context, bookID uint, pageNumber uint) (*model.Page, error)
+	CountByBookID(ctx context.Context, bookID uint) (int64, error)
+	ListByBookID(ctx context.Context, bookID uint) ([]model.Page, error)
+	FingerprintByBookID(ctx context.Context, bookID uint) (*model.PagesFingerprint, error)
 }
 
 type pageRepository struct {
@@ -45,8 +48,53 @@ func (r *pageRepository) FindByBookIDAndPageNumber(ctx context.Context, bookID,
 	defer cancel()
 
 	var page model.Page
-	if err := r.db.WithContext(ctx).Where("book_id = ?", bookID).Where("number = ?", pageNumber).First(&page).Error; err != nil {
+	err := r.db.WithContext(ctx).
+		Where("book_id = ?", bookID).
+		Where("number = ?", pageNumber).
+		Where("EXISTS (SELECT 1 FROM books WHERE books.id = pages.book_id AND books.deleted_at IS NULL)").
+		First(&page).Error
+	if err != nil {
 		return nil, postgresInfrastructure.NewError(err, r.name)
 	}
 	return &page, nil
 }
+
+func (r *pageRepository) CountByBookID(ctx context.Context, bookID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var pagesCount int64
+	if err := r.db.WithContext(ctx).Model(&model.Page{}).Where("book_id = ?", bookID).Count(&pagesCount).Error; err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return pagesCount, nil
+}
+
+func (r *pageRepository) ListByBookID(ctx context.Context, bookID uint) ([]model.Page, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var pages []model.Page
+	if err := r.db.WithContext(ctx).Where("book_id = ?", bookID).Order("number").Find(&pages).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return pages, nil
+}
+
+// FingerprintByBookID hashes numbers and contents of all pages of a book inside the database,
+// so callers can tell whether pages changed without loading them
+func (r *pageRepository) FingerprintByBookID(ctx context.Context, bookID uint) (*model.PagesFingerprint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var pagesFingerprint model.PagesFingerprint
+	err := r.db.WithContext(ctx).
+		Model(&model.Page{}).
+		Select("COUNT(*) AS pages_count, COALESCE(MD5(STRING_AGG(number || ':' || MD5(content), ',' ORDER BY number)), '') AS hash").
+		Where("book_id = ?", bookID).
+		Scan(&pagesFingerprint).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &pagesFingerprint, nil
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReadingProgressRepository interface {
+	Save(ctx context.Context, readingProgress *model.ReadingProgress) error
+	ListInProgressByUserID(ctx context.Context, userID uint, page, count uint) ([]model.ReadingProgressWithBook, error)
+}
+
+type readingProgressRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewReadingProgressRepository(db *gorm.DB) ReadingProgressRepository {
+	return &readingProgressRepository{name: "Reading progress", timeout: 1 * time.Second, db: db}
+}
+
+func (r *readingProgressRepository) Save(ctx context.Context, readingProgress *model.ReadingProgress) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "book_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_page", "percentage", "updated_at"}),
+		}).
+		Create(readingProgress).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// ListInProgressByUserID skips finished books, most recently read books go first
+func (r *readingProgressRepository) ListInProgressByUserID(ctx context.Context, userID uint, page, count uint) ([]model.ReadingProgressWithBook, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var readingProgresses []model.ReadingProgressWithBook
+
+	err := r.db.WithContext(ctx).
+		Model(&model.ReadingProgress{}).
+		Select(model.BookWithAuthorColumns()+
+			", reading_progresses.last_page, reading_progresses.percentage, reading_progresses.created_at, reading_progresses.updated_at").
+		Joins("INNER JOIN books ON reading_progresses.book_id = books.id AND books.deleted_at IS NULL").
+		Joins("LEFT JOIN authors ON books.author_id = authors.id").
+		Where("reading_progresses.user_id = ?", userID).
+		Where("reading_progresses.percentage < 100").
+		Order("reading_progresses.updated_at DESC").
+		Limit(int(count)).
+		Offset(int((page - 1) * count)).
+		Scan(&readingProgresses).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+
+	return readingProgresses, nil
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type RevisionRepository interface {
+	WithinTX(tx *gorm.DB) RevisionRepository
+	Create(ctx context.Context, revision *model.Revision) error
+	FindByID(ctx context.Context, revisionID uint) (*model.Revision, error)
+	ListByEntity(ctx context.Context, entityType string, entityID, page, count uint) ([]model.Revision, error)
+}
+
+type revisionRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewRevisionRepository(db *gorm.DB) RevisionRepository {
+	return &revisionRepository{name: "Revision(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *revisionRepository) WithinTX(tx *gorm.DB) RevisionRepository {
+	return &revisionRepository{name: "Revision(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *revisionRepository) Create(ctx context.Context, revision *model.Revision) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Create(revision).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *revisionRepository) FindByID(ctx context.Context, revisionID uint) (*model.Revision, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var revision model.Revision
+	if err := r.db.WithContext(ctx).Where("id = ?", revisionID).First(&revision).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &revision, nil
+}
+
+// ListByEntity returns the newest revisions first
+func (r *revisionRepository) ListByEntity(ctx context.Context, entityType string, entityID, page, count uint) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var revisions []model.Revision
+	offset := (page - 1) * count
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("created_at DESC, id DESC").
+		Offset(int(offset)).
+		Limit(int(count)).
+		Find(&revisions).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return revisions, nil
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type SuggestionRepository interface {
+	List(ctx context.Context, query string, count int) ([]model.Suggestion, error)
+	FindClosestTitle(ctx context.Context, title string) (string, error)
+	FindClosestAuthorName(ctx context.Context, authorName string) (string, error)
+}
+
+type suggestionRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+// NewSuggestionRepository uses a shorter timeout, suggestions are requested on every keystroke
+// and a late one is of no use anymore
+func NewSuggestionRepository(db *gorm.DB) SuggestionRepository {
+	return &suggestionRepository{name: "Suggestion(s)", timeout: 300 * time.Millisecond, db: db}
+}
+
+// List matches titles, author names and aliases containing the query or having a word close to it (pg_trgm),
+// prefix matches go first
+func (r *suggestionRepository) List(ctx context.Context, query string, count int) ([]model.Suggestion, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sqlQuery := `
+		SELECT type, text, entity_id FROM (
+			SELECT @titleType AS type, b.title AS text, b.id AS entity_id,
+				(b.title ILIKE @prefix)::int + word_similarity(@query, b.title) AS score
+			FROM books b
+			WHERE b.deleted_at IS NULL AND (b.title ILIKE @substring OR @query <% b.title)
+			UNION ALL
+			SELECT @authorType, a.fullname, a.id,
+				(a.fullname ILIKE @prefix)::int + word_similarity(@query, a.fullname)
+			FROM authors a
+			WHERE a.deleted_at IS NULL AND (a.fullname ILIKE @substring OR @query <% a.fullname)
+			UNION ALL
+			SELECT @authorType, aa.name, a.id,
+				(aa.name ILIKE @prefix)::int + word_similarity(@query, aa.name)
+			FROM author_aliases aa
+			INNER JOIN authors a ON a.id = aa.author_id AND a.deleted_at IS NULL
+			WHERE aa.name ILIKE @substring OR @query <% aa.name
+		) suggestions
+		ORDER BY score DESC, text, entity_id
+		LIMIT @count
+	`
+
+	var suggestions []model.Suggestion
+	err := r.db.WithContext(ctx).Raw(sqlQuery,
+		sql.Named("titleType", domain.SuggestionTypeTitle),
+		sql.Named("authorType", domain.SuggestionTypeAuthor),
+		sql.Named("query", query),
+		sql.Named("prefix", query+"%"),
+		sql.Named("substring", "%"+query+"%"),
+		sql.Named("count", count),
+	).Scan(&suggestions).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return suggestions, nil
+}
+
+// FindClosestTitle returns an empty string when no title is similar enough
+func (r *suggestionRepository) FindClosestTitle(ctx context.Context, title string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sqlQuery := `
+		SELECT title FROM books
+		WHERE deleted_at IS NULL AND ? <% title
+		ORDER BY word_similarity(?, title) DESC, title
+		LIMIT 1
+	`
+
+	var closestTitle string
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, title, title).Scan(&closestTitle).Error; err != nil {
+		return "", postgresInfrastructure.NewError(err, r.name)
+	}
+	return closestTitle, nil
+}
+
+// FindClosestAuthorName looks through aliases as well, as the search does. An empty string means no name is similar enough
+func (r *suggestionRepository) FindClosestAuthorName(ctx context.Context, authorName string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sqlQuery := `
+		SELECT name FROM (
+			SELECT a.fullname AS name FROM authors a
+			WHERE a.deleted_at IS NULL
+			UNION
+			SELECT aa.name FROM author_aliases aa
+			INNER JOIN authors a ON a.id = aa.author_id AND a.deleted_at IS NULL
+		) names
+		WHERE ? <% name
+		ORDER BY word_similarity(?, name) DESC, name
+		LIMIT 1
+	`
+
+	var closestAuthorName string
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, authorName, authorName).Scan(&closestAuthorName).Error; err != nil {
+		return "", postgresInfrastructure.NewError(err, r.name)
+	}
+	return closestAuthorName, nil
+}
@@ -21,16 +21,42 @@ const (
 
 	POPULAR_BOOKS_KEY   = "books:popular"
 	POPULAR_BOOKS_COUNT = 10
+
+	BOOK_KEY_EXPIRATION = time.Minute * 15
+
+	DAILY_VIEWS_KEY_EXPIRATION = time.Hour * 48
+
+	TRENDING_BUCKET_EXPIRATION = time.Hour * 24 * 31
+	TRENDING_WINDOW_EXPIRATION = time.Minute
+
+	ANONYMOUS_VIEWS_LIMIT          = 60
+	ANONYMOUS_VIEWS_KEY_EXPIRATION = time.Hour
 )
 
 type BookRepository interface {
 	SetCategories(ctx context.Context, categories []string) error
 	GetBookCategories(ctx context.Context) ([]string, error)
+	DeleteCategories(ctx context.Context) error
 	SetNew(ctx context.Context, newBooks []model.BookWithAuthor) error
 	GetNew(ctx context.Context) ([]model.BookWithAuthor, error)
-	UpdateViewsCount(ctx context.Context, bookID, userID uint) error
+	DeleteNew(ctx context.Context) error
+	SetBook(ctx context.Context, book *model.BookWithAuthor) error
+	GetBook(ctx context.Context, bookID uint) (*model.BookWithAuthor, error)
+	DeleteBooks(ctx context.Context, bookIDs []uint) error
+	UpdateViewsCount(ctx context.Context, bookID, categoryID uint, viewer string, viewedAt time.Time) error
+	AllowAnonymousView(ctx context.Context, clientIP string, viewedAt time.Time) (bool, error)
 	GetViewsCount(ctx context.Context, bookID uint) (int64, error)
 	GetPopularBookIDs(ctx context.Context) ([]string, error)
+	GetTrendingBookIDs(ctx context.Context, categoryIDs []uint, days int, now time.Time, count int) ([]string, error)
+	DeletePopular(ctx context.Context, bookIDs []uint) error
+	RestorePopular(ctx context.Context, bookIDs []uint) error
+	DeleteTrending(ctx context.Context, trendingBooks []model.TrendingBook, days int, now time.Time) error
+	RestoreTrending(ctx context.Context, bookIDs []uint) error
+	DeleteViews(ctx context.Context, bookID uint) error
+	GetViews(ctx context.Context, bookIDs []uint) ([]model.BookViews, error)
+	GetDailyViews(ctx context.Context, day time.Time) ([]model.BookViews, error)
+	RestorePopularViews(ctx context.Context, views []model.BookViews) error
+	RestoreDailyViews(ctx context.Context, day time.Time, views []model.BookDailyViews) error
 }
 
 type bookRepository struct {
@@ -76,6 +102,16 @@ func (r *bookRepository) GetBookCategories(ctx context.Context) ([]string, error
 	return categories, nil
 }
 
+func (r *bookRepository) DeleteCategories(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.rdb.Del(ctx, CATEGORIES_KEY).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
 func (r *bookRepository) SetNew(ctx context.Context, newBooks []model.BookWithAuthor) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -110,12 +146,75 @@ func (r *bookRepository) GetNew(ctx context.Context) ([]model.BookWithAuthor, er
 	return newBooks, nil
 }
 
-func (r *bookRepository) UpdateViewsCount(ctx context.Context, bookID, userID uint) error {
+func (r *bookRepository) DeleteNew(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.rdb.Del(ctx, NEW_BOOKS_KEY).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+func (r *bookRepository) SetBook(ctx context.Context, book *model.BookWithAuthor) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	bookBytes, err := json.Marshal(book)
+	if err != nil {
+		return err
+	}
+
+	if err := r.rdb.Set(ctx, bookKey(book.ID), bookBytes, BOOK_KEY_EXPIRATION).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+func (r *bookRepository) GetBook(ctx context.Context, bookID uint) (*model.BookWithAuthor, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	bookViewsCountKey := fmt.Sprintf("books:%d:views", bookID)
-	addedCount, err := r.rdb.PFAdd(ctx, bookViewsCountKey, userID).Result()
+	bookString, err := r.rdb.Get(ctx, bookKey(bookID)).Result()
+	if err != nil {
+		if redisInfrastructure.IsNil(err) {
+			return nil, nil
+		}
+		return nil, redisInfrastructure.NewError(err)
+	}
+
+	var book model.BookWithAuthor
+	if err := json.Unmarshal([]byte(bookString), &book); err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+func (r *bookRepository) DeleteBooks(ctx context.Context, bookIDs []uint) error {
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	keys := make([]string, len(bookIDs))
+	for i, bookID := range bookIDs {
+		keys[i] = bookKey(bookID)
+	}
+	if err := r.rdb.Del(ctx, keys...).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+// UpdateViewsCount counts a viewer once for all-time popularity and once a day for the trending buckets
+func (r *bookRepository) UpdateViewsCount(ctx context.Context, bookID, categoryID uint, viewer string, viewedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	bookViewsCountKey := bookViewsKey(bookID)
+	addedCount, err := r.rdb.PFAdd(ctx, bookViewsCountKey, viewer).Result()
 	if err != nil {
 		return redisInfrastructure.NewError(err)
 	}
@@ -125,14 +224,60 @@ func (r *bookRepository) UpdateViewsCount(ctx context.Context, bookID, userID ui
 			return redisInfrastructure.NewError(err)
 		}
 	}
+
+	day := viewedAt.UTC().Format(time.DateOnly)
+	bookDailyViewsCountKey := bookDailyViewsKey(bookID, day)
+	addedDailyCount, err := r.rdb.PFAdd(ctx, bookDailyViewsCountKey, viewer).Result()
+	if err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	if err := r.rdb.Expire(ctx, bookDailyViewsCountKey, DAILY_VIEWS_KEY_EXPIRATION).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	if addedDailyCount == 0 {
+		return nil
+	}
+
+	trendingBucketKeys := []string{trendingBucketKey(day)}
+	if categoryID > 0 {
+		trendingBucketKeys = append(trendingBucketKeys, categoryTrendingBucketKey(day, categoryID))
+	}
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, trendingBucketKey := range trendingBucketKeys {
+			pipe.ZIncrBy(ctx, trendingBucketKey, 1, strconv.Itoa(int(bookID)))
+			pipe.Expire(ctx, trendingBucketKey, TRENDING_BUCKET_EXPIRATION)
+		}
+		return nil
+	})
+	if err != nil {
+		return redisInfrastructure.NewError(err)
+	}
 	return nil
 }
 
+// AllowAnonymousView counts anonymous views of a client address per hour and tells whether it's still under the limit
+func (r *bookRepository) AllowAnonymousView(ctx context.Context, clientIP string, viewedAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	anonymousViewsKey := anonymousViewsKey(clientIP, viewedAt.UTC().Format("2006-01-02T15"))
+	viewsCount, err := r.rdb.Incr(ctx, anonymousViewsKey).Result()
+	if err != nil {
+		return false, redisInfrastructure.NewError(err)
+	}
+	if viewsCount == 1 {
+		if err := r.rdb.Expire(ctx, anonymousViewsKey, ANONYMOUS_VIEWS_KEY_EXPIRATION).Err(); err != nil {
+			return false, redisInfrastructure.NewError(err)
+		}
+	}
+	return viewsCount <= ANONYMOUS_VIEWS_LIMIT, nil
+}
+
 func (r *bookRepository) GetViewsCount(ctx context.Context, bookID uint) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	bookViewsCountKey := fmt.Sprintf("books:%d:views", bookID)
+	bookViewsCountKey := bookViewsKey(bookID)
 	bookViewsCount, err := r.rdb.PFCount(ctx, bookViewsCountKey).Result()
 	if err != nil {
 		return 0, redisInfrastructure.NewError(err)
@@ -160,6 +305,357 @@ func (r *bookRepository) GetPopularBookIDs(ctx context.Context) ([]string, error
 	return popularBookIDs, nil
 }
 
+// GetTrendingBookIDs sums the daily buckets of the window ending at now. Without category IDs the whole
+// catalog is ranked, the union is kept for a short while so it isn't rebuilt on every request
+func (r *bookRepository) GetTrendingBookIDs(ctx context.Context, categoryIDs []uint, days int, now time.Time, count int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	windowKey := trendingWindowKey(days)
+	if len(categoryIDs) > 0 {
+		windowKey = categoryTrendingWindowKey(days, categoryIDs[0])
+	}
+
+	windowKeyExists, err := r.rdb.Exists(ctx, windowKey).Result()
+	if err != nil {
+		return nil, redisInfrastructure.NewError(err)
+	}
+	if windowKeyExists == 0 {
+		bucketKeys := make([]string, 0, days*max(len(categoryIDs), 1))
+		for i := range days {
+			day := now.UTC().AddDate(0, 0, -i).Format(time.DateOnly)
+			if len(categoryIDs) == 0 {
+				bucketKeys = append(bucketKeys, trendingBucketKey(day))
+				continue
+			}
+			for _, categoryID := range categoryIDs {
+				bucketKeys = append(bucketKeys, categoryTrendingBucketKey(day, categoryID))
+			}
+		}
+
+		if err := r.rdb.ZUnionStore(ctx, windowKey, &redis.ZStore{Keys: bucketKeys}).Err(); err != nil {
+			return nil, redisInfrastructure.NewError(err)
+		}
+		if err := r.rdb.Expire(ctx, windowKey, TRENDING_WINDOW_EXPIRATION).Err(); err != nil {
+			return nil, redisInfrastructure.NewError(err)
+		}
+	}
+
+	trendingBookIDs, err := r.rdb.ZRangeArgs(ctx, redis.ZRangeArgs{
+		Key:   windowKey,
+		Start: 0,
+		Stop:  count - 1,
+		Rev:   true,
+	}).Result()
+	if err != nil {
+		if redisInfrastructure.IsNil(err) {
+			return nil, nil
+		}
+		return nil, redisInfrastructure.NewError(err)
+	}
+	return trendingBookIDs, nil
+}
+
+// DeletePopular drops books from the popular ranking, their views are kept so they can be restored
+func (r *bookRepository) DeletePopular(ctx context.Context, bookIDs []uint) error {
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	members := make([]any, len(bookIDs))
+	for i, bookID := range bookIDs {
+		members[i] = strconv.Itoa(int(bookID))
+	}
+	if err := r.rdb.ZRem(ctx, POPULAR_BOOKS_KEY, members...).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+// RestorePopular puts books back into the popular ranking, scored by their unique views
+func (r *bookRepository) RestorePopular(ctx context.Context, bookIDs []uint) error {
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	members := make([]redis.Z, 0, len(bookIDs))
+	for _, bookID := range bookIDs {
+		viewsCount, err := r.rdb.PFCount(ctx, bookViewsKey(bookID)).Result()
+		if err != nil {
+			return redisInfrastructure.NewError(err)
+		}
+		if viewsCount > 0 {
+			members = append(members, redis.Z{Score: float64(viewsCount), Member: strconv.Itoa(int(bookID))})
+		}
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	if err := r.rdb.ZAdd(ctx, POPULAR_BOOKS_KEY, members...).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+// DeleteTrending takes books out of the trending buckets of the last days.
+// Their scores are kept aside, so RestoreTrending can put them back
+func (r *bookRepository) DeleteTrending(ctx context.Context, trendingBooks []model.TrendingBook, days int, now time.Time) error {
+	if len(trendingBooks) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	for _, trendingBook := range trendingBooks {
+		member := strconv.Itoa(int(trendingBook.BookID))
+
+		bucketKeys := make([]string, 0, days*2)
+		for i := range days {
+			day := now.UTC().AddDate(0, 0, -i).Format(time.DateOnly)
+			bucketKeys = append(bucketKeys, trendingBucketKey(day))
+			if trendingBook.CategoryID > 0 {
+				bucketKeys = append(bucketKeys, categoryTrendingBucketKey(day, trendingBook.CategoryID))
+			}
+		}
+
+		scoreCmds := make([]*redis.FloatCmd, len(bucketKeys))
+		_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, bucketKey := range bucketKeys {
+				scoreCmds[i] = pipe.ZScore(ctx, bucketKey, member)
+			}
+			return nil
+		})
+		if err != nil && !redisInfrastructure.IsNil(err) {
+			return redisInfrastructure.NewError(err)
+		}
+
+		scores := make(map[string]any)
+		for i, scoreCmd := range scoreCmds {
+			score, err := scoreCmd.Result()
+			if redisInfrastructure.IsNil(err) {
+				continue
+			}
+			if err != nil {
+				return redisInfrastructure.NewError(err)
+			}
+			scores[bucketKeys[i]] = score
+		}
+		if len(scores) == 0 {
+			continue
+		}
+
+		trashedKey := trashedTrendingKey(trendingBook.BookID)
+		_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, trashedKey, scores)
+			pipe.Expire(ctx, trashedKey, TRENDING_BUCKET_EXPIRATION)
+			for bucketKey := range scores {
+				pipe.ZRem(ctx, bucketKey, member)
+			}
+			return nil
+		})
+		if err != nil {
+			return redisInfrastructure.NewError(err)
+		}
+	}
+	return nil
+}
+
+// RestoreTrending puts books back into the trending buckets they were taken out of on trash
+func (r *bookRepository) RestoreTrending(ctx context.Context, bookIDs []uint) error {
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	for _, bookID := range bookIDs {
+		trashedKey := trashedTrendingKey(bookID)
+		scores, err := r.rdb.HGetAll(ctx, trashedKey).Result()
+		if err != nil {
+			return redisInfrastructure.NewError(err)
+		}
+		if len(scores) == 0 {
+			continue
+		}
+
+		member := strconv.Itoa(int(bookID))
+		_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for bucketKey, scoreString := range scores {
+				score, err := strconv.ParseFloat(scoreString, 64)
+				if err != nil {
+					return err
+				}
+				pipe.ZIncrBy(ctx, bucketKey, score, member)
+				pipe.ExpireNX(ctx, bucketKey, TRENDING_BUCKET_EXPIRATION)
+			}
+			pipe.Del(ctx, trashedKey)
+			return nil
+		})
+		if err != nil {
+			return redisInfrastructure.NewError(err)
+		}
+	}
+	return nil
+}
+
+func (r *bookRepository) DeleteViews(ctx context.Context, bookID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.rdb.Del(ctx, bookViewsKey(bookID), trashedTrendingKey(bookID)).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+func (r *bookRepository) GetViews(ctx context.Context, bookIDs []uint) ([]model.BookViews, error) {
+	if len(bookIDs) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	viewsCountCmds := make([]*redis.IntCmd, len(bookIDs))
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, bookID := range bookIDs {
+			viewsCountCmds[i] = pipe.PFCount(ctx, bookViewsKey(bookID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, redisInfrastructure.NewError(err)
+	}
+
+	views := make([]model.BookViews, len(bookIDs))
+	for i, bookID := range bookIDs {
+		views[i] = model.BookViews{BookID: bookID, UniqueViewers: viewsCountCmds[i].Val()}
+	}
+	return views, nil
+}
+
+// GetDailyViews reads unique viewers of the day from its trending bucket
+func (r *bookRepository) GetDailyViews(ctx context.Context, day time.Time) ([]model.BookViews, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	members, err := r.rdb.ZRangeWithScores(ctx, trendingBucketKey(day.UTC().Format(time.DateOnly)), 0, -1).Result()
+	if err != nil {
+		if redisInfrastructure.IsNil(err) {
+			return nil, nil
+		}
+		return nil, redisInfrastructure.NewError(err)
+	}
+
+	views := make([]model.BookViews, 0, len(members))
+	for _, member := range members {
+		bookID, err := strconv.ParseUint(member.Member.(string), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, model.BookViews{BookID: uint(bookID), UniqueViewers: int64(member.Score)})
+	}
+	return views, nil
+}
+
+// RestorePopularViews only raises scores, so views counted since Redis came back aren't lost
+func (r *bookRepository) RestorePopularViews(ctx context.Context, views []model.BookViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	members := make([]redis.Z, len(views))
+	for i := range views {
+		members[i] = redis.Z{Score: float64(views[i].UniqueViewers), Member: strconv.Itoa(int(views[i].BookID))}
+	}
+	if err := r.rdb.ZAddGT(ctx, POPULAR_BOOKS_KEY, members...).Err(); err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+// RestoreDailyViews refills the trending buckets of the day, they expire as if they were filled on that day
+func (r *bookRepository) RestoreDailyViews(ctx context.Context, day time.Time, views []model.BookDailyViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	dayString := day.UTC().Format(time.DateOnly)
+	membersByKey := make(map[string][]redis.Z)
+	for i := range views {
+		member := redis.Z{Score: float64(views[i].UniqueViewers), Member: strconv.Itoa(int(views[i].BookID))}
+		membersByKey[trendingBucketKey(dayString)] = append(membersByKey[trendingBucketKey(dayString)], member)
+		if views[i].CategoryID > 0 {
+			categoryBucketKey := categoryTrendingBucketKey(dayString, views[i].CategoryID)
+			membersByKey[categoryBucketKey] = append(membersByKey[categoryBucketKey], member)
+		}
+	}
+
+	expireAt := day.UTC().Truncate(24 * time.Hour).Add(TRENDING_BUCKET_EXPIRATION)
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, members := range membersByKey {
+			pipe.ZAddGT(ctx, key, members...)
+			pipe.ExpireAt(ctx, key, expireAt)
+		}
+		return nil
+	})
+	if err != nil {
+		return redisInfrastructure.NewError(err)
+	}
+	return nil
+}
+
+func bookKey(bookID uint) string {
+	return fmt.Sprintf("books:%d", bookID)
+}
+
+func bookViewsKey(bookID uint) string {
+	return fmt.Sprintf("books:%d:views", bookID)
+}
+
+func bookDailyViewsKey(bookID uint, day string) string {
+	return fmt.Sprintf("books:%d:views:%s", bookID, day)
+}
+
+func anonymousViewsKey(clientIP, hour string) string {
+	return fmt.Sprintf("views:anonymous:%s:%s", clientIP, hour)
+}
+
+func trendingBucketKey(day string) string {
+	return fmt.Sprintf("books:trending:%s", day)
+}
+
+func categoryTrendingBucketKey(day string, categoryID uint) string {
+	return fmt.Sprintf("books:trending:%s:categories:%d", day, categoryID)
+}
+
+func trashedTrendingKey(bookID uint) string {
+	return fmt.Sprintf("books:%d:trending:trashed", bookID)
+}
+
+func trendingWindowKey(days int) string {
+	return fmt.Sprintf("books:trending:window:%d", days)
+}
+
+func categoryTrendingWindowKey(days int, categoryID uint) string {
+	return fmt.Sprintf("books:trending:window:%d:categories:%d", days, categoryID)
+}
+
 func stringSliceToAnySlice(slice []string) []any {
 	res := make([]any, len(slice))
 	for i, v := range slice {
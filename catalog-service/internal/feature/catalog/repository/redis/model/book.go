@@ -1,9 +1,29 @@
 package model
 
 type BookWithAuthor struct {
-	ID       uint
+	ID            uint
+	Author        Author
+	Contributors  []Contributor
+	Title         string
+	Year          int
+	Category      string
+	CategoryID    uint
+	Metadata      BookMetadata
+	RatingAverage float64
+	RatingCount   uint
+}
+
+type BookMetadata struct {
+	ISBN        string
+	Publisher   string
+	Language    string
+	PageCount   uint
+	Description string
+	CoverKey    string
+}
+
+type Contributor struct {
 	Author   Author
-	Title    string
-	Year     int
-	Category string
+	Role     string
+	Position uint
 }
@@ -0,0 +1,17 @@
+package model
+
+type BookViews struct {
+	BookID        uint
+	UniqueViewers int64
+}
+
+type BookDailyViews struct {
+	BookID        uint
+	CategoryID    uint
+	UniqueViewers int64
+}
+
+type TrendingBook struct {
+	BookID     uint
+	CategoryID uint
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+)
+
+// GetCategories returns the category tree, roots and children are ordered by name
+func (s *catalogService) GetCategories(ctx context.Context) ([]domain.Category, error) {
+	categoryModels, err := s.postgresCategoryRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildCategoryTree(postgresMapper.CategoryWithBooksCountModelsToDomains(categoryModels), nil), nil
+}
+
+func (s *catalogService) GetCategory(ctx context.Context, categoryID uint) (*domain.Category, error) {
+	categoryModel, err := s.postgresCategoryRepository.FindByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryModels, err := s.postgresCategoryRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoryDomains := postgresMapper.CategoryWithBooksCountModelsToDomains(categoryModels)
+
+	categoryDomain := postgresMapper.CategoryModelToDomain(categoryModel)
+	for i := range categoryDomains {
+		if categoryDomains[i].ID == categoryID {
+			categoryDomain.BooksCount = categoryDomains[i].BooksCount
+		}
+	}
+	categoryDomain.Children = buildCategoryTree(categoryDomains, &categoryID)
+	return &categoryDomain, nil
+}
+
+func (s *catalogService) CreateCategory(ctx context.Context, categoryDomain *domain.Category) error {
+	if err := normalizeCategory(categoryDomain); err != nil {
+		return err
+	}
+
+	if categoryDomain.ParentID != nil {
+		if _, err := s.postgresCategoryRepository.FindByID(ctx, *categoryDomain.ParentID); err != nil {
+			return err
+		}
+	}
+
+	categoryModel := model.Category{
+		ParentID:    categoryDomain.ParentID,
+		Slug:        categoryDomain.Slug,
+		Name:        categoryDomain.Name,
+		Description: categoryDomain.Description,
+	}
+	if err := s.postgresCategoryRepository.Create(ctx, &categoryModel); err != nil {
+		return err
+	}
+	categoryDomain.ID = categoryModel.ID
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeCategories, nil)
+	return nil
+}
+
+// UpdateCategory replaces category fields. A new name is written to the books of the category
+// and published, so subscriptions keep following the category
+func (s *catalogService) UpdateCategory(ctx context.Context, categoryDomain *domain.Category) error {
+	if err := normalizeCategory(categoryDomain); err != nil {
+		return err
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var oldName string
+	var renamedBookIDs []uint
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresCategoryRepositoryTX := s.postgresCategoryRepository.WithinTX(tx)
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		categoryModel, err := postgresCategoryRepositoryTX.FindByID(txCtx, categoryDomain.ID)
+		if err != nil {
+			return err
+		}
+		oldName = categoryModel.Name
+
+		if categoryDomain.ParentID != nil {
+			subtreeIDs, err := postgresCategoryRepositoryTX.ListSubtreeIDs(txCtx, categoryDomain.ID)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(subtreeIDs, *categoryDomain.ParentID) {
+				return errs.NewBadRequestError("Category can't be moved under itself or its subcategory")
+			}
+			if _, err := postgresCategoryRepositoryTX.FindByID(txCtx, *categoryDomain.ParentID); err != nil {
+				return err
+			}
+		}
+
+		categoryModel.ParentID = categoryDomain.ParentID
+		categoryModel.Slug = categoryDomain.Slug
+		categoryModel.Name = categoryDomain.Name
+		categoryModel.Description = categoryDomain.Description
+		if err := postgresCategoryRepositoryTX.Update(txCtx, categoryModel); err != nil {
+			return err
+		}
+
+		if oldName == categoryDomain.Name {
+			return nil
+		}
+		renamedBookIDs, err = postgresBookRepositoryTX.RenameCategory(txCtx, categoryDomain.ID, categoryDomain.Name)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeCategories, nil)
+
+	if oldName == categoryDomain.Name {
+		return nil
+	}
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, renamedBookIDs)
+
+	categoryRenamedEvent, err := json.Marshal(event.CategoryRenamed{
+		ID:      categoryDomain.ID,
+		Slug:    categoryDomain.Slug,
+		OldName: oldName,
+		NewName: categoryDomain.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	kafkaCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := s.categoryRenamedWriter.WriteMessages(kafkaCtx, kafka.Message{Value: categoryRenamedEvent}); err != nil {
+		s.logger.Error(ctx, "Category renamed event write error", logging.Error(err))
+	}
+
+	return nil
+}
+
+// DeleteCategory only removes empty leaf categories, books and subcategories have to be moved first
+func (s *catalogService) DeleteCategory(ctx context.Context, categoryID uint) error {
+	if _, err := s.postgresCategoryRepository.FindByID(ctx, categoryID); err != nil {
+		return err
+	}
+
+	childrenCount, err := s.postgresCategoryRepository.CountChildren(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if childrenCount > 0 {
+		return errs.NewBadRequestError("Category has subcategories")
+	}
+
+	booksCount, err := s.postgresBookRepository.CountByCategoryID(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if booksCount > 0 {
+		return errs.NewBadRequestError("Category has books")
+	}
+
+	if err := s.postgresCategoryRepository.Delete(ctx, categoryID); err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeCategories, nil)
+	return nil
+}
+
+func normalizeCategory(categoryDomain *domain.Category) error {
+	categoryDomain.Name = strings.TrimSpace(categoryDomain.Name)
+	categoryDomain.Description = strings.TrimSpace(categoryDomain.Description)
+
+	if categoryDomain.Slug == "" {
+		categoryDomain.Slug = categoryDomain.Name
+	}
+	categoryDomain.Slug = slugify(categoryDomain.Slug)
+	if categoryDomain.Slug == "" {
+		return errs.NewBadRequestError("Category slug must contain latin letters or digits")
+	}
+
+	if categoryDomain.ParentID != nil && *categoryDomain.ParentID == categoryDomain.ID {
+		return errs.NewBadRequestError("Category can't be its own parent")
+	}
+	return nil
+}
+
+// slugify lowercases the value and joins runs of latin letters and digits with dashes
+func slugify(value string) string {
+	var builder strings.Builder
+	pendingDash := false
+
+	for _, r := range strings.ToLower(value) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingDash = false
+			builder.WriteRune(r)
+			continue
+		}
+		pendingDash = true
+	}
+	return builder.String()
+}
+
+func buildCategoryTree(categoryDomains []domain.Category, parentID *uint) []domain.Category {
+	children := []domain.Category{}
+	for _, categoryDomain := range categoryDomains {
+		if !sameParent(categoryDomain.ParentID, parentID) {
+			continue
+		}
+		categoryDomain.Children = buildCategoryTree(categoryDomains, &categoryDomain.ID)
+		children = append(children, categoryDomain)
+	}
+	return children
+}
+
+func sameParent(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+	"gorm.io/gorm"
+)
+
+const COVER_ENTITY_NAME = "Book cover"
+
+// coverExtensions lists accepted cover image types, detected from the content instead of the client supplied header
+var coverExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+func (s *catalogService) GetBookCover(ctx context.Context, bookID uint) (io.ReadCloser, string, error) {
+	bookWithAuthorModel, err := s.postgresBookRepository.FindByID(ctx, bookID)
+	if err != nil {
+		return nil, "", err
+	}
+	if bookWithAuthorModel.CoverKey == "" {
+		return nil, "", errs.NewEntityNotFoundError(COVER_ENTITY_NAME)
+	}
+
+	content, err := s.blobStorage.Get(ctx, bookWithAuthorModel.CoverKey)
+	if err != nil {
+		return nil, "", blob.NewError(err, COVER_ENTITY_NAME)
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(bookWithAuthorModel.CoverKey))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return content, contentType, nil
+}
+
+// SetBookCover stores the image under a new key every time, so cached old covers are never served for the new one
+func (s *catalogService) SetBookCover(ctx context.Context, bookID uint, content io.Reader, userID uint) (*domain.Book, error) {
+	bookWithAuthorModel, err := s.postgresBookRepository.FindByID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errs.NewBadRequestError("Cover image is empty")
+	}
+	head = head[:n]
+
+	extension, ok := coverExtensions[http.DetectContentType(head)]
+	if !ok {
+		return nil, errs.NewBadRequestError("Cover must be a JPEG, PNG or WebP image")
+	}
+
+	coverKey := fmt.Sprintf("covers/%d-%d%s", bookID, time.Now().UnixNano(), extension)
+	if err := s.blobStorage.Put(ctx, coverKey, io.MultiReader(bytes.NewReader(head), content)); err != nil {
+		return nil, blob.NewError(err, COVER_ENTITY_NAME)
+	}
+
+	updatedBookWithAuthorModel, err := s.updateCoverKey(ctx, bookWithAuthorModel, coverKey, userID)
+	if err != nil {
+		s.deleteCoverBlob(ctx, coverKey)
+		return nil, err
+	}
+	s.deleteCoverBlob(ctx, bookWithAuthorModel.CoverKey)
+
+	bookDomain := postgresMapper.BookWithAuthorModelToDomain(updatedBookWithAuthorModel)
+	return &bookDomain, nil
+}
+
+func (s *catalogService) DeleteBookCover(ctx context.Context, bookID, userID uint) error {
+	bookWithAuthorModel, err := s.postgresBookRepository.FindByID(ctx, bookID)
+	if err != nil {
+		return err
+	}
+	if bookWithAuthorModel.CoverKey == "" {
+		return errs.NewEntityNotFoundError(COVER_ENTITY_NAME)
+	}
+
+	if _, err := s.updateCoverKey(ctx, bookWithAuthorModel, "", userID); err != nil {
+		return err
+	}
+
+	s.deleteCoverBlob(ctx, bookWithAuthorModel.CoverKey)
+	return nil
+}
+
+// updateCoverKey records the change in the book history together with the new key
+func (s *catalogService) updateCoverKey(ctx context.Context, bookWithAuthorModel *model.BookWithAuthor, coverKey string, userID uint) (*model.BookWithAuthor, error) {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var updatedBookWithAuthorModel *model.BookWithAuthor
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		if err := postgresBookRepositoryTX.UpdateCoverKey(txCtx, bookWithAuthorModel.ID, coverKey); err != nil {
+			return err
+		}
+
+		var err error
+		updatedBookWithAuthorModel, err = postgresBookRepositoryTX.FindByID(txCtx, bookWithAuthorModel.ID)
+		if err != nil {
+			return err
+		}
+		return s.recordBookRevision(txCtx, tx, bookWithAuthorModel.ID, domain.RevisionActionUpdate, userID, bookWithAuthorModel, updatedBookWithAuthorModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookWithAuthorModel.ID})
+	return updatedBookWithAuthorModel, nil
+}
+
+// deleteCoverBlob only logs failures, a leftover blob isn't referenced by any book anymore
+func (s *catalogService) deleteCoverBlob(ctx context.Context, coverKey string) {
+	if coverKey == "" {
+		return
+	}
+	if err := s.blobStorage.Delete(ctx, coverKey); err != nil {
+		s.logger.Warn(ctx, "Skip delete book cover", logging.String("coverKey", coverKey), logging.Error(err))
+	}
+}
@@ -0,0 +1,31 @@
+package kafka
+
+import (
+	sharedEvent "github.com/Yarik7610/library-backend-common/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+)
+
+func BookDomainToBookAddedEvent(bookDomain *domain.Book) event.BookAdded {
+	contributorEvents := make([]event.BookContributor, len(bookDomain.Contributors))
+	for i := range bookDomain.Contributors {
+		contributorEvents[i] = event.BookContributor{
+			AuthorID:       bookDomain.Contributors[i].Author.ID,
+			AuthorFullname: bookDomain.Contributors[i].Author.Fullname,
+			Role:           bookDomain.Contributors[i].Role,
+			Position:       bookDomain.Contributors[i].Position,
+		}
+	}
+
+	return event.BookAdded{
+		BookAdded: sharedEvent.BookAdded{
+			ID:             bookDomain.ID,
+			AuthorID:       bookDomain.Author.ID,
+			AuthorFullname: bookDomain.Author.Fullname,
+			Title:          bookDomain.Title,
+			Year:           bookDomain.Year,
+			Category:       bookDomain.Category,
+		},
+		Contributors: contributorEvents,
+	}
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+func AuthorModelToDomain(authorModel *model.Author) domain.Author {
+	aliases := make([]string, len(authorModel.Aliases))
+	for i := range authorModel.Aliases {
+		aliases[i] = authorModel.Aliases[i].Name
+	}
+
+	return domain.Author{
+		ID:          authorModel.ID,
+		Fullname:    authorModel.Fullname,
+		Biography:   authorModel.Biography,
+		BirthYear:   authorModel.BirthYear,
+		DeathYear:   authorModel.DeathYear,
+		Nationality: authorModel.Nationality,
+		Aliases:     aliases,
+	}
+}
+
+func AuthorModelsToDomains(authorModels []model.Author) []domain.Author {
+	authorDomains := make([]domain.Author, len(authorModels))
+	for i := range authorModels {
+		authorDomains[i] = AuthorModelToDomain(&authorModels[i])
+	}
+	return authorDomains
+}
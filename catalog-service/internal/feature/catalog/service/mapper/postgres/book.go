@@ -12,9 +12,21 @@ func BookWithAuthorModelToDomain(bookWithAuthorModel *model.BookWithAuthor) doma
 			ID:       bookWithAuthorModel.AuthorID,
 			Fullname: bookWithAuthorModel.AuthorFullname,
 		},
-		Title:    bookWithAuthorModel.Title,
-		Year:     bookWithAuthorModel.Year,
-		Category: bookWithAuthorModel.Category,
+		Contributors: BookContributorWithAuthorModelsToDomains(bookWithAuthorModel.Contributors),
+		Title:        bookWithAuthorModel.Title,
+		Year:         bookWithAuthorModel.Year,
+		Category:     bookWithAuthorModel.Category,
+		CategoryID:   derefUint(bookWithAuthorModel.CategoryID),
+		Metadata: domain.BookMetadata{
+			ISBN:        derefString(bookWithAuthorModel.ISBN),
+			Publisher:   bookWithAuthorModel.Publisher,
+			Language:    bookWithAuthorModel.Language,
+			PageCount:   bookWithAuthorModel.PageCount,
+			Description: bookWithAuthorModel.Description,
+			CoverKey:    bookWithAuthorModel.CoverKey,
+		},
+		RatingAverage: bookWithAuthorModel.RatingAverage,
+		RatingCount:   bookWithAuthorModel.RatingCount,
 	}
 }
 
@@ -25,3 +37,58 @@ func BookWithAuthorModelsToDomains(bookWithAuthorModels []model.BookWithAuthor)
 	}
 	return bookDomains
 }
+
+// BookMetadataDomainToModel fills metadata columns of the book model, missing ISBN is stored as NULL to keep it unique
+func BookMetadataDomainToModel(bookModel *model.Book, metadataDomain *domain.BookMetadata) {
+	bookModel.ISBN = nil
+	if metadataDomain.ISBN != "" {
+		isbn := metadataDomain.ISBN
+		bookModel.ISBN = &isbn
+	}
+	bookModel.Publisher = metadataDomain.Publisher
+	bookModel.Language = metadataDomain.Language
+	bookModel.PageCount = metadataDomain.PageCount
+	bookModel.Description = metadataDomain.Description
+}
+
+func BookContributorWithAuthorModelsToDomains(contributorModels []model.BookContributorWithAuthor) []domain.Contributor {
+	contributorDomains := make([]domain.Contributor, len(contributorModels))
+	for i := range contributorModels {
+		contributorDomains[i] = domain.Contributor{
+			Author: domain.Author{
+				ID:       contributorModels[i].AuthorID,
+				Fullname: contributorModels[i].AuthorFullname,
+			},
+			Role:     contributorModels[i].Role,
+			Position: contributorModels[i].Position,
+		}
+	}
+	return contributorDomains
+}
+
+func ContributorDomainsToModels(bookID uint, contributorDomains []domain.Contributor) []model.BookContributor {
+	contributorModels := make([]model.BookContributor, len(contributorDomains))
+	for i := range contributorDomains {
+		contributorModels[i] = model.BookContributor{
+			BookID:   bookID,
+			AuthorID: contributorDomains[i].Author.ID,
+			Role:     contributorDomains[i].Role,
+			Position: contributorDomains[i].Position,
+		}
+	}
+	return contributorModels
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefUint(u *uint) uint {
+	if u == nil {
+		return 0
+	}
+	return *u
+}
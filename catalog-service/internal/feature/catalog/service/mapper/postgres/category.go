@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+func CategoryModelToDomain(categoryModel *model.Category) domain.Category {
+	return domain.Category{
+		ID:          categoryModel.ID,
+		ParentID:    categoryModel.ParentID,
+		Slug:        categoryModel.Slug,
+		Name:        categoryModel.Name,
+		Description: categoryModel.Description,
+	}
+}
+
+func CategoryWithBooksCountModelsToDomains(categoryModels []model.CategoryWithBooksCount) []domain.Category {
+	categoryDomains := make([]domain.Category, len(categoryModels))
+	for i := range categoryModels {
+		categoryDomains[i] = CategoryModelToDomain(&categoryModels[i].Category)
+		categoryDomains[i].BooksCount = categoryModels[i].BooksCount
+	}
+	return categoryDomains
+}
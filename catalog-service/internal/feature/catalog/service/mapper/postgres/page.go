@@ -12,3 +12,11 @@ func PageModelToDomain(pageModel *model.Page) domain.Page {
 		Content: pageModel.Content,
 	}
 }
+
+func PageModelsToDomains(pageModels []model.Page) []domain.Page {
+	pageDomains := make([]domain.Page, len(pageModels))
+	for i := range pageModels {
+		pageDomains[i] = PageModelToDomain(&pageModels[i])
+	}
+	return pageDomains
+}
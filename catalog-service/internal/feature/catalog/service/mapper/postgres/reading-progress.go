@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+func ReadingProgressWithBookModelToDomain(readingProgressWithBookModel *model.ReadingProgressWithBook) domain.ReadingProgress {
+	return domain.ReadingProgress{
+		Book:       BookWithAuthorModelToDomain(&readingProgressWithBookModel.BookWithAuthor),
+		LastPage:   readingProgressWithBookModel.LastPage,
+		Percentage: readingProgressWithBookModel.Percentage,
+		StartedAt:  readingProgressWithBookModel.CreatedAt,
+		UpdatedAt:  readingProgressWithBookModel.UpdatedAt,
+	}
+}
+
+func ReadingProgressWithBookModelsToDomains(readingProgressWithBookModels []model.ReadingProgressWithBook) []domain.ReadingProgress {
+	readingProgressDomains := make([]domain.ReadingProgress, len(readingProgressWithBookModels))
+	for i := range readingProgressWithBookModels {
+		readingProgressDomains[i] = ReadingProgressWithBookModelToDomain(&readingProgressWithBookModels[i])
+	}
+	return readingProgressDomains
+}
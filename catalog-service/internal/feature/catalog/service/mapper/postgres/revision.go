@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+// NewBookRevisionModel snapshots the book before and after the change, nil stands for a missing state
+func NewBookRevisionModel(bookID uint, action string, actorUserID uint, before, after *model.BookWithAuthor) (*model.Revision, error) {
+	revisionModel := model.Revision{EntityType: domain.RevisionEntityBook, EntityID: bookID, Action: action, ActorUserID: actorUserID}
+
+	var err error
+	if before != nil {
+		if revisionModel.Before, err = json.Marshal(BookWithAuthorModelToSnapshot(before)); err != nil {
+			return nil, err
+		}
+	}
+	if after != nil {
+		if revisionModel.After, err = json.Marshal(BookWithAuthorModelToSnapshot(after)); err != nil {
+			return nil, err
+		}
+	}
+	return &revisionModel, nil
+}
+
+// NewAuthorRevisionModel snapshots the author before and after the change, nil stands for a missing state
+func NewAuthorRevisionModel(authorID uint, action string, actorUserID uint, before, after *model.Author) (*model.Revision, error) {
+	revisionModel := model.Revision{EntityType: domain.RevisionEntityAuthor, EntityID: authorID, Action: action, ActorUserID: actorUserID}
+
+	var err error
+	if before != nil {
+		if revisionModel.Before, err = json.Marshal(AuthorModelToSnapshot(before)); err != nil {
+			return nil, err
+		}
+	}
+	if after != nil {
+		if revisionModel.After, err = json.Marshal(AuthorModelToSnapshot(after)); err != nil {
+			return nil, err
+		}
+	}
+	return &revisionModel, nil
+}
+
+func BookWithAuthorModelToSnapshot(bookWithAuthorModel *model.BookWithAuthor) model.BookSnapshot {
+	contributorSnapshots := make([]model.ContributorSnapshot, len(bookWithAuthorModel.Contributors))
+	for i, contributorModel := range bookWithAuthorModel.Contributors {
+		contributorSnapshots[i] = model.ContributorSnapshot{
+			AuthorID: contributorModel.AuthorID,
+			Role:     contributorModel.Role,
+			Position: contributorModel.Position,
+		}
+	}
+
+	return model.BookSnapshot{
+		Title:        bookWithAuthorModel.Title,
+		AuthorID:     bookWithAuthorModel.AuthorID,
+		Year:         bookWithAuthorModel.Year,
+		Category:     bookWithAuthorModel.Category,
+		ISBN:         bookWithAuthorModel.ISBN,
+		Publisher:    bookWithAuthorModel.Publisher,
+		Language:     bookWithAuthorModel.Language,
+		PageCount:    bookWithAuthorModel.PageCount,
+		Description:  bookWithAuthorModel.Description,
+		CoverKey:     bookWithAuthorModel.CoverKey,
+		Contributors: contributorSnapshots,
+	}
+}
+
+func AuthorModelToSnapshot(authorModel *model.Author) model.AuthorSnapshot {
+	aliases := make([]string, len(authorModel.Aliases))
+	for i := range authorModel.Aliases {
+		aliases[i] = authorModel.Aliases[i].Name
+	}
+
+	return model.AuthorSnapshot{
+		Fullname:    authorModel.Fullname,
+		Biography:   authorModel.Biography,
+		BirthYear:   authorModel.BirthYear,
+		DeathYear:   authorModel.DeathYear,
+		Nationality: authorModel.Nationality,
+		Aliases:     aliases,
+	}
+}
+
+func BookSnapshotToMetadataDomain(bookSnapshot *model.BookSnapshot) domain.BookMetadata {
+	return domain.BookMetadata{
+		ISBN:        derefString(bookSnapshot.ISBN),
+		Publisher:   bookSnapshot.Publisher,
+		Language:    bookSnapshot.Language,
+		PageCount:   bookSnapshot.PageCount,
+		Description: bookSnapshot.Description,
+	}
+}
+
+func ContributorSnapshotsToDomains(contributorSnapshots []model.ContributorSnapshot) []domain.Contributor {
+	contributorDomains := make([]domain.Contributor, len(contributorSnapshots))
+	for i := range contributorSnapshots {
+		contributorDomains[i] = domain.Contributor{
+			Author:   domain.Author{ID: contributorSnapshots[i].AuthorID},
+			Role:     contributorSnapshots[i].Role,
+			Position: contributorSnapshots[i].Position,
+		}
+	}
+	return contributorDomains
+}
+
+func RevisionModelToDomain(revisionModel *model.Revision) domain.Revision {
+	return domain.Revision{
+		ID:          revisionModel.ID,
+		EntityType:  revisionModel.EntityType,
+		EntityID:    revisionModel.EntityID,
+		Action:      revisionModel.Action,
+		ActorUserID: revisionModel.ActorUserID,
+		Before:      revisionModel.Before,
+		After:       revisionModel.After,
+		CreatedAt:   revisionModel.CreatedAt,
+	}
+}
+
+func RevisionModelsToDomains(revisionModels []model.Revision) []domain.Revision {
+	revisionDomains := make([]domain.Revision, len(revisionModels))
+	for i := range revisionModels {
+		revisionDomains[i] = RevisionModelToDomain(&revisionModels[i])
+	}
+	return revisionDomains
+}
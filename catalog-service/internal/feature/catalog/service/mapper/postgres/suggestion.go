@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+func SuggestionModelToDomain(suggestionModel *model.Suggestion) domain.Suggestion {
+	return domain.Suggestion{
+		Type:     suggestionModel.Type,
+		Text:     suggestionModel.Text,
+		EntityID: suggestionModel.EntityID,
+	}
+}
+
+func SuggestionModelsToDomains(suggestionModels []model.Suggestion) []domain.Suggestion {
+	suggestionDomains := make([]domain.Suggestion, len(suggestionModels))
+	for i := range suggestionModels {
+		suggestionDomains[i] = SuggestionModelToDomain(&suggestionModels[i])
+	}
+	return suggestionDomains
+}
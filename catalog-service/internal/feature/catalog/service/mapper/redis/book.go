@@ -15,11 +15,16 @@ func BookWithAuthorModelsToDomains(bookModels []model.BookWithAuthor) []domain.B
 
 func BookWithAuthorModelToDomain(bookModel *model.BookWithAuthor) domain.Book {
 	return domain.Book{
-		ID:       bookModel.ID,
-		Author:   domain.Author(bookModel.Author),
-		Title:    bookModel.Title,
-		Year:     bookModel.Year,
-		Category: bookModel.Category,
+		ID:            bookModel.ID,
+		Author:        domain.Author{ID: bookModel.Author.ID, Fullname: bookModel.Author.Fullname},
+		Contributors:  contributorModelsToDomains(bookModel.Contributors),
+		Title:         bookModel.Title,
+		Year:          bookModel.Year,
+		Category:      bookModel.Category,
+		CategoryID:    bookModel.CategoryID,
+		Metadata:      domain.BookMetadata(bookModel.Metadata),
+		RatingAverage: bookModel.RatingAverage,
+		RatingCount:   bookModel.RatingCount,
 	}
 }
 
@@ -33,10 +38,39 @@ func BookDomainsToBookWithAuthorModels(bookDomains []domain.Book) []model.BookWi
 
 func BookDomainToBookWithAuthorModel(bookDomain *domain.Book) model.BookWithAuthor {
 	return model.BookWithAuthor{
-		ID:       bookDomain.ID,
-		Author:   model.Author(bookDomain.Author),
-		Title:    bookDomain.Title,
-		Year:     bookDomain.Year,
-		Category: bookDomain.Category,
+		ID:            bookDomain.ID,
+		Author:        model.Author{ID: bookDomain.Author.ID, Fullname: bookDomain.Author.Fullname},
+		Contributors:  contributorDomainsToModels(bookDomain.Contributors),
+		Title:         bookDomain.Title,
+		Year:          bookDomain.Year,
+		Category:      bookDomain.Category,
+		CategoryID:    bookDomain.CategoryID,
+		Metadata:      model.BookMetadata(bookDomain.Metadata),
+		RatingAverage: bookDomain.RatingAverage,
+		RatingCount:   bookDomain.RatingCount,
 	}
 }
+
+func contributorModelsToDomains(contributorModels []model.Contributor) []domain.Contributor {
+	contributorDomains := make([]domain.Contributor, len(contributorModels))
+	for i := range contributorModels {
+		contributorDomains[i] = domain.Contributor{
+			Author:   domain.Author{ID: contributorModels[i].Author.ID, Fullname: contributorModels[i].Author.Fullname},
+			Role:     contributorModels[i].Role,
+			Position: contributorModels[i].Position,
+		}
+	}
+	return contributorDomains
+}
+
+func contributorDomainsToModels(contributorDomains []domain.Contributor) []model.Contributor {
+	contributorModels := make([]model.Contributor, len(contributorDomains))
+	for i := range contributorDomains {
+		contributorModels[i] = model.Contributor{
+			Author:   model.Author{ID: contributorDomains[i].Author.ID, Fullname: contributorDomains[i].Author.Fullname},
+			Role:     contributorDomains[i].Role,
+			Position: contributorDomains[i].Position,
+		}
+	}
+	return contributorModels
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+)
+
+func (s *catalogService) GetReadingProgress(ctx context.Context, userID, page, count uint) ([]domain.ReadingProgress, error) {
+	readingProgressWithBookModels, err := s.postgresReadingProgressRepository.ListInProgressByUserID(ctx, userID, page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.ReadingProgressWithBookModelsToDomains(readingProgressWithBookModels), nil
+}
+
+// saveReadingProgress remembers the last fetched page, so going back to an earlier page moves progress back too
+func (s *catalogService) saveReadingProgress(ctx context.Context, bookID, pageNumber, userID uint) error {
+	pagesCount, err := s.postgresPageRepository.CountByBookID(ctx, bookID)
+	if err != nil {
+		return err
+	}
+
+	percentage := 100.0
+	if pagesCount > 0 {
+		percentage = math.Min(100, math.Round(float64(pageNumber)/float64(pagesCount)*1000)/10)
+	}
+
+	return s.postgresReadingProgressRepository.Save(ctx, &model.ReadingProgress{
+		UserID:     userID,
+		BookID:     bookID,
+		LastPage:   pageNumber,
+		Percentage: percentage,
+	})
+}
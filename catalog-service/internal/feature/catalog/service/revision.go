@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"gorm.io/gorm"
+)
+
+const REVISION_ENTITY_NAME = "Revision"
+
+func (s *catalogService) GetBookHistory(ctx context.Context, bookID, page, count uint) ([]domain.Revision, error) {
+	revisionModels, err := s.postgresRevisionRepository.ListByEntity(ctx, domain.RevisionEntityBook, bookID, page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.RevisionModelsToDomains(revisionModels), nil
+}
+
+// RevertBook brings the metadata and contributors of the book back to the state right after the revision,
+// deletions are reverted to the state before them. Title, year, category and cover aren't reverted
+func (s *catalogService) RevertBook(ctx context.Context, bookID, revisionID, userID uint) (*domain.Book, error) {
+	revisionModel, err := s.postgresRevisionRepository.FindByID(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if revisionModel.EntityType != domain.RevisionEntityBook || revisionModel.EntityID != bookID {
+		return nil, errs.NewEntityNotFoundError(REVISION_ENTITY_NAME)
+	}
+
+	snapshot := revisionModel.After
+	if len(snapshot) == 0 {
+		snapshot = revisionModel.Before
+	}
+	var bookSnapshot model.BookSnapshot
+	if err := json.Unmarshal(snapshot, &bookSnapshot); err != nil {
+		return nil, err
+	}
+
+	metadataDomain := postgresMapper.BookSnapshotToMetadataDomain(&bookSnapshot)
+	contributorDomains := normalizeContributors(postgresMapper.ContributorSnapshotsToDomains(bookSnapshot.Contributors))
+	primaryAuthorIndex := slices.IndexFunc(contributorDomains, func(contributorDomain domain.Contributor) bool {
+		return contributorDomain.Role == domain.ContributorRoleAuthor
+	})
+	if primaryAuthorIndex == -1 {
+		return nil, errs.NewBadRequestError("Revision has no contributor with author role")
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var bookWithAuthorModel *model.BookWithAuthor
+	err = s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresAuthorRepositoryTX := s.postgresAuthorRepository.WithinTX(tx)
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		previousBookWithAuthorModel, err := postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		if err := fillContributorAuthors(txCtx, postgresAuthorRepositoryTX, contributorDomains); err != nil {
+			return err
+		}
+
+		bookModel := model.Book{ID: bookID}
+		postgresMapper.BookMetadataDomainToModel(&bookModel, &metadataDomain)
+		if err := postgresBookRepositoryTX.UpdateMetadata(txCtx, &bookModel); err != nil {
+			return err
+		}
+		if err := s.replaceContributors(txCtx, tx, bookID, contributorDomains[primaryAuthorIndex].Author.ID, contributorDomains); err != nil {
+			return err
+		}
+
+		bookWithAuthorModel, err = postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		return s.recordBookRevision(txCtx, tx, bookID, domain.RevisionActionRevert, userID, previousBookWithAuthorModel, bookWithAuthorModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookID})
+
+	bookDomain := postgresMapper.BookWithAuthorModelToDomain(bookWithAuthorModel)
+	return &bookDomain, nil
+}
+
+// replaceContributors keeps books.author_id in sync with the first author for the single-author fields
+func (s *catalogService) replaceContributors(ctx context.Context, tx *gorm.DB, bookID, primaryAuthorID uint, contributorDomains []domain.Contributor) error {
+	if err := s.postgresBookRepository.WithinTX(tx).UpdateAuthorID(ctx, bookID, primaryAuthorID); err != nil {
+		return err
+	}
+	return s.postgresBookContributorRepository.WithinTX(tx).ReplaceByBookID(ctx, bookID, postgresMapper.ContributorDomainsToModels(bookID, contributorDomains))
+}
+
+// recordBookRevision runs within the transaction of the change, so the history never misses a committed edit
+func (s *catalogService) recordBookRevision(ctx context.Context, tx *gorm.DB, bookID uint, action string, userID uint, before, after *model.BookWithAuthor) error {
+	revisionModel, err := postgresMapper.NewBookRevisionModel(bookID, action, userID, before, after)
+	if err != nil {
+		return err
+	}
+	return s.postgresRevisionRepository.WithinTX(tx).Create(ctx, revisionModel)
+}
+
+// recordAuthorRevision runs within the transaction of the change, so the history never misses a committed edit
+func (s *catalogService) recordAuthorRevision(ctx context.Context, tx *gorm.DB, authorID uint, action string, userID uint, before, after *model.Author) error {
+	revisionModel, err := postgresMapper.NewAuthorRevisionModel(authorID, action, userID, before, after)
+	if err != nil {
+		return err
+	}
+	return s.postgresRevisionRepository.WithinTX(tx).Create(ctx, revisionModel)
+}
@@ -3,68 +3,125 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/Yarik7610/library-backend-common/broker/kafka/event"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
 	redisRepositories "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	redisModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis/model"
+	kafkaMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/kafka"
 	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
 	redisMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/redis"
 	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
 	"github.com/segmentio/kafka-go"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 )
 
 type CatalogService interface {
 	GetBookCategories(ctx context.Context) ([]string, error)
 	BookCategoryExists(ctx context.Context, bookCategory string) (bool, error)
+	GetCategories(ctx context.Context) ([]domain.Category, error)
+	GetCategory(ctx context.Context, categoryID uint) (*domain.Category, error)
+	CreateCategory(ctx context.Context, categoryDomain *domain.Category) error
+	UpdateCategory(ctx context.Context, categoryDomain *domain.Category) error
+	DeleteCategory(ctx context.Context, categoryID uint) error
 	GetNewBooks(ctx context.Context) ([]domain.Book, error)
 	GetBookViewsCount(ctx context.Context, bookID uint) (int64, error)
 	GetPopularBooks(ctx context.Context) ([]domain.Book, error)
+	GetTrendingBooks(ctx context.Context, window, categoryName string, count uint) ([]domain.Book, error)
 	GetBooksByAuthorID(ctx context.Context, authorID uint) ([]domain.Book, error)
-	GetBookPage(ctx context.Context, bookID, pageNumber uint) (*domain.Page, error)
-	PreviewBook(ctx context.Context, bookID, userID uint) (*domain.Book, error)
-	AddBook(ctx context.Context, bookDomain *domain.Book) error
-	DeleteBook(ctx context.Context, bookID uint) error
-	CreateAuthor(ctx context.Context, authorDomain *domain.Author) error
-	DeleteAuthor(ctx context.Context, authorID uint) error
+	GetBookPage(ctx context.Context, bookID, pageNumber, userID uint) (*domain.Page, error)
+	GetReadingProgress(ctx context.Context, userID, page, count uint) ([]domain.ReadingProgress, error)
+	PreviewBook(ctx context.Context, bookID uint, viewerDomain domain.Viewer) (*domain.Book, error)
+	AddBook(ctx context.Context, bookDomain *domain.Book, userID uint) error
+	SetBookContributors(ctx context.Context, bookID uint, contributorDomains []domain.Contributor, userID uint) (*domain.Book, error)
+	UpdateBookMetadata(ctx context.Context, bookID uint, metadataDomain *domain.BookMetadata, userID uint) (*domain.Book, error)
+	GetBookCover(ctx context.Context, bookID uint) (io.ReadCloser, string, error)
+	SetBookCover(ctx context.Context, bookID uint, content io.Reader, userID uint) (*domain.Book, error)
+	DeleteBookCover(ctx context.Context, bookID, userID uint) error
+	DeleteBook(ctx context.Context, bookID, userID uint) error
+	GetBookHistory(ctx context.Context, bookID, page, count uint) ([]domain.Revision, error)
+	RevertBook(ctx context.Context, bookID, revisionID, userID uint) (*domain.Book, error)
+	GetAuthor(ctx context.Context, authorID uint) (*domain.Author, error)
+	ListAuthors(ctx context.Context, name string, page, count uint) ([]domain.Author, error)
+	CreateAuthor(ctx context.Context, authorDomain *domain.Author, userID uint) error
+	UpdateAuthor(ctx context.Context, authorDomain *domain.Author, userID uint) error
+	DeleteAuthor(ctx context.Context, authorID, userID uint) error
 	ListBooksByCategory(ctx context.Context, categoryName string, page, count uint, sort, order string) ([]domain.Book, error)
 	ListBooksByAuthorName(ctx context.Context, authorName string, page, count uint, sort, order string) ([]domain.Book, error)
 	ListBooksByTitle(ctx context.Context, title string, page, count uint, sort, order string) ([]domain.Book, error)
 	ListBooksByAuthorNameAndTitle(ctx context.Context, authorName, title string, page, count uint, sort, order string) ([]domain.Book, error)
+	ListBooksByTags(ctx context.Context, authorName, title string, tagNames []string, matchAllTags bool, page, count uint, sort, order string) ([]domain.Book, error)
+	ListBooksByISBN(ctx context.Context, isbn string) ([]domain.Book, error)
+	SuggestSearch(ctx context.Context, query string, count uint) ([]domain.Suggestion, error)
+	CorrectSearch(ctx context.Context, authorName, title string) (*domain.SearchCorrection, error)
+	RefreshBookCaches(ctx context.Context, change string, bookIDs []uint) error
 }
 
 type catalogService struct {
-	logger                   *logging.Logger
-	postgresDB               *gorm.DB
-	bookAddedWriter          *kafkaInfrastructure.OtelWriter
-	redisBookRepository      redisRepositories.BookRepository
-	postgresAuthorRepository postgres.AuthorRepository
-	postgresBookRepository   postgres.BookRepository
-	postgresPageRepository   postgres.PageRepository
+	logger                            *logging.Logger
+	postgresDB                        *gorm.DB
+	blobStorage                       blob.Storage
+	bookAddedWriter                   *kafkaInfrastructure.OtelWriter
+	categoryRenamedWriter             *kafkaInfrastructure.OtelWriter
+	bookChangedWriter                 *kafkaInfrastructure.BookChangedWriter
+	redisBookRepository               redisRepositories.BookRepository
+	postgresAuthorRepository          postgres.AuthorRepository
+	postgresBookRepository            postgres.BookRepository
+	postgresBookContributorRepository postgres.BookContributorRepository
+	postgresPageRepository            postgres.PageRepository
+	postgresReadingProgressRepository postgres.ReadingProgressRepository
+	postgresUserBookViewRepository    postgres.UserBookViewRepository
+	postgresCategoryRepository        postgres.CategoryRepository
+	postgresRevisionRepository        postgres.RevisionRepository
+	postgresSuggestionRepository      postgres.SuggestionRepository
+	cacheLoads                        singleflight.Group
 }
 
 func NewCatalogService(
 	logger *logging.Logger,
 	postgresDB *gorm.DB,
+	blobStorage blob.Storage,
 	bookAddedWriter *kafkaInfrastructure.OtelWriter,
+	categoryRenamedWriter *kafkaInfrastructure.OtelWriter,
+	bookChangedWriter *kafkaInfrastructure.BookChangedWriter,
 	redisBookRepository redisRepositories.BookRepository,
 	postgresAuthorRepository postgres.AuthorRepository,
 	postgresBookRepository postgres.BookRepository,
-	postgresPageRepository postgres.PageRepository) CatalogService {
+	postgresBookContributorRepository postgres.BookContributorRepository,
+	postgresPageRepository postgres.PageRepository,
+	postgresReadingProgressRepository postgres.ReadingProgressRepository,
+	postgresUserBookViewRepository postgres.UserBookViewRepository,
+	postgresCategoryRepository postgres.CategoryRepository,
+	postgresRevisionRepository postgres.RevisionRepository,
+	postgresSuggestionRepository postgres.SuggestionRepository) CatalogService {
 	return &catalogService{
-		logger:                   logger,
-		postgresDB:               postgresDB,
-		bookAddedWriter:          bookAddedWriter,
-		redisBookRepository:      redisBookRepository,
-		postgresAuthorRepository: postgresAuthorRepository,
-		postgresBookRepository:   postgresBookRepository,
-		postgresPageRepository:   postgresPageRepository,
+		logger:                            logger,
+		postgresDB:                        postgresDB,
+		blobStorage:                       blobStorage,
+		bookAddedWriter:                   bookAddedWriter,
+		categoryRenamedWriter:             categoryRenamedWriter,
+		bookChangedWriter:                 bookChangedWriter,
+		redisBookRepository:               redisBookRepository,
+		postgresAuthorRepository:          postgresAuthorRepository,
+		postgresBookRepository:            postgresBookRepository,
+		postgresBookContributorRepository: postgresBookContributorRepository,
+		postgresPageRepository:            postgresPageRepository,
+		postgresReadingProgressRepository: postgresReadingProgressRepository,
+		postgresUserBookViewRepository:    postgresUserBookViewRepository,
+		postgresCategoryRepository:        postgresCategoryRepository,
+		postgresRevisionRepository:        postgresRevisionRepository,
+		postgresSuggestionRepository:      postgresSuggestionRepository,
 	}
 }
 
@@ -73,22 +130,30 @@ func (s *catalogService) GetBookCategories(ctx context.Context) ([]string, error
 	if err != nil {
 		return nil, err
 	}
+	if len(categories) > 0 {
+		return categories, nil
+	}
 
-	if len(categories) == 0 {
-		var err error
-		categories, err = s.postgresBookRepository.GetCategories(ctx)
+	// Concurrent misses share a single database load, it finishes even if the first reader goes away
+	loadedCategories, err, _ := s.cacheLoads.Do(redisRepositories.CATEGORIES_KEY, func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		categories, err := s.postgresCategoryRepository.ListNames(loadCtx)
 		if err != nil {
 			return nil, err
 		}
-		go s.redisBookRepository.SetCategories(context.WithoutCancel(ctx), categories)
+		if err := s.redisBookRepository.SetCategories(loadCtx, categories); err != nil {
+			s.logger.Warn(ctx, "Skip cache categories", logging.Error(err))
+		}
+		return categories, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return categories, nil
+	return loadedCategories.([]string), nil
 }
 
 func (s *catalogService) BookCategoryExists(ctx context.Context, bookCategory string) (bool, error) {
-	bookCategory = strings.ToLower(bookCategory)
-	return s.postgresBookRepository.CategoryExists(ctx, bookCategory)
+	return s.postgresCategoryRepository.ExistsByName(ctx, bookCategory)
 }
 
 func (s *catalogService) GetNewBooks(ctx context.Context) ([]domain.Book, error) {
@@ -101,14 +166,23 @@ func (s *catalogService) GetNewBooks(ctx context.Context) ([]domain.Book, error)
 		return redisMapper.BookWithAuthorModelsToDomains(redisNewBookWithAuthorModels), nil
 	}
 
-	postgresNewBookWithAuthorModels, err := s.postgresBookRepository.GetNew(ctx)
+	loadedNewBookDomains, err, _ := s.cacheLoads.Do(redisRepositories.NEW_BOOKS_KEY, func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		postgresNewBookWithAuthorModels, err := s.postgresBookRepository.GetNew(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		postgresNewBookDomains := postgresMapper.BookWithAuthorModelsToDomains(postgresNewBookWithAuthorModels)
+		if err := s.redisBookRepository.SetNew(loadCtx, redisMapper.BookDomainsToBookWithAuthorModels(postgresNewBookDomains)); err != nil {
+			s.logger.Warn(ctx, "Skip cache new books", logging.Error(err))
+		}
+		return postgresNewBookDomains, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	postgresNewBookDomains := postgresMapper.BookWithAuthorModelsToDomains(postgresNewBookWithAuthorModels)
-	go s.redisBookRepository.SetNew(context.WithoutCancel(ctx), redisMapper.BookDomainsToBookWithAuthorModels(postgresNewBookDomains))
-	return postgresNewBookDomains, nil
+	return loadedNewBookDomains.([]domain.Book), nil
 }
 
 func (s *catalogService) GetBookViewsCount(ctx context.Context, bookID uint) (int64, error) {
@@ -120,7 +194,33 @@ func (s *catalogService) GetPopularBooks(ctx context.Context) ([]domain.Book, er
 	if err != nil {
 		return nil, err
 	}
+	return s.getRankedBooks(ctx, bookIDs)
+}
+
+func (s *catalogService) GetTrendingBooks(ctx context.Context, window, categoryName string, count uint) ([]domain.Book, error) {
+	var categoryIDs []uint
+	if categoryName != "" {
+		categoryModel, err := s.postgresCategoryRepository.FindBySlugOrName(ctx, categoryName)
+		if err != nil {
+			return nil, err
+		}
+
+		// Views of subcategory books count towards the parent category as well
+		categoryIDs, err = s.postgresCategoryRepository.ListSubtreeIDs(ctx, categoryModel.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bookIDs, err := s.redisBookRepository.GetTrendingBookIDs(ctx, categoryIDs, domain.TrendingWindowDays(window), time.Now(), int(count))
+	if err != nil {
+		return nil, err
+	}
+	return s.getRankedBooks(ctx, bookIDs)
+}
 
+// getRankedBooks keeps the order of the ranking
+func (s *catalogService) getRankedBooks(ctx context.Context, bookIDs []string) ([]domain.Book, error) {
 	bookWithAuthorModels, err := s.postgresBookRepository.GetBooksByIDs(ctx, bookIDs)
 	if err != nil {
 		return nil, err
@@ -138,7 +238,10 @@ func (s *catalogService) GetPopularBooks(ctx context.Context) ([]domain.Book, er
 		if err != nil {
 			return nil, err
 		}
-		sortedBooks = append(sortedBooks, bookDomainsMap[uint(bookID)])
+		// Books trashed in the meantime may still be ranked
+		if bookDomain, ok := bookDomainsMap[uint(bookID)]; ok {
+			sortedBooks = append(sortedBooks, bookDomain)
+		}
 	}
 	return sortedBooks, nil
 }
@@ -151,56 +254,135 @@ func (s *catalogService) GetBooksByAuthorID(ctx context.Context, authorID uint)
 	return postgresMapper.BookWithAuthorModelsToDomains(bookWithAuthorModels), nil
 }
 
-func (s *catalogService) GetBookPage(ctx context.Context, bookID, pageNumber uint) (*domain.Page, error) {
+func (s *catalogService) GetBookPage(ctx context.Context, bookID, pageNumber, userID uint) (*domain.Page, error) {
 	pageModel, err := s.postgresPageRepository.FindByBookIDAndPageNumber(ctx, bookID, pageNumber)
 	if err != nil {
 		return nil, err
 	}
+
+	if userID > 0 {
+		if err := s.saveReadingProgress(ctx, bookID, pageNumber, userID); err != nil {
+			s.logger.Warn(ctx, "Skip save reading progress", logging.Int("bookID", int(bookID)), logging.Error(err))
+		}
+	}
 	pageDomain := postgresMapper.PageModelToDomain(pageModel)
 	return &pageDomain, nil
 }
 
-func (s *catalogService) PreviewBook(ctx context.Context, bookID, userID uint) (*domain.Book, error) {
-	bookWithAuthorModel, err := s.postgresBookRepository.FindByID(ctx, bookID)
+func (s *catalogService) PreviewBook(ctx context.Context, bookID uint, viewerDomain domain.Viewer) (*domain.Book, error) {
+	bookDomain, err := s.findBook(ctx, bookID)
 	if err != nil {
 		return nil, err
 	}
 
-	if userID > 0 {
-		if err := s.redisBookRepository.UpdateViewsCount(ctx, bookID, userID); err != nil {
+	if viewer := viewerKey(viewerDomain); viewer != "" && s.allowView(ctx, viewerDomain) {
+		if err := s.redisBookRepository.UpdateViewsCount(ctx, bookID, bookDomain.CategoryID, viewer, time.Now()); err != nil {
 			s.logger.Warn(ctx, "Skip update book views count", logging.Int("bookID", int(bookID)), logging.Error(err))
 		}
 	}
 
-	bookDomain := postgresMapper.BookWithAuthorModelToDomain(bookWithAuthorModel)
-	return &bookDomain, nil
+	if viewerDomain.UserID > 0 {
+		userBookViewModel := model.UserBookView{UserID: viewerDomain.UserID, BookID: bookID, ViewedAt: time.Now()}
+		if err := s.postgresUserBookViewRepository.Save(ctx, &userBookViewModel); err != nil {
+			s.logger.Warn(ctx, "Skip save user book view", logging.Int("bookID", int(bookID)), logging.Error(err))
+		}
+	}
+	return bookDomain, nil
 }
 
-func (s *catalogService) AddBook(ctx context.Context, bookDomain *domain.Book) error {
+// allowView rate limits anonymous viewers per client address, so rotating browser headers can't inflate views
+func (s *catalogService) allowView(ctx context.Context, viewerDomain domain.Viewer) bool {
+	if viewerDomain.UserID > 0 {
+		return true
+	}
+
+	allowed, err := s.redisBookRepository.AllowAnonymousView(ctx, viewerDomain.ClientIP, time.Now())
+	if err != nil {
+		s.logger.Warn(ctx, "Skip rate limit anonymous view", logging.Error(err))
+		return true
+	}
+	return allowed
+}
+
+// viewerKey keeps plain user IDs so views counted before anonymous viewers existed stay unique
+func viewerKey(viewerDomain domain.Viewer) string {
+	if viewerDomain.UserID > 0 {
+		return strconv.FormatUint(uint64(viewerDomain.UserID), 10)
+	}
+	if viewerDomain.Fingerprint != "" {
+		return "anonymous:" + viewerDomain.Fingerprint
+	}
+	return ""
+}
+
+// findBook reads the book through its cache, Redis failures fall back to the database
+func (s *catalogService) findBook(ctx context.Context, bookID uint) (*domain.Book, error) {
+	redisBookWithAuthorModel, err := s.redisBookRepository.GetBook(ctx, bookID)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip read cached book", logging.Int("bookID", int(bookID)), logging.Error(err))
+	}
+	if redisBookWithAuthorModel != nil {
+		bookDomain := redisMapper.BookWithAuthorModelToDomain(redisBookWithAuthorModel)
+		return &bookDomain, nil
+	}
+
+	loadedBookDomain, err, _ := s.cacheLoads.Do("books:"+strconv.Itoa(int(bookID)), func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		postgresBookWithAuthorModel, err := s.postgresBookRepository.FindByID(loadCtx, bookID)
+		if err != nil {
+			return nil, err
+		}
+
+		bookDomain := postgresMapper.BookWithAuthorModelToDomain(postgresBookWithAuthorModel)
+		redisBookWithAuthorModel := redisMapper.BookDomainToBookWithAuthorModel(&bookDomain)
+		if err := s.redisBookRepository.SetBook(loadCtx, &redisBookWithAuthorModel); err != nil {
+			s.logger.Warn(ctx, "Skip cache book", logging.Int("bookID", int(bookID)), logging.Error(err))
+		}
+		return &bookDomain, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loadedBookDomain.(*domain.Book), nil
+}
+
+func (s *catalogService) AddBook(ctx context.Context, bookDomain *domain.Book, userID uint) error {
+	primaryAuthor := domain.Contributor{Author: domain.Author{ID: bookDomain.Author.ID}, Role: domain.ContributorRoleAuthor}
+	bookDomain.Contributors = normalizeContributors(append([]domain.Contributor{primaryAuthor}, bookDomain.Contributors...))
+	if err := normalizeBookMetadata(&bookDomain.Metadata); err != nil {
+		return err
+	}
+
 	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	var createdBookModel model.Book
-	var authorModel *model.Author
-
 	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
 		postgresAuthorRepositoryTX := s.postgresAuthorRepository.WithinTX(tx)
 		postgresPageRepositoryTX := s.postgresPageRepository.WithinTX(tx)
 		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+		postgresCategoryRepositoryTX := s.postgresCategoryRepository.WithinTX(tx)
 
-		var err error
-		authorModel, err = postgresAuthorRepositoryTX.FindByID(txCtx, bookDomain.Author.ID)
-		if err != nil {
+		if err := fillContributorAuthors(txCtx, postgresAuthorRepositoryTX, bookDomain.Contributors); err != nil {
 			return err
 		}
-		bookDomain.Author.Fullname = authorModel.Fullname
+		bookDomain.Author = bookDomain.Contributors[0].Author
 
-		createdBookModel = model.Book{
-			AuthorID: bookDomain.Author.ID,
-			Title:    bookDomain.Title,
-			Year:     bookDomain.Year,
-			Category: bookDomain.Category,
+		categoryModel, err := postgresCategoryRepositoryTX.FindBySlugOrName(txCtx, bookDomain.Category)
+		if err != nil {
+			return err
+		}
+		bookDomain.Category = categoryModel.Name
+		bookDomain.CategoryID = categoryModel.ID
+
+		createdBookModel := model.Book{
+			AuthorID:     bookDomain.Author.ID,
+			Title:        bookDomain.Title,
+			Year:         bookDomain.Year,
+			CategoryID:   &categoryModel.ID,
+			Category:     bookDomain.Category,
+			Contributors: postgresMapper.ContributorDomainsToModels(0, bookDomain.Contributors),
 		}
+		postgresMapper.BookMetadataDomainToModel(&createdBookModel, &bookDomain.Metadata)
 		if err := postgresBookRepositoryTX.Create(txCtx, &createdBookModel); err != nil {
 			return err
 		}
@@ -218,21 +400,19 @@ func (s *catalogService) AddBook(ctx context.Context, bookDomain *domain.Book) e
 			}
 		}
 
-		return nil
+		bookWithAuthorModel, err := postgresBookRepositoryTX.FindByID(txCtx, createdBookModel.ID)
+		if err != nil {
+			return err
+		}
+		return s.recordBookRevision(txCtx, tx, bookDomain.ID, domain.RevisionActionCreate, userID, nil, bookWithAuthorModel)
 	})
 	if err != nil {
 		return err
 	}
 
-	bookAddedEvent, err := json.Marshal(
-		event.BookAdded{
-			ID:             createdBookModel.ID,
-			AuthorID:       createdBookModel.AuthorID,
-			AuthorFullname: authorModel.Fullname,
-			Title:          createdBookModel.Title,
-			Year:           createdBookModel.Year,
-			Category:       createdBookModel.Category,
-		})
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, nil)
+
+	bookAddedEvent, err := json.Marshal(kafkaMapper.BookDomainToBookAddedEvent(bookDomain))
 	if err != nil {
 		return err
 	}
@@ -246,16 +426,162 @@ func (s *catalogService) AddBook(ctx context.Context, bookDomain *domain.Book) e
 	return nil
 }
 
-func (s *catalogService) DeleteBook(ctx context.Context, bookID uint) error {
-	return s.postgresBookRepository.Delete(ctx, bookID)
+func (s *catalogService) SetBookContributors(ctx context.Context, bookID uint, contributorDomains []domain.Contributor, userID uint) (*domain.Book, error) {
+	contributorDomains = normalizeContributors(contributorDomains)
+
+	primaryAuthorIndex := slices.IndexFunc(contributorDomains, func(contributorDomain domain.Contributor) bool {
+		return contributorDomain.Role == domain.ContributorRoleAuthor
+	})
+	if primaryAuthorIndex == -1 {
+		return nil, errs.NewBadRequestError("Book must have at least one contributor with author role")
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var bookWithAuthorModel *model.BookWithAuthor
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresAuthorRepositoryTX := s.postgresAuthorRepository.WithinTX(tx)
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		previousBookWithAuthorModel, err := postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		if err := fillContributorAuthors(txCtx, postgresAuthorRepositoryTX, contributorDomains); err != nil {
+			return err
+		}
+		if err := s.replaceContributors(txCtx, tx, bookID, contributorDomains[primaryAuthorIndex].Author.ID, contributorDomains); err != nil {
+			return err
+		}
+
+		bookWithAuthorModel, err = postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		return s.recordBookRevision(txCtx, tx, bookID, domain.RevisionActionUpdate, userID, previousBookWithAuthorModel, bookWithAuthorModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookID})
+
+	bookDomain := postgresMapper.BookWithAuthorModelToDomain(bookWithAuthorModel)
+	return &bookDomain, nil
+}
+
+func (s *catalogService) UpdateBookMetadata(ctx context.Context, bookID uint, metadataDomain *domain.BookMetadata, userID uint) (*domain.Book, error) {
+	if err := normalizeBookMetadata(metadataDomain); err != nil {
+		return nil, err
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var bookWithAuthorModel *model.BookWithAuthor
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		previousBookWithAuthorModel, err := postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+
+		bookModel := model.Book{ID: bookID}
+		postgresMapper.BookMetadataDomainToModel(&bookModel, metadataDomain)
+		if err := postgresBookRepositoryTX.UpdateMetadata(txCtx, &bookModel); err != nil {
+			return err
+		}
+
+		bookWithAuthorModel, err = postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		return s.recordBookRevision(txCtx, tx, bookID, domain.RevisionActionUpdate, userID, previousBookWithAuthorModel, bookWithAuthorModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookID})
+
+	bookDomain := postgresMapper.BookWithAuthorModelToDomain(bookWithAuthorModel)
+	return &bookDomain, nil
+}
+
+// DeleteBook moves the book to the trash, its cover is kept until the book is purged
+func (s *catalogService) DeleteBook(ctx context.Context, bookID, userID uint) error {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		bookWithAuthorModel, err := postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+
+		if err := postgresBookRepositoryTX.Delete(txCtx, bookID); err != nil {
+			return err
+		}
+		return s.recordBookRevision(txCtx, tx, bookID, domain.RevisionActionDelete, userID, bookWithAuthorModel, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeTrashed, []uint{bookID})
+	return nil
+}
+
+func (s *catalogService) GetAuthor(ctx context.Context, authorID uint) (*domain.Author, error) {
+	authorModel, err := s.postgresAuthorRepository.FindByIDWithAliases(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	authorDomain := postgresMapper.AuthorModelToDomain(authorModel)
+	return &authorDomain, nil
+}
+
+func (s *catalogService) ListAuthors(ctx context.Context, name string, page, count uint) ([]domain.Author, error) {
+	authorModels, err := s.postgresAuthorRepository.List(ctx, strings.TrimSpace(name), page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.AuthorModelsToDomains(authorModels), nil
 }
 
-func (s *catalogService) CreateAuthor(ctx context.Context, authorDomain *domain.Author) error {
+func (s *catalogService) CreateAuthor(ctx context.Context, authorDomain *domain.Author, userID uint) error {
+	if err := validateAuthor(authorDomain); err != nil {
+		return err
+	}
+	authorDomain.Aliases = normalizeAuthorAliases(authorDomain.Fullname, authorDomain.Aliases)
+
 	authorModel := model.Author{
-		Fullname: authorDomain.Fullname,
+		Fullname:    authorDomain.Fullname,
+		Biography:   authorDomain.Biography,
+		BirthYear:   authorDomain.BirthYear,
+		DeathYear:   authorDomain.DeathYear,
+		Nationality: authorDomain.Nationality,
+		Aliases:     make([]model.AuthorAlias, len(authorDomain.Aliases)),
+	}
+	for i := range authorDomain.Aliases {
+		authorModel.Aliases[i] = model.AuthorAlias{Name: authorDomain.Aliases[i]}
 	}
 
-	if err := s.postgresAuthorRepository.Create(ctx, &authorModel); err != nil {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		if err := s.postgresAuthorRepository.WithinTX(tx).Create(txCtx, &authorModel); err != nil {
+			return err
+		}
+		return s.recordAuthorRevision(txCtx, tx, authorModel.ID, domain.RevisionActionCreate, userID, nil, &authorModel)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -263,12 +589,178 @@ func (s *catalogService) CreateAuthor(ctx context.Context, authorDomain *domain.
 	return nil
 }
 
-func (s *catalogService) DeleteAuthor(ctx context.Context, authorID uint) error {
-	return s.postgresAuthorRepository.Delete(ctx, authorID)
+func (s *catalogService) UpdateAuthor(ctx context.Context, authorDomain *domain.Author, userID uint) error {
+	if err := validateAuthor(authorDomain); err != nil {
+		return err
+	}
+	authorDomain.Aliases = normalizeAuthorAliases(authorDomain.Fullname, authorDomain.Aliases)
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var contributedBookIDs []uint
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresAuthorRepositoryTX := s.postgresAuthorRepository.WithinTX(tx)
+
+		previousAuthorModel, err := postgresAuthorRepositoryTX.FindByIDWithAliases(txCtx, authorDomain.ID)
+		if err != nil {
+			return err
+		}
+
+		authorModel := *previousAuthorModel
+		authorModel.Fullname = authorDomain.Fullname
+		authorModel.Biography = authorDomain.Biography
+		authorModel.BirthYear = authorDomain.BirthYear
+		authorModel.DeathYear = authorDomain.DeathYear
+		authorModel.Nationality = authorDomain.Nationality
+		authorModel.Aliases = nil
+		if err := postgresAuthorRepositoryTX.Update(txCtx, &authorModel); err != nil {
+			return err
+		}
+		if err := postgresAuthorRepositoryTX.ReplaceAliases(txCtx, authorDomain.ID, authorDomain.Aliases); err != nil {
+			return err
+		}
+
+		updatedAuthorModel, err := postgresAuthorRepositoryTX.FindByIDWithAliases(txCtx, authorDomain.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.recordAuthorRevision(txCtx, tx, authorDomain.ID, domain.RevisionActionUpdate, userID, previousAuthorModel, updatedAuthorModel); err != nil {
+			return err
+		}
+
+		contributedBookIDs, err = s.postgresBookContributorRepository.WithinTX(tx).ListBookIDsByAuthorID(txCtx, authorDomain.ID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Cached books carry the author name of every contributor
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, contributedBookIDs)
+	return nil
+}
+
+// DeleteAuthor moves the author to the trash together with the books they are the primary author of
+func (s *catalogService) DeleteAuthor(ctx context.Context, authorID, userID uint) error {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// Postgres keeps microseconds, the books are matched by this exact time on restore
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	var deletedBookIDs, contributedBookIDs []uint
+	err := s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresAuthorRepositoryTX := s.postgresAuthorRepository.WithinTX(tx)
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		authorModel, err := postgresAuthorRepositoryTX.FindByIDWithAliases(txCtx, authorID)
+		if err != nil {
+			return err
+		}
+		bookWithAuthorModels, err := postgresBookRepositoryTX.GetBooksByAuthorID(txCtx, authorID)
+		if err != nil {
+			return err
+		}
+
+		if err := postgresAuthorRepositoryTX.Delete(txCtx, authorID, deletedAt); err != nil {
+			return err
+		}
+		deletedBookIDs, err = postgresBookRepositoryTX.DeleteByAuthorID(txCtx, authorID, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := s.recordAuthorRevision(txCtx, tx, authorID, domain.RevisionActionDelete, userID, authorModel, nil); err != nil {
+			return err
+		}
+		for i := range bookWithAuthorModels {
+			if !slices.Contains(deletedBookIDs, bookWithAuthorModels[i].ID) {
+				continue
+			}
+			if err := s.recordBookRevision(txCtx, tx, bookWithAuthorModels[i].ID, domain.RevisionActionDelete, userID, &bookWithAuthorModels[i], nil); err != nil {
+				return err
+			}
+		}
+
+		contributedBookIDs, err = s.postgresBookContributorRepository.WithinTX(tx).ListBookIDsByAuthorID(txCtx, authorID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Books where the author is only a contributor stay, but lose them from the contributors
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, contributedBookIDs)
+	if len(deletedBookIDs) > 0 {
+		s.bookChangedWriter.Write(ctx, event.BookChangeTrashed, deletedBookIDs)
+	}
+	return nil
+}
+
+// RefreshBookCaches is called by the book changed consumer only.
+// Trashed books leave the popular and trending rankings, their views are kept until the purge
+func (s *catalogService) RefreshBookCaches(ctx context.Context, change string, bookIDs []uint) error {
+	if change == event.BookChangeCategories {
+		return s.redisBookRepository.DeleteCategories(ctx)
+	}
+
+	if err := s.redisBookRepository.DeleteNew(ctx); err != nil {
+		return err
+	}
+	if err := s.redisBookRepository.DeleteBooks(ctx, bookIDs); err != nil {
+		return err
+	}
+
+	switch change {
+	case event.BookChangeTrashed:
+		if err := s.redisBookRepository.DeletePopular(ctx, bookIDs); err != nil {
+			return err
+		}
+		return s.deleteTrending(ctx, bookIDs)
+	case event.BookChangeRestored:
+		if err := s.redisBookRepository.RestorePopular(ctx, bookIDs); err != nil {
+			return err
+		}
+		return s.redisBookRepository.RestoreTrending(ctx, bookIDs)
+	}
+	return nil
+}
+
+func (s *catalogService) deleteTrending(ctx context.Context, bookIDs []uint) error {
+	bookModels, err := s.postgresBookRepository.ListCategoryIDsByIDs(ctx, bookIDs)
+	if err != nil {
+		return err
+	}
+
+	categoryIDs := make(map[uint]uint, len(bookModels))
+	for i := range bookModels {
+		if bookModels[i].CategoryID != nil {
+			categoryIDs[bookModels[i].ID] = *bookModels[i].CategoryID
+		}
+	}
+
+	// Purged books are still taken out of the global buckets
+	trendingBooks := make([]redisModel.TrendingBook, len(bookIDs))
+	for i, bookID := range bookIDs {
+		trendingBooks[i] = redisModel.TrendingBook{BookID: bookID, CategoryID: categoryIDs[bookID]}
+	}
+	return s.redisBookRepository.DeleteTrending(ctx, trendingBooks, domain.TrendingWindowDays(domain.TrendingWindowMonth), time.Now())
 }
 
 func (s *catalogService) ListBooksByCategory(ctx context.Context, categoryName string, page, count uint, sort, order string) ([]domain.Book, error) {
-	bookWithAuthorModels, err := s.postgresBookRepository.ListByCategory(ctx, categoryName, page, count, sort, order)
+	categoryModel, err := s.postgresCategoryRepository.FindBySlugOrName(ctx, categoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Books of subcategories belong to the parent category as well
+	categoryIDs, err := s.postgresCategoryRepository.ListSubtreeIDs(ctx, categoryModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookWithAuthorModels, err := s.postgresBookRepository.ListByCategoryIDs(ctx, categoryIDs, page, count, sort, order)
 	if err != nil {
 		return nil, err
 	}
@@ -298,3 +790,118 @@ func (s *catalogService) ListBooksByAuthorNameAndTitle(ctx context.Context, auth
 	}
 	return postgresMapper.BookWithAuthorModelsToDomains(bookWithAuthorModels), nil
 }
+
+func (s *catalogService) ListBooksByTags(ctx context.Context, authorName, title string, tagNames []string, matchAllTags bool, page, count uint, sort, order string) ([]domain.Book, error) {
+	normalizedTagNames := make([]string, 0, len(tagNames))
+	for _, tagName := range tagNames {
+		normalizedTagName := domain.NormalizeTagName(tagName)
+		if normalizedTagName != "" && !slices.Contains(normalizedTagNames, normalizedTagName) {
+			normalizedTagNames = append(normalizedTagNames, normalizedTagName)
+		}
+	}
+	if len(normalizedTagNames) == 0 {
+		return nil, errs.NewBadRequestError("Tags must contain at least one letter or digit")
+	}
+
+	bookWithAuthorModels, err := s.postgresBookRepository.ListByTags(ctx, authorName, title, normalizedTagNames, matchAllTags, page, count, sort, order)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.BookWithAuthorModelsToDomains(bookWithAuthorModels), nil
+}
+
+func (s *catalogService) ListBooksByISBN(ctx context.Context, isbn string) ([]domain.Book, error) {
+	normalizedISBN, ok := domain.NormalizeISBN(isbn)
+	if !ok {
+		return nil, errs.NewBadRequestError("Invalid ISBN")
+	}
+
+	bookWithAuthorModels, err := s.postgresBookRepository.ListByISBN(ctx, normalizedISBN)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.BookWithAuthorModelsToDomains(bookWithAuthorModels), nil
+}
+
+// normalizeBookMetadata trims text fields and stores a valid ISBN in its ISBN-13 form
+func normalizeBookMetadata(metadataDomain *domain.BookMetadata) error {
+	metadataDomain.Publisher = strings.TrimSpace(metadataDomain.Publisher)
+	metadataDomain.Description = strings.TrimSpace(metadataDomain.Description)
+
+	metadataDomain.ISBN = strings.TrimSpace(metadataDomain.ISBN)
+	if metadataDomain.ISBN == "" {
+		return nil
+	}
+
+	normalizedISBN, ok := domain.NormalizeISBN(metadataDomain.ISBN)
+	if !ok {
+		return errs.NewBadRequestError("Invalid ISBN")
+	}
+	metadataDomain.ISBN = normalizedISBN
+	return nil
+}
+
+func validateAuthor(authorDomain *domain.Author) error {
+	if authorDomain.BirthYear != nil && authorDomain.DeathYear != nil && *authorDomain.DeathYear < *authorDomain.BirthYear {
+		return errs.NewBadRequestError("Death year can't be before birth year")
+	}
+	return nil
+}
+
+// normalizeAuthorAliases trims aliases and drops empty ones, case-insensitive duplicates and the full name itself
+func normalizeAuthorAliases(fullname string, aliases []string) []string {
+	seen := map[string]bool{strings.ToLower(strings.TrimSpace(fullname)): true}
+
+	normalized := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		key := strings.ToLower(alias)
+		if alias == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, alias)
+	}
+	return normalized
+}
+
+// normalizeContributors drops repeated author and role pairs and numbers contributors in the given order
+func normalizeContributors(contributorDomains []domain.Contributor) []domain.Contributor {
+	type contributorKey struct {
+		authorID uint
+		role     string
+	}
+	seen := make(map[contributorKey]bool, len(contributorDomains))
+
+	normalized := make([]domain.Contributor, 0, len(contributorDomains))
+	for _, contributorDomain := range contributorDomains {
+		key := contributorKey{authorID: contributorDomain.Author.ID, role: contributorDomain.Role}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		contributorDomain.Position = uint(len(normalized))
+		normalized = append(normalized, contributorDomain)
+	}
+	return normalized
+}
+
+func fillContributorAuthors(ctx context.Context, authorRepository postgres.AuthorRepository, contributorDomains []domain.Contributor) error {
+	fullnames := make(map[uint]string, len(contributorDomains))
+	for i := range contributorDomains {
+		authorID := contributorDomains[i].Author.ID
+
+		fullname, ok := fullnames[authorID]
+		if !ok {
+			authorModel, err := authorRepository.FindByID(ctx, authorID)
+			if err != nil {
+				return err
+			}
+			fullname = authorModel.Fullname
+			fullnames[authorID] = fullname
+		}
+		contributorDomains[i].Author.Fullname = fullname
+	}
+	return nil
+}
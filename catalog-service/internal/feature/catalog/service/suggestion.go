@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+)
+
+func (s *catalogService) SuggestSearch(ctx context.Context, query string, count uint) ([]domain.Suggestion, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return []domain.Suggestion{}, nil
+	}
+
+	suggestionModels, err := s.postgresSuggestionRepository.List(ctx, query, int(count))
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.SuggestionModelsToDomains(suggestionModels), nil
+}
+
+// CorrectSearch is meant for searches which found nothing. The correction keeps the searched author name
+// or title when nothing differing is close to it, and is nil when neither of them can be corrected
+func (s *catalogService) CorrectSearch(ctx context.Context, authorName, title string) (*domain.SearchCorrection, error) {
+	searchCorrectionDomain := domain.SearchCorrection{Author: authorName, Title: title}
+	corrected := false
+
+	if authorName != "" {
+		closestAuthorName, err := s.postgresSuggestionRepository.FindClosestAuthorName(ctx, authorName)
+		if err != nil {
+			return nil, err
+		}
+		if closestAuthorName != "" && !strings.EqualFold(closestAuthorName, authorName) {
+			searchCorrectionDomain.Author = closestAuthorName
+			corrected = true
+		}
+	}
+	if title != "" {
+		closestTitle, err := s.postgresSuggestionRepository.FindClosestTitle(ctx, title)
+		if err != nil {
+			return nil, err
+		}
+		if closestTitle != "" && !strings.EqualFold(closestTitle, title) {
+			searchCorrectionDomain.Title = closestTitle
+			corrected = true
+		}
+	}
+
+	if !corrected {
+		return nil, nil
+	}
+	return &searchCorrectionDomain, nil
+}
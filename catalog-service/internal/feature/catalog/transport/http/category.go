@@ -0,0 +1,201 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+// GetCategories godoc
+//
+//	@Summary		Get category tree
+//	@Description	Returns root categories with nested subcategories, ordered by name
+//	@Tags			catalog
+//	@Produce		json
+//	@Success		200	{array}		dto.Category
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/categories [get]
+func (h *catalogHandler) GetCategories(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetCategories")
+	defer span.End()
+
+	categoryDomains, err := h.catalogService.GetCategories(ctx)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get category tree error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.CategoryDomainsToDTOs(categoryDomains))
+}
+
+// GetCategory godoc
+//
+//	@Summary		Get a category
+//	@Description	Returns a category with its nested subcategories
+//	@Tags			catalog
+//	@Param			categoryID	path	uint	true	"Category ID"
+//	@Produce		json
+//	@Success		200	{object}	dto.Category
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/categories/{categoryID} [get]
+func (h *catalogHandler) GetCategory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	categoryIDString := c.Param("categoryID")
+	categoryID, err := strconv.ParseUint(categoryIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetCategory")
+	defer span.End()
+
+	categoryDomain, err := h.catalogService.GetCategory(ctx, uint(categoryID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get category error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.CategoryDomainToDTO(categoryDomain))
+}
+
+// CreateCategory godoc
+//
+//	@Summary		Create a category
+//	@Description	Adds a new category. Slug is built from the name when omitted
+//	@Tags			catalog
+//	@Param			category	body	dto.SaveCategoryRequest	true	"Category info"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	dto.Category
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		409 {object}	dto.Error "Entity already exists"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/categories [post]
+func (h *catalogHandler) CreateCategory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var saveCategoryRequestDTO dto.SaveCategoryRequest
+	if err := c.ShouldBindJSON(&saveCategoryRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CreateCategory")
+	defer span.End()
+
+	categoryDomain := mapper.SaveCategoryRequestDTOToDomain(0, &saveCategoryRequestDTO)
+	if err := h.catalogService.CreateCategory(ctx, &categoryDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Create category error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.CategoryDomainToDTO(&categoryDomain))
+}
+
+// UpdateCategory godoc
+//
+//	@Summary		Update a category
+//	@Description	Replaces category fields. Renames are applied to books and book category subscriptions
+//	@Tags			catalog
+//	@Param			categoryID	path	uint					true	"Category ID"
+//	@Param			category	body	dto.SaveCategoryRequest	true	"Category info"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Category
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		409 {object}	dto.Error "Entity already exists"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/categories/{categoryID} [put]
+func (h *catalogHandler) UpdateCategory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	categoryIDString := c.Param("categoryID")
+	categoryID, err := strconv.ParseUint(categoryIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var saveCategoryRequestDTO dto.SaveCategoryRequest
+	if err := c.ShouldBindJSON(&saveCategoryRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UpdateCategory")
+	defer span.End()
+
+	categoryDomain := mapper.SaveCategoryRequestDTOToDomain(uint(categoryID), &saveCategoryRequestDTO)
+	if err := h.catalogService.UpdateCategory(ctx, &categoryDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Update category error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.CategoryDomainToDTO(&categoryDomain))
+}
+
+// DeleteCategory godoc
+//
+//	@Summary		Delete a category
+//	@Description	Deletes a category without books and subcategories
+//	@Tags			catalog
+//	@Param			categoryID	path	uint	true	"Category ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/categories/{categoryID} [delete]
+func (h *catalogHandler) DeleteCategory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	categoryIDString := c.Param("categoryID")
+	categoryID, err := strconv.ParseUint(categoryIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteCategory")
+	defer span.End()
+
+	if err := h.catalogService.DeleteCategory(ctx, uint(categoryID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete category error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
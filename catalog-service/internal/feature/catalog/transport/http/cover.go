@@ -0,0 +1,166 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+const MAX_COVER_SIZE = 5 << 20
+
+// GetBookCover godoc
+//
+//	@Summary		Get book cover
+//	@Description	Returns the cover image of a book
+//	@Tags			catalog
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		image/jpeg,image/png,image/webp
+//	@Success		200	{file}		binary
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/cover [get]
+func (h *catalogHandler) GetBookCover(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetBookCover")
+	defer span.End()
+
+	content, contentType, err := h.catalogService.GetBookCover(ctx, uint(bookID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get book cover error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+	defer content.Close()
+
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.DataFromReader(http.StatusOK, -1, contentType, content, nil)
+}
+
+// SetBookCover godoc
+//
+//	@Summary		Upload book cover
+//	@Description	Replaces the cover image of a book. Accepts JPEG, PNG or WebP images up to 5 MB
+//	@Tags			catalog
+//	@Accept			multipart/form-data
+//	@Param			bookID	path		uint	true	"Book ID"
+//	@Param			cover	formData	file	true	"Cover image"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/cover [put]
+func (h *catalogHandler) SetBookCover(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	// Leave some room for the multipart envelope around the file itself
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MAX_COVER_SIZE+1<<20)
+
+	coverFileHeader, err := c.FormFile("cover")
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+	if coverFileHeader.Size > MAX_COVER_SIZE {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError("Cover image can't be larger than 5 MB"))
+		return
+	}
+
+	coverFile, err := coverFileHeader.Open()
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+	defer coverFile.Close()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SetBookCover")
+	defer span.End()
+
+	bookDomain, err := h.catalogService.SetBookCover(ctx, uint(bookID), coverFile, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Set book cover error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookDomainToDTO(bookDomain))
+}
+
+// DeleteBookCover godoc
+//
+//	@Summary		Delete book cover
+//	@Description	Removes the cover image of a book
+//	@Tags			catalog
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/cover [delete]
+func (h *catalogHandler) DeleteBookCover(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteBookCover")
+	defer span.End()
+
+	if err := h.catalogService.DeleteBookCover(ctx, uint(bookID), uint(userID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete book cover error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
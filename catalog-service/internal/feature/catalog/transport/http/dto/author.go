@@ -1,10 +1,29 @@
 package dto
 
 type Author struct {
-	ID       uint   `json:"id"`
-	Fullname string `json:"fullname"`
+	ID          uint     `json:"id"`
+	Fullname    string   `json:"fullname"`
+	Biography   string   `json:"biography,omitempty"`
+	BirthYear   *int     `json:"birthYear,omitempty"`
+	DeathYear   *int     `json:"deathYear,omitempty"`
+	Nationality string   `json:"nationality,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
 }
 
 type CreateAuthorRequest struct {
-	Fullname string `json:"fullname" binding:"required"`
+	Fullname    string   `json:"fullname" binding:"required"`
+	Biography   string   `json:"biography" binding:"max=10000"`
+	BirthYear   *int     `json:"birthYear"`
+	DeathYear   *int     `json:"deathYear"`
+	Nationality string   `json:"nationality" binding:"max=100"`
+	Aliases     []string `json:"aliases" binding:"max=20,dive,max=200"`
+}
+
+type UpdateAuthorRequest struct {
+	Fullname    string   `json:"fullname" binding:"required"`
+	Biography   string   `json:"biography" binding:"max=10000"`
+	BirthYear   *int     `json:"birthYear"`
+	DeathYear   *int     `json:"deathYear"`
+	Nationality string   `json:"nationality" binding:"max=100"`
+	Aliases     []string `json:"aliases" binding:"max=20,dive,max=200"`
 }
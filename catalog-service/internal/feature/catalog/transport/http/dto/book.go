@@ -1,19 +1,42 @@
 package dto
 
 type Book struct {
-	ID       uint   `json:"id"`
-	Author   Author `json:"author"`
-	Title    string `json:"title"`
-	Year     int    `json:"year"`
-	Category string `json:"category"`
+	ID            uint          `json:"id"`
+	Author        Author        `json:"author"`
+	Contributors  []Contributor `json:"contributors"`
+	Title         string        `json:"title"`
+	Year          int           `json:"year"`
+	Category      string        `json:"category"`
+	ISBN          string        `json:"isbn,omitempty"`
+	Publisher     string        `json:"publisher,omitempty"`
+	Language      string        `json:"language,omitempty"`
+	PageCount     uint          `json:"pageCount,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	CoverURL      string        `json:"coverUrl,omitempty"`
+	RatingAverage float64       `json:"ratingAverage"`
+	RatingCount   uint          `json:"ratingCount"`
 }
 
 type AddBookRequest struct {
-	AuthorID uint                `json:"authorId" binding:"required,min=1"`
-	Title    string              `json:"title" binding:"required"`
-	Year     int                 `json:"year" binding:"required"`
-	Category string              `json:"category" binding:"required"`
-	Pages    []CreatePageRequest `json:"pages"`
+	AuthorID     uint                 `json:"authorId" binding:"required,min=1"`
+	Contributors []ContributorRequest `json:"contributors" binding:"max=20,dive"`
+	Title        string               `json:"title" binding:"required"`
+	Year         int                  `json:"year" binding:"required"`
+	Category     string               `json:"category" binding:"required"`
+	ISBN         string               `json:"isbn" binding:"max=17"`
+	Publisher    string               `json:"publisher" binding:"max=255"`
+	Language     string               `json:"language" binding:"omitempty,bcp47_language_tag"`
+	PageCount    uint                 `json:"pageCount" binding:"max=100000"`
+	Description  string               `json:"description" binding:"max=10000"`
+	Pages        []CreatePageRequest  `json:"pages"`
+}
+
+type UpdateBookMetadataRequest struct {
+	ISBN        string `json:"isbn" binding:"max=17"`
+	Publisher   string `json:"publisher" binding:"max=255"`
+	Language    string `json:"language" binding:"omitempty,bcp47_language_tag"`
+	PageCount   uint   `json:"pageCount" binding:"max=100000"`
+	Description string `json:"description" binding:"max=10000"`
 }
 
 type BookViews struct {
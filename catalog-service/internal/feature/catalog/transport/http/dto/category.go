@@ -0,0 +1,18 @@
+package dto
+
+type Category struct {
+	ID          uint       `json:"id"`
+	ParentID    *uint      `json:"parentId,omitempty"`
+	Slug        string     `json:"slug"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	BooksCount  uint       `json:"booksCount"`
+	Children    []Category `json:"children"`
+}
+
+type SaveCategoryRequest struct {
+	ParentID    *uint  `json:"parentId" binding:"omitempty,min=1"`
+	Slug        string `json:"slug" binding:"max=100"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=2000"`
+}
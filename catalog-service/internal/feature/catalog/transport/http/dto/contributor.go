@@ -0,0 +1,16 @@
+package dto
+
+type Contributor struct {
+	Author   Author `json:"author"`
+	Role     string `json:"role"`
+	Position uint   `json:"position"`
+}
+
+type ContributorRequest struct {
+	AuthorID uint   `json:"authorId" binding:"required,min=1"`
+	Role     string `json:"role" binding:"required,oneof=author co-author editor translator illustrator"`
+}
+
+type SetBookContributorsRequest struct {
+	Contributors []ContributorRequest `json:"contributors" binding:"required,min=1,max=20,dive"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+type ReadingProgress struct {
+	Book       Book      `json:"book"`
+	LastPage   uint      `json:"lastPage"`
+	Percentage float64   `json:"percentage"`
+	StartedAt  time.Time `json:"startedAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Revision struct {
+	ID          uint            `json:"id"`
+	EntityType  string          `json:"entityType" enums:"book,author"`
+	EntityID    uint            `json:"entityId"`
+	Action      string          `json:"action" enums:"create,update,delete,restore,revert"`
+	ActorUserID uint            `json:"actorUserId"`
+	Before      json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After       json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type Suggestion struct {
+	Type     string `json:"type" enums:"title,author"`
+	Text     string `json:"text"`
+	EntityID uint   `json:"entityId"`
+}
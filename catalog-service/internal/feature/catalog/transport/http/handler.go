@@ -3,6 +3,7 @@ package http
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
@@ -20,18 +21,36 @@ import (
 
 type CatalogHandler interface {
 	GetBookCategories(c *gin.Context)
+	GetCategories(c *gin.Context)
+	GetCategory(c *gin.Context)
+	CreateCategory(c *gin.Context)
+	UpdateCategory(c *gin.Context)
+	DeleteCategory(c *gin.Context)
 	PreviewBook(c *gin.Context)
 	GetBooksByAuthorID(c *gin.Context)
 	GetBookPage(c *gin.Context)
 	AddBook(c *gin.Context)
+	SetBookContributors(c *gin.Context)
+	UpdateBookMetadata(c *gin.Context)
+	GetBookCover(c *gin.Context)
+	SetBookCover(c *gin.Context)
+	DeleteBookCover(c *gin.Context)
 	DeleteBook(c *gin.Context)
+	GetBookHistory(c *gin.Context)
+	RevertBook(c *gin.Context)
+	GetAuthor(c *gin.Context)
+	ListAuthors(c *gin.Context)
 	CreateAuthor(c *gin.Context)
+	UpdateAuthor(c *gin.Context)
 	DeleteAuthor(c *gin.Context)
 	GetNewBooks(c *gin.Context)
 	GetBookViewsCount(c *gin.Context)
 	GetPopularBooks(c *gin.Context)
+	GetTrendingBooks(c *gin.Context)
 	ListBooksByCategory(c *gin.Context)
 	SearchBooks(c *gin.Context)
+	SuggestSearch(c *gin.Context)
+	GetReadingProgress(c *gin.Context)
 }
 
 type catalogHandler struct {
@@ -55,7 +74,7 @@ func NewCatalogHandler(
 // GetBookCategories godoc
 //
 //	@Summary		Get all book categories
-//	@Description	Returns a list of all available book categories
+//	@Description	Returns names of all managed book categories
 //	@Tags			catalog
 //	@Produce		json
 //	@Success		200	{array}		string
@@ -81,7 +100,7 @@ func (h *catalogHandler) GetBookCategories(c *gin.Context) {
 // PreviewBook godoc
 //
 //	@Summary		Preview a book
-//	@Description	Returns preview information for a book
+//	@Description	Returns preview information for a book and counts the view, anonymous viewers are told apart by a client fingerprint and rate limited per address
 //	@Tags			catalog
 //	@Param			bookID	path	uint	true	"Book ID"
 //	@Produce		json
@@ -104,11 +123,16 @@ func (h *catalogHandler) PreviewBook(c *gin.Context) {
 	if err != nil {
 		userID = 0
 	}
+	viewerDomain := domain.Viewer{UserID: uint(userID)}
+	if userID == 0 {
+		viewerDomain.Fingerprint = header.GetFingerprint(c)
+		viewerDomain.ClientIP = c.ClientIP()
+	}
 
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.PreviewBook")
 	defer span.End()
 
-	bookDomain, err := h.catalogService.PreviewBook(ctx, uint(bookID), uint(userID))
+	bookDomain, err := h.catalogService.PreviewBook(ctx, uint(bookID), viewerDomain)
 	if err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Preview book error", logging.Error(err))
@@ -157,7 +181,7 @@ func (h *catalogHandler) GetBooksByAuthorID(c *gin.Context) {
 // GetBookPage godoc
 //
 //	@Summary		Get a book page
-//	@Description	Returns content of a specific book page
+//	@Description	Returns content of a specific book page. Saves reading progress for authorized users
 //	@Tags			catalog
 //	@Param			bookID		path	uint	true	"Book ID"
 //	@Param			page	query	int		true	"Page number"
@@ -183,10 +207,15 @@ func (h *catalogHandler) GetBookPage(c *gin.Context) {
 		return
 	}
 
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		userID = 0
+	}
+
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetBookPage")
 	defer span.End()
 
-	pageDomain, err := h.catalogService.GetBookPage(ctx, uint(bookID), query.PageNumber)
+	pageDomain, err := h.catalogService.GetBookPage(ctx, uint(bookID), query.PageNumber, uint(userID))
 	if err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Get book page error", logging.Error(err))
@@ -200,7 +229,7 @@ func (h *catalogHandler) GetBookPage(c *gin.Context) {
 // AddBook godoc
 //
 //	@Summary		Add a new book
-//	@Description	Creates a new book entry
+//	@Description	Creates a new book entry. authorId becomes the primary author, contributors lists co-authors, editors, translators and illustrators in display order. category is a slug or name of an existing category
 //	@Tags			catalog
 //	@Param			book	body	dto.AddBookRequest	true	"Book info"
 //	@Produce		json
@@ -222,11 +251,17 @@ func (h *catalogHandler) AddBook(c *gin.Context) {
 		return
 	}
 
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.AddBook")
 	defer span.End()
 
 	bookDomain := mapper.AddBookRequestToDomain(&createBookDTO)
-	if err := h.catalogService.AddBook(ctx, &bookDomain); err != nil {
+	if err := h.catalogService.AddBook(ctx, &bookDomain, uint(userID)); err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Add book error", logging.Error(err))
 		httpInfrastructure.RenderError(c, err)
@@ -236,10 +271,118 @@ func (h *catalogHandler) AddBook(c *gin.Context) {
 	c.JSON(http.StatusCreated, mapper.BookDomainToDTO(&bookDomain))
 }
 
+// SetBookContributors godoc
+//
+//	@Summary		Set book contributors
+//	@Description	Replaces book contributors, list order is the display order. The first contributor with author role becomes the primary author
+//	@Tags			catalog
+//	@Param			bookID			path	uint							true	"Book ID"
+//	@Param			contributors	body	dto.SetBookContributorsRequest	true	"Book contributors"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		409 {object}	dto.Error "Entity already exists"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/contributors [put]
+func (h *catalogHandler) SetBookContributors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var setBookContributorsRequestDTO dto.SetBookContributorsRequest
+	if err := c.ShouldBindJSON(&setBookContributorsRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SetBookContributors")
+	defer span.End()
+
+	contributorDomains := mapper.ContributorRequestDTOsToDomains(setBookContributorsRequestDTO.Contributors)
+	bookDomain, err := h.catalogService.SetBookContributors(ctx, uint(bookID), contributorDomains, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Set book contributors error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookDomainToDTO(bookDomain))
+}
+
+// UpdateBookMetadata godoc
+//
+//	@Summary		Update book metadata
+//	@Description	Replaces ISBN, publisher, language, page count and description of a book. ISBN-10 is stored as ISBN-13
+//	@Tags			catalog
+//	@Param			bookID		path	uint							true	"Book ID"
+//	@Param			metadata	body	dto.UpdateBookMetadataRequest	true	"Book metadata"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		409 {object}	dto.Error "Entity already exists"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/metadata [put]
+func (h *catalogHandler) UpdateBookMetadata(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var updateBookMetadataRequestDTO dto.UpdateBookMetadataRequest
+	if err := c.ShouldBindJSON(&updateBookMetadataRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UpdateBookMetadata")
+	defer span.End()
+
+	metadataDomain := mapper.UpdateBookMetadataRequestToDomain(&updateBookMetadataRequestDTO)
+	bookDomain, err := h.catalogService.UpdateBookMetadata(ctx, uint(bookID), &metadataDomain, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Update book metadata error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookDomainToDTO(bookDomain))
+}
+
 // DeleteBook godoc
 //
 //	@Summary		Delete a book
-//	@Description	Deletes a book by ID
+//	@Description	Moves a book to the trash, it can be restored until the trash retention period ends
 //	@Tags			catalog
 //	@Param			bookID	path	uint	true	"Book ID"
 //	@Produce		json
@@ -248,6 +391,7 @@ func (h *catalogHandler) AddBook(c *gin.Context) {
 //	@Failure		400 {object}	dto.Error "Bad request"
 //	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
 //	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
 //	@Failure		500	{object}	dto.Error "Internal server error"
 //	@Router			/catalog/books/{bookID} [delete]
 func (h *catalogHandler) DeleteBook(c *gin.Context) {
@@ -260,10 +404,16 @@ func (h *catalogHandler) DeleteBook(c *gin.Context) {
 		return
 	}
 
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteBook")
 	defer span.End()
 
-	err = h.catalogService.DeleteBook(ctx, uint(bookID))
+	err = h.catalogService.DeleteBook(ctx, uint(bookID), uint(userID))
 	if err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Delete book error", logging.Error(err))
@@ -275,6 +425,78 @@ func (h *catalogHandler) DeleteBook(c *gin.Context) {
 	c.Abort()
 }
 
+// GetAuthor godoc
+//
+//	@Summary		Get an author
+//	@Description	Returns author profile with biography, life years, nationality and aliases
+//	@Tags			catalog
+//	@Param			authorID	path	uint	true	"Author ID"
+//	@Produce		json
+//	@Success		200	{object}	dto.Author
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/authors/{authorID} [get]
+func (h *catalogHandler) GetAuthor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	authorIDString := c.Param("authorID")
+	authorID, err := strconv.ParseUint(authorIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetAuthor")
+	defer span.End()
+
+	authorDomain, err := h.catalogService.GetAuthor(ctx, uint(authorID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get author error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.AuthorDomainToDTO(authorDomain))
+}
+
+// ListAuthors godoc
+//
+//	@Summary		List authors
+//	@Description	Returns paginated list of authors ordered by full name, optionally filtered by name or alias
+//	@Tags			catalog
+//	@Param			name	query	string	false	"Author name or alias"
+//	@Param			page	query	int		false	"Page number (min=1, default=1)"
+//	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Success		200	{array}		dto.Author
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/authors [get]
+func (h *catalogHandler) ListAuthors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.ListAuthors
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ListAuthors")
+	defer span.End()
+
+	authorDomains, err := h.catalogService.ListAuthors(ctx, query.Name, query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "List authors error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.AuthorDomainsToDTOs(authorDomains))
+}
+
 // CreateAuthor godoc
 //
 //	@Summary		Create a new author
@@ -299,11 +521,17 @@ func (h *catalogHandler) CreateAuthor(c *gin.Context) {
 		return
 	}
 
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CreateAuthor")
 	defer span.End()
 
 	authorDomain := mapper.CreateAuthorRequestDTOToDomain(&createAuthorRequestDTO)
-	if err := h.catalogService.CreateAuthor(ctx, &authorDomain); err != nil {
+	if err := h.catalogService.CreateAuthor(ctx, &authorDomain, uint(userID)); err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Create author error", logging.Error(err))
 		httpInfrastructure.RenderError(c, err)
@@ -313,10 +541,62 @@ func (h *catalogHandler) CreateAuthor(c *gin.Context) {
 	c.JSON(http.StatusCreated, mapper.AuthorDomainToDTO(&authorDomain))
 }
 
+// UpdateAuthor godoc
+//
+//	@Summary		Update an author
+//	@Description	Replaces author profile and aliases
+//	@Tags			catalog
+//	@Param			authorID	path	uint	true	"Author ID"
+//	@Param			author		body	dto.UpdateAuthorRequest	true	"Author info"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Author
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/authors/{authorID} [put]
+func (h *catalogHandler) UpdateAuthor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	authorIDString := c.Param("authorID")
+	authorID, err := strconv.ParseUint(authorIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var updateAuthorRequestDTO dto.UpdateAuthorRequest
+	if err := c.ShouldBindJSON(&updateAuthorRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UpdateAuthor")
+	defer span.End()
+
+	authorDomain := mapper.UpdateAuthorRequestDTOToDomain(uint(authorID), &updateAuthorRequestDTO)
+	if err := h.catalogService.UpdateAuthor(ctx, &authorDomain, uint(userID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Update author error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.AuthorDomainToDTO(&authorDomain))
+}
+
 // DeleteAuthor godoc
 //
 //	@Summary		Delete an author
-//	@Description	Deletes an author by ID
+//	@Description	Moves an author to the trash together with the books they are the primary author of, all of them can be restored until the trash retention period ends
 //	@Tags			catalog
 //	@Param			authorID	path	uint	true	"Author ID"
 //	@Security		BearerAuth
@@ -325,6 +605,7 @@ func (h *catalogHandler) CreateAuthor(c *gin.Context) {
 //	@Failure		400 {object}	dto.Error "Bad request"
 //	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
 //	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
 //	@Failure		500	{object}	dto.Error "Internal server error"
 //	@Router			/catalog/authors/{authorID} [delete]
 func (h *catalogHandler) DeleteAuthor(c *gin.Context) {
@@ -337,10 +618,16 @@ func (h *catalogHandler) DeleteAuthor(c *gin.Context) {
 		return
 	}
 
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteAuthor")
 	defer span.End()
 
-	if err := h.catalogService.DeleteAuthor(ctx, uint(authorID)); err != nil {
+	if err := h.catalogService.DeleteAuthor(ctx, uint(authorID), uint(userID)); err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Delete author error", logging.Error(err))
 		httpInfrastructure.RenderError(c, err)
@@ -415,7 +702,7 @@ func (h *catalogHandler) GetBookViewsCount(c *gin.Context) {
 // GetPopularBooks godoc
 //
 //	@Summary		Get popular books
-//	@Description	Returns list of most popular books based on views count by different authorized users only
+//	@Description	Returns list of most popular books of all time based on views count by different users and anonymous clients
 //	@Tags			catalog
 //	@Produce		json
 //	@Success		200	{array}		dto.Book
@@ -438,19 +725,57 @@ func (h *catalogHandler) GetPopularBooks(c *gin.Context) {
 	c.JSON(http.StatusOK, mapper.BookDomainsToDTOs(popularBookDomains))
 }
 
+// GetTrendingBooks godoc
+//
+//	@Summary		Get trending books
+//	@Description	Returns list of books with the most views by different users and anonymous clients within the window, optionally limited to a category and its subcategories
+//	@Tags			catalog
+//	@Param			window		query	string	false	"Time window (day / week / month, default=week)"
+//	@Param			category	query	string	false	"Category slug or name"
+//	@Param			count		query	int		false	"Number of books (min=1, max=100, default=10)"
+//	@Produce		json
+//	@Success		200	{array}		dto.Book
+//	@Failure		400	{object}	dto.Error "Bad request"
+//	@Failure		404	{object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/trending [get]
+func (h *catalogHandler) GetTrendingBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.GetTrendingBooks
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetTrendingBooks")
+	defer span.End()
+
+	trendingBookDomains, err := h.catalogService.GetTrendingBooks(ctx, query.Window, query.Category, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get trending books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookDomainsToDTOs(trendingBookDomains))
+}
+
 // ListBooksByCategory godoc
 //
 //	@Summary		List books by category
-//	@Description	Returns paginated list of books for the given category
+//	@Description	Returns paginated list of books for the given category and all its subcategories
 //	@Tags			catalog
-//	@Param			categoryName	path	string	true	"Category name"
+//	@Param			categoryName	path	string	true	"Category slug or name"
 //	@Param			page	query	int		false	"Page number (min=1, default=1)"
 //	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
-//	@Param			sort			query	string	false	"Sort field (title / year / category, default=title)"
+//	@Param			sort			query	string	false	"Sort field (title / year / category / rating, default=title)"
 //	@Param			order			query	string	false	"Sort order (asc / desc, default=asc)"
 //	@Produce		json
 //	@Success		200	{array}		dto.Book
 //	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
 //	@Failure		500	{object}	dto.Error "Internal server error"
 //	@Router			/catalog/books/categories/{categoryName} [get]
 func (h *catalogHandler) ListBooksByCategory(c *gin.Context) {
@@ -481,16 +806,20 @@ func (h *catalogHandler) ListBooksByCategory(c *gin.Context) {
 // SearchBooks godoc
 //
 //	@Summary		Search books
-//	@Description	List books by author name and/or title with pagination
+//	@Description	List books by author name (or alias), title and/or tags with pagination, or find books by ISBN
 //	@Tags			catalog
-//	@Param			author	query	string	false	"Author name"
-//	@Param			title	query	string	false	"Book title"
+//	@Param			author		query	string		false	"Author name or alias"
+//	@Param			title		query	string		false	"Book title"
+//	@Param			isbn		query	string		false	"ISBN-10 or ISBN-13, other filters are ignored when set"
+//	@Param			tags		query	[]string	false	"Tags, repeated or comma separated (max=20)"	collectionFormat(multi)
+//	@Param			tagsMatch	query	string		false	"Whether books need any or all of the tags (any / all, default=any)"
 //	@Param			page	query	int		false	"Page number (min=1, default=1)"
 //	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
-//	@Param			sort			query	string	false	"Sort field (title / year / category, default=title)"
+//	@Param			sort			query	string	false	"Sort field (title / year / category / rating, default=title)"
 //	@Param			order			query	string	false	"Sort order (asc / desc, default=asc)"
 //	@Produce		json
 //	@Success		200	{array}		dto.Book
+//	@Header			200	{string}	X-Did-You-Mean	"Set when nothing is found on the first page, but a known author name or title is close to the searched one. Holds the corrected author and title query parameters"
 //	@Failure		400 {object}	dto.Error "Bad request"
 //	@Failure		500	{object}	dto.Error "Internal server error"
 //	@Router			/catalog/books/search [get]
@@ -503,8 +832,9 @@ func (h *catalogHandler) SearchBooks(c *gin.Context) {
 		return
 	}
 
-	if query.Author == "" && query.Title == "" {
-		httpInfrastructure.RenderError(c, errs.NewBadRequestError("Can't have both empty author and title query strings"))
+	tags := splitTags(query.Tags)
+	if query.Author == "" && query.Title == "" && query.ISBN == "" && len(tags) == 0 {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError("Can't have empty author, title, ISBN and tags query strings all together"))
 		return
 	}
 
@@ -513,7 +843,11 @@ func (h *catalogHandler) SearchBooks(c *gin.Context) {
 
 	var bookDomains []domain.Book
 	var err error
-	if query.Author != "" && query.Title != "" {
+	if query.ISBN != "" {
+		bookDomains, err = h.catalogService.ListBooksByISBN(ctx, query.ISBN)
+	} else if len(tags) > 0 {
+		bookDomains, err = h.catalogService.ListBooksByTags(ctx, query.Author, query.Title, tags, query.TagsMatch == "all", query.Page, query.Count, query.Sort, query.Order)
+	} else if query.Author != "" && query.Title != "" {
 		bookDomains, err = h.catalogService.ListBooksByAuthorNameAndTitle(ctx, query.Author, query.Title, query.Page, query.Count, query.Sort, query.Order)
 	} else if query.Author != "" {
 		bookDomains, err = h.catalogService.ListBooksByAuthorName(ctx, query.Author, query.Page, query.Count, query.Sort, query.Order)
@@ -528,5 +862,29 @@ func (h *catalogHandler) SearchBooks(c *gin.Context) {
 		return
 	}
 
+	// Tags and ISBN are exact, so only misspelled author names and titles get corrected.
+	// Later pages are empty when the search ran out of results, not when it was misspelled
+	if len(bookDomains) == 0 && query.Page == 1 && query.ISBN == "" && len(tags) == 0 {
+		searchCorrectionDomain, err := h.catalogService.CorrectSearch(ctx, query.Author, query.Title)
+		if err != nil {
+			h.logger.Warn(ctx, "Skip correct search", logging.Error(err))
+		} else if searchCorrectionDomain != nil {
+			header.SetDidYouMean(c, searchCorrectionDomain.Author, searchCorrectionDomain.Title)
+		}
+	}
+
 	c.JSON(http.StatusOK, mapper.BookDomainsToDTOs(bookDomains))
 }
+
+// splitTags accepts both repeated (?tags=a&tags=b) and comma separated (?tags=a,b) tags
+func splitTags(rawTags []string) []string {
+	tags := make([]string, 0, len(rawTags))
+	for _, rawTag := range rawTags {
+		for tag := range strings.SplitSeq(rawTag, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
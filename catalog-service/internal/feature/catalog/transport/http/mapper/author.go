@@ -7,11 +7,43 @@ import (
 
 func AuthorDomainToDTO(authorDomain *domain.Author) dto.Author {
 	return dto.Author{
-		ID:       authorDomain.ID,
-		Fullname: authorDomain.Fullname,
+		ID:          authorDomain.ID,
+		Fullname:    authorDomain.Fullname,
+		Biography:   authorDomain.Biography,
+		BirthYear:   authorDomain.BirthYear,
+		DeathYear:   authorDomain.DeathYear,
+		Nationality: authorDomain.Nationality,
+		Aliases:     authorDomain.Aliases,
 	}
 }
 
+func AuthorDomainsToDTOs(authorDomains []domain.Author) []dto.Author {
+	authorDTOs := make([]dto.Author, len(authorDomains))
+	for i := range authorDomains {
+		authorDTOs[i] = AuthorDomainToDTO(&authorDomains[i])
+	}
+	return authorDTOs
+}
+
 func CreateAuthorRequestDTOToDomain(createAuthorRequestDTO *dto.CreateAuthorRequest) domain.Author {
-	return domain.Author{Fullname: createAuthorRequestDTO.Fullname}
+	return domain.Author{
+		Fullname:    createAuthorRequestDTO.Fullname,
+		Biography:   createAuthorRequestDTO.Biography,
+		BirthYear:   createAuthorRequestDTO.BirthYear,
+		DeathYear:   createAuthorRequestDTO.DeathYear,
+		Nationality: createAuthorRequestDTO.Nationality,
+		Aliases:     createAuthorRequestDTO.Aliases,
+	}
+}
+
+func UpdateAuthorRequestDTOToDomain(authorID uint, updateAuthorRequestDTO *dto.UpdateAuthorRequest) domain.Author {
+	return domain.Author{
+		ID:          authorID,
+		Fullname:    updateAuthorRequestDTO.Fullname,
+		Biography:   updateAuthorRequestDTO.Biography,
+		BirthYear:   updateAuthorRequestDTO.BirthYear,
+		DeathYear:   updateAuthorRequestDTO.DeathYear,
+		Nationality: updateAuthorRequestDTO.Nationality,
+		Aliases:     updateAuthorRequestDTO.Aliases,
+	}
 }
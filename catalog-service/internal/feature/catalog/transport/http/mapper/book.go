@@ -1,8 +1,12 @@
 package mapper
 
 import (
+	"strconv"
+
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
 )
 
 func BookDomainsToDTOs(bookDomains []domain.Book) []dto.Book {
@@ -15,20 +19,54 @@ func BookDomainsToDTOs(bookDomains []domain.Book) []dto.Book {
 
 func BookDomainToDTO(bookDomain *domain.Book) dto.Book {
 	return dto.Book{
-		ID:       bookDomain.ID,
-		Author:   dto.Author(bookDomain.Author),
-		Title:    bookDomain.Title,
-		Year:     bookDomain.Year,
-		Category: bookDomain.Category,
+		ID:            bookDomain.ID,
+		Author:        dto.Author{ID: bookDomain.Author.ID, Fullname: bookDomain.Author.Fullname},
+		Contributors:  ContributorDomainsToDTOs(bookDomain.Contributors),
+		Title:         bookDomain.Title,
+		Year:          bookDomain.Year,
+		Category:      bookDomain.Category,
+		ISBN:          bookDomain.Metadata.ISBN,
+		Publisher:     bookDomain.Metadata.Publisher,
+		Language:      bookDomain.Metadata.Language,
+		PageCount:     bookDomain.Metadata.PageCount,
+		Description:   bookDomain.Metadata.Description,
+		CoverURL:      bookCoverURL(bookDomain),
+		RatingAverage: bookDomain.RatingAverage,
+		RatingCount:   bookDomain.RatingCount,
 	}
 }
 
 func AddBookRequestToDomain(addBookRequestDTO *dto.AddBookRequest) domain.Book {
 	return domain.Book{
-		Author:   domain.Author{ID: addBookRequestDTO.AuthorID},
-		Title:    addBookRequestDTO.Title,
-		Year:     addBookRequestDTO.Year,
-		Category: addBookRequestDTO.Category,
-		Pages:    CreatePageRequestDTOsToDomains(addBookRequestDTO.Pages),
+		Author:       domain.Author{ID: addBookRequestDTO.AuthorID},
+		Contributors: ContributorRequestDTOsToDomains(addBookRequestDTO.Contributors),
+		Title:        addBookRequestDTO.Title,
+		Year:         addBookRequestDTO.Year,
+		Category:     addBookRequestDTO.Category,
+		Metadata: domain.BookMetadata{
+			ISBN:        addBookRequestDTO.ISBN,
+			Publisher:   addBookRequestDTO.Publisher,
+			Language:    addBookRequestDTO.Language,
+			PageCount:   addBookRequestDTO.PageCount,
+			Description: addBookRequestDTO.Description,
+		},
+		Pages: CreatePageRequestDTOsToDomains(addBookRequestDTO.Pages),
+	}
+}
+
+func UpdateBookMetadataRequestToDomain(updateBookMetadataRequestDTO *dto.UpdateBookMetadataRequest) domain.BookMetadata {
+	return domain.BookMetadata{
+		ISBN:        updateBookMetadataRequestDTO.ISBN,
+		Publisher:   updateBookMetadataRequestDTO.Publisher,
+		Language:    updateBookMetadataRequestDTO.Language,
+		PageCount:   updateBookMetadataRequestDTO.PageCount,
+		Description: updateBookMetadataRequestDTO.Description,
+	}
+}
+
+func bookCoverURL(bookDomain *domain.Book) string {
+	if bookDomain.Metadata.CoverKey == "" {
+		return ""
 	}
+	return sharedRoute.CATALOG + sharedRoute.BOOKS + "/" + strconv.FormatUint(uint64(bookDomain.ID), 10) + route.COVER
 }
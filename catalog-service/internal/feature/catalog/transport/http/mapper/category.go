@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+func CategoryDomainToDTO(categoryDomain *domain.Category) dto.Category {
+	return dto.Category{
+		ID:          categoryDomain.ID,
+		ParentID:    categoryDomain.ParentID,
+		Slug:        categoryDomain.Slug,
+		Name:        categoryDomain.Name,
+		Description: categoryDomain.Description,
+		BooksCount:  categoryDomain.BooksCount,
+		Children:    CategoryDomainsToDTOs(categoryDomain.Children),
+	}
+}
+
+func CategoryDomainsToDTOs(categoryDomains []domain.Category) []dto.Category {
+	categoryDTOs := make([]dto.Category, len(categoryDomains))
+	for i := range categoryDomains {
+		categoryDTOs[i] = CategoryDomainToDTO(&categoryDomains[i])
+	}
+	return categoryDTOs
+}
+
+func SaveCategoryRequestDTOToDomain(categoryID uint, saveCategoryRequestDTO *dto.SaveCategoryRequest) domain.Category {
+	return domain.Category{
+		ID:          categoryID,
+		ParentID:    saveCategoryRequestDTO.ParentID,
+		Slug:        saveCategoryRequestDTO.Slug,
+		Name:        saveCategoryRequestDTO.Name,
+		Description: saveCategoryRequestDTO.Description,
+	}
+}
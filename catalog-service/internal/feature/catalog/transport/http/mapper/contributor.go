@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+func ContributorDomainsToDTOs(contributorDomains []domain.Contributor) []dto.Contributor {
+	contributorDTOs := make([]dto.Contributor, len(contributorDomains))
+	for i := range contributorDomains {
+		contributorDTOs[i] = dto.Contributor{
+			Author: dto.Author{
+				ID:       contributorDomains[i].Author.ID,
+				Fullname: contributorDomains[i].Author.Fullname,
+			},
+			Role:     contributorDomains[i].Role,
+			Position: contributorDomains[i].Position,
+		}
+	}
+	return contributorDTOs
+}
+
+func ContributorRequestDTOsToDomains(contributorRequestDTOs []dto.ContributorRequest) []domain.Contributor {
+	contributorDomains := make([]domain.Contributor, len(contributorRequestDTOs))
+	for i := range contributorRequestDTOs {
+		contributorDomains[i] = domain.Contributor{
+			Author: domain.Author{ID: contributorRequestDTOs[i].AuthorID},
+			Role:   contributorRequestDTOs[i].Role,
+		}
+	}
+	return contributorDomains
+}
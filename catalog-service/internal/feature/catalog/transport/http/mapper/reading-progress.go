@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+func ReadingProgressDomainsToDTOs(readingProgressDomains []domain.ReadingProgress) []dto.ReadingProgress {
+	readingProgressDTOs := make([]dto.ReadingProgress, len(readingProgressDomains))
+	for i := range readingProgressDomains {
+		readingProgressDTOs[i] = ReadingProgressDomainToDTO(&readingProgressDomains[i])
+	}
+	return readingProgressDTOs
+}
+
+func ReadingProgressDomainToDTO(readingProgressDomain *domain.ReadingProgress) dto.ReadingProgress {
+	return dto.ReadingProgress{
+		Book:       BookDomainToDTO(&readingProgressDomain.Book),
+		LastPage:   readingProgressDomain.LastPage,
+		Percentage: readingProgressDomain.Percentage,
+		StartedAt:  readingProgressDomain.StartedAt,
+		UpdatedAt:  readingProgressDomain.UpdatedAt,
+	}
+}
@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+func RevisionDomainToDTO(revisionDomain *domain.Revision) dto.Revision {
+	return dto.Revision{
+		ID:          revisionDomain.ID,
+		EntityType:  revisionDomain.EntityType,
+		EntityID:    revisionDomain.EntityID,
+		Action:      revisionDomain.Action,
+		ActorUserID: revisionDomain.ActorUserID,
+		Before:      revisionDomain.Before,
+		After:       revisionDomain.After,
+		CreatedAt:   revisionDomain.CreatedAt,
+	}
+}
+
+func RevisionDomainsToDTOs(revisionDomains []domain.Revision) []dto.Revision {
+	revisionDTOs := make([]dto.Revision, len(revisionDomains))
+	for i := range revisionDomains {
+		revisionDTOs[i] = RevisionDomainToDTO(&revisionDomains[i])
+	}
+	return revisionDTOs
+}
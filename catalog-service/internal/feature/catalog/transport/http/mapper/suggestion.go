@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+func SuggestionDomainToDTO(suggestionDomain *domain.Suggestion) dto.Suggestion {
+	return dto.Suggestion{
+		Type:     suggestionDomain.Type,
+		Text:     suggestionDomain.Text,
+		EntityID: suggestionDomain.EntityID,
+	}
+}
+
+func SuggestionDomainsToDTOs(suggestionDomains []domain.Suggestion) []dto.Suggestion {
+	suggestionDTOs := make([]dto.Suggestion, len(suggestionDomains))
+	for i := range suggestionDomains {
+		suggestionDTOs[i] = SuggestionDomainToDTO(&suggestionDomains[i])
+	}
+	return suggestionDTOs
+}
@@ -0,0 +1,7 @@
+package query
+
+type ListAuthors struct {
+	Name  string `form:"name"`
+	Page  uint   `form:"page,default=1" binding:"min=1"`
+	Count uint   `form:"count,default=20" binding:"min=1,max=100"`
+}
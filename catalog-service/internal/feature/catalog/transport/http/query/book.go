@@ -8,10 +8,19 @@ type ListBooksByCategory struct {
 }
 
 type SearchBooks struct {
-	Author string `form:"author"`
-	Title  string `form:"title"`
-	Page   uint   `form:"page,default=1" binding:"min=1"`
-	Count  uint   `form:"count,default=20" binding:"min=1,max=100"`
-	Sort   string `form:"sort,default=title"`
-	Order  string `form:"order,default=asc"`
+	Author    string   `form:"author"`
+	Title     string   `form:"title"`
+	ISBN      string   `form:"isbn"`
+	Tags      []string `form:"tags" binding:"max=20"`
+	TagsMatch string   `form:"tagsMatch,default=any" binding:"oneof=any all"`
+	Page      uint     `form:"page,default=1" binding:"min=1"`
+	Count     uint     `form:"count,default=20" binding:"min=1,max=100"`
+	Sort      string   `form:"sort,default=title"`
+	Order     string   `form:"order,default=asc"`
+}
+
+type GetTrendingBooks struct {
+	Window   string `form:"window,default=week" binding:"oneof=day week month"`
+	Category string `form:"category"`
+	Count    uint   `form:"count,default=10" binding:"min=1,max=100"`
 }
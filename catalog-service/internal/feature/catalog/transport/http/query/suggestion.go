@@ -0,0 +1,6 @@
+package query
+
+type SuggestSearch struct {
+	Query string `form:"q" binding:"required,min=2,max=100"`
+	Count uint   `form:"count,default=8" binding:"min=1,max=20"`
+}
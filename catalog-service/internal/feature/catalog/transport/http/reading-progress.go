@@ -0,0 +1,57 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// GetReadingProgress godoc
+//
+//	@Summary		Continue reading
+//	@Description	Returns books which the authorized user started but hasn't finished yet, most recently read first
+//	@Tags			catalog
+//	@Param			page	query	int		false	"Page number (min=1, default=1)"
+//	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.ReadingProgress
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/reading [get]
+func (h *catalogHandler) GetReadingProgress(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var query query.GetReadingProgress
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetReadingProgress")
+	defer span.End()
+
+	readingProgressDomains, err := h.catalogService.GetReadingProgress(ctx, uint(userID), query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get reading progress error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ReadingProgressDomainsToDTOs(readingProgressDomains))
+}
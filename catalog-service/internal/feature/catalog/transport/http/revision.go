@@ -0,0 +1,115 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// GetBookHistory godoc
+//
+//	@Summary		Get book history
+//	@Description	Returns paginated revisions of a book, the newest first. Each revision holds the acting user and the book state before and after the change
+//	@Tags			catalog
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Param			page	query	int		false	"Page number (min=1, default=1)"
+//	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Revision
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/history [get]
+func (h *catalogHandler) GetBookHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.GetBookHistory
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetBookHistory")
+	defer span.End()
+
+	revisionDomains, err := h.catalogService.GetBookHistory(ctx, uint(bookID), query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get book history error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.RevisionDomainsToDTOs(revisionDomains))
+}
+
+// RevertBook godoc
+//
+//	@Summary		Revert a book to a revision
+//	@Description	Brings the metadata and contributors of a book back to the state right after the revision (or right before it for deletions). Title, year, category and cover aren't reverted. The revert is recorded as a new revision
+//	@Tags			catalog
+//	@Param			bookID		path	uint	true	"Book ID"
+//	@Param			revisionID	path	uint	true	"Revision ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		409 {object}	dto.Error "Entity already exists"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/history/{revisionID}/revert [post]
+func (h *catalogHandler) RevertBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	revisionIDString := c.Param("revisionID")
+	revisionID, err := strconv.ParseUint(revisionIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.RevertBook")
+	defer span.End()
+
+	bookDomain, err := h.catalogService.RevertBook(ctx, uint(bookID), uint(revisionID), uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Revert book error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookDomainToDTO(bookDomain))
+}
@@ -3,9 +3,11 @@ package http
 import (
 	"net/http"
 
-	"github.com/Yarik7610/library-backend-common/transport/http/route"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/catalog-service/docs"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -14,49 +16,83 @@ import (
 
 func NewRouter(config *config.Config, metricsHandler http.Handler, catalogHandler CatalogHandler) *gin.Engine {
 	r := gin.Default()
+	// Only the gateway reaches the service, it resolves the client address and passes it in its own header
+	r.SetTrustedProxies(nil)
+	r.TrustedPlatform = header.CLIENT_IP
 
 	r.Use(otelgin.Middleware(config.ServiceName,
 		otelgin.WithGinFilter(func(c *gin.Context) bool {
-			return c.FullPath() != route.METRICS
+			return c.FullPath() != sharedRoute.METRICS
 		}),
 	))
 
-	r.GET(route.METRICS, gin.WrapH(metricsHandler))
+	r.GET(sharedRoute.METRICS, gin.WrapH(metricsHandler))
 
 	docs.SwaggerInfo.BasePath = "/"
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	catalogGroup := r.Group(route.CATALOG)
+	catalogGroup := r.Group(sharedRoute.CATALOG)
 	{
-		bookGroup := catalogGroup.Group(route.BOOKS)
+		bookGroup := catalogGroup.Group(sharedRoute.BOOKS)
 		{
-			bookGroup.GET(route.CATEGORIES, catalogHandler.GetBookCategories)
-			bookGroup.GET(route.CATEGORIES+"/:categoryName", catalogHandler.ListBooksByCategory)
-			bookGroup.GET("/:bookID"+route.PREVIEW, catalogHandler.PreviewBook)
+			bookGroup.GET(sharedRoute.CATEGORIES, catalogHandler.GetBookCategories)
+			bookGroup.GET(sharedRoute.CATEGORIES+"/:categoryName", catalogHandler.ListBooksByCategory)
+			bookGroup.GET("/:bookID"+sharedRoute.PREVIEW, catalogHandler.PreviewBook)
 			bookGroup.GET("/:bookID", catalogHandler.GetBookPage)
-			bookGroup.GET(route.SEARCH, catalogHandler.SearchBooks)
-			bookGroup.GET(route.NEW, catalogHandler.GetNewBooks)
-			bookGroup.GET(route.POPULAR, catalogHandler.GetPopularBooks)
-			bookGroup.GET("/:bookID"+route.VIEWS, catalogHandler.GetBookViewsCount)
+			bookGroup.GET(sharedRoute.SEARCH, catalogHandler.SearchBooks)
+			bookGroup.GET(sharedRoute.NEW, catalogHandler.GetNewBooks)
+			bookGroup.GET(sharedRoute.POPULAR, catalogHandler.GetPopularBooks)
+			bookGroup.GET(route.TRENDING, catalogHandler.GetTrendingBooks)
+			bookGroup.GET("/:bookID"+sharedRoute.VIEWS, catalogHandler.GetBookViewsCount)
+			bookGroup.GET("/:bookID"+route.COVER, catalogHandler.GetBookCover)
 
 			adminGroup := bookGroup.Group("")
 			{
 				adminGroup.DELETE("/:bookID", catalogHandler.DeleteBook)
 				adminGroup.POST("", catalogHandler.AddBook)
+				adminGroup.PUT("/:bookID"+route.CONTRIBUTORS, catalogHandler.SetBookContributors)
+				adminGroup.PUT("/:bookID"+route.METADATA, catalogHandler.UpdateBookMetadata)
+				adminGroup.PUT("/:bookID"+route.COVER, catalogHandler.SetBookCover)
+				adminGroup.DELETE("/:bookID"+route.COVER, catalogHandler.DeleteBookCover)
+				adminGroup.GET("/:bookID"+route.HISTORY, catalogHandler.GetBookHistory)
+				adminGroup.POST("/:bookID"+route.HISTORY+"/:revisionID"+route.REVERT, catalogHandler.RevertBook)
 			}
 		}
 
-		authorGroup := catalogGroup.Group(route.AUTHORS)
+		catalogGroup.GET(sharedRoute.SEARCH+route.SUGGEST, catalogHandler.SuggestSearch)
+
+		categoryGroup := catalogGroup.Group(sharedRoute.CATEGORIES)
+		{
+			categoryGroup.GET("", catalogHandler.GetCategories)
+			categoryGroup.GET("/:categoryID", catalogHandler.GetCategory)
+
+			adminGroup := categoryGroup.Group("")
+			{
+				adminGroup.POST("", catalogHandler.CreateCategory)
+				adminGroup.PUT("/:categoryID", catalogHandler.UpdateCategory)
+				adminGroup.DELETE("/:categoryID", catalogHandler.DeleteCategory)
+			}
+		}
+
+		authorGroup := catalogGroup.Group(sharedRoute.AUTHORS)
 		{
-			authorGroup.GET("/:authorID"+route.BOOKS, catalogHandler.GetBooksByAuthorID)
+			authorGroup.GET("", catalogHandler.ListAuthors)
+			authorGroup.GET("/:authorID", catalogHandler.GetAuthor)
+			authorGroup.GET("/:authorID"+sharedRoute.BOOKS, catalogHandler.GetBooksByAuthorID)
 
 			adminGroup := authorGroup.Group("")
 			{
+				adminGroup.PUT("/:authorID", catalogHandler.UpdateAuthor)
 				adminGroup.DELETE("/:authorID", catalogHandler.DeleteAuthor)
 				adminGroup.POST("", catalogHandler.CreateAuthor)
 			}
 		}
 	}
 
+	meGroup := r.Group(sharedRoute.ME)
+	{
+		meGroup.GET(route.READING, catalogHandler.GetReadingProgress)
+	}
+
 	return r
 }
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+// SuggestSearch godoc
+//
+//	@Summary		Suggest search queries
+//	@Description	Returns titles, author names and aliases for the search box, starting with the query or close to it despite typos. Titles and names starting with the query go first
+//	@Tags			catalog
+//	@Param			q		query	string	true	"Typed query (min=2, max=100)"
+//	@Param			count	query	int		false	"Number of suggestions (min=1, max=20, default=8)"
+//	@Produce		json
+//	@Success		200	{array}		dto.Suggestion
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/search/suggest [get]
+func (h *catalogHandler) SuggestSearch(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.SuggestSearch
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SuggestSearch")
+	defer span.End()
+
+	suggestionDomains, err := h.catalogService.SuggestSearch(ctx, query.Query, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Suggest search error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.SuggestionDomainsToDTOs(suggestionDomains))
+}
@@ -0,0 +1,119 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	MIN_REFRESH_RETRY_BACKOFF = time.Second
+	MAX_REFRESH_RETRY_BACKOFF = time.Minute
+)
+
+// BookChangedConsumer is the only place which invalidates book caches after books or categories change
+type BookChangedConsumer interface {
+	Run(ctx context.Context)
+}
+
+type bookChangedConsumer struct {
+	logger            *logging.Logger
+	bookChangedReader *kafkaInfrastructure.OtelReader
+	catalogService    service.CatalogService
+}
+
+func NewBookChangedConsumer(
+	logger *logging.Logger,
+	bookChangedReader *kafkaInfrastructure.OtelReader,
+	catalogService service.CatalogService,
+) BookChangedConsumer {
+	return &bookChangedConsumer{
+		logger:            logger,
+		bookChangedReader: bookChangedReader,
+		catalogService:    catalogService,
+	}
+}
+
+func (c *bookChangedConsumer) Run(ctx context.Context) {
+	defer c.bookChangedReader.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		message, spanCtx, span, err := c.bookChangedReader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error(ctx, "Book changed message fetch error", logging.Error(err))
+			continue
+		}
+
+		if err := c.processMessage(spanCtx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			c.logger.Error(spanCtx, "Book changed message process error", logging.Error(err))
+			span.End()
+			continue
+		}
+
+		if err := c.bookChangedReader.CommitMessages(spanCtx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			c.logger.Error(spanCtx, "Book changed message commit error", logging.Any("message", message), logging.Error(err))
+		}
+		span.End()
+	}
+}
+
+// processMessage doesn't give up on failed refreshes, skipping the message would leave the caches stale for good,
+// e.g. trashed books would stay popular. It only returns on shutdown, then the uncommitted message is consumed again
+func (c *bookChangedConsumer) processMessage(ctx context.Context, message kafka.Message) error {
+	var bookChanged event.BookChanged
+	if err := json.Unmarshal(message.Value, &bookChanged); err != nil {
+		return err
+	}
+
+	backoff := MIN_REFRESH_RETRY_BACKOFF
+	for {
+		err := c.refreshBookCaches(ctx, bookChanged)
+		if err == nil {
+			return nil
+		}
+		c.logger.Warn(ctx, "Retry refresh book caches",
+			logging.String("change", bookChanged.Change),
+			logging.String("backoff", backoff.String()),
+			logging.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, MAX_REFRESH_RETRY_BACKOFF)
+	}
+}
+
+func (c *bookChangedConsumer) refreshBookCaches(ctx context.Context, bookChanged event.BookChanged) error {
+	// To not inherit kafka's cancelled context already, for cases to process old messages on microservice reboot
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	return c.catalogService.RefreshBookCaches(refreshCtx, bookChanged.Change, bookChanged.BookIDs)
+}
@@ -0,0 +1,32 @@
+package download
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires book downloads into the HTTP router of the catalog feature.
+// Generated files are cached in the blob storage shared with covers and imports
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	blobStorage blob.Storage,
+	httpRouter *gin.Engine,
+) {
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresPageRepository := catalogPostgres.NewPageRepository(postgresDB)
+	postgresBookFileRepository := postgres.NewBookFileRepository(postgresDB)
+
+	downloadService := service.NewDownloadService(logger, blobStorage, config.PDFFontFile, postgresBookRepository, postgresPageRepository, postgresBookFileRepository)
+
+	httpDownloadHandler := httpTransport.NewDownloadHandler(config, logger, downloadService)
+	httpTransport.RegisterRoutes(httpRouter, httpDownloadHandler)
+}
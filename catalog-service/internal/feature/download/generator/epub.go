@@ -0,0 +1,284 @@
+package generator
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"text/template"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+// marcRelators maps contributor roles to the MARC relator codes used by EPUB readers
+var marcRelators = map[string]string{
+	domain.ContributorRoleAuthor:      "aut",
+	domain.ContributorRoleCoAuthor:    "aut",
+	domain.ContributorRoleEditor:      "edt",
+	domain.ContributorRoleTranslator:  "trl",
+	domain.ContributorRoleIllustrator: "ill",
+}
+
+var coverExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+var epubTemplates = template.Must(template.New("epub").Funcs(template.FuncMap{
+	"x":   xmlEscape,
+	"inc": func(i int) int { return i + 1 },
+}).Parse(`
+{{define "container.xml"}}<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+{{end}}
+
+{{define "content.opf"}}<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id" xml:lang="{{x .Language}}">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="book-id">{{x .Identifier}}</dc:identifier>
+    <dc:title>{{x .Book.Title}}</dc:title>
+    <dc:language>{{x .Language}}</dc:language>
+    {{- range $i, $creator := .Creators}}
+    <dc:{{$creator.Element}} id="creator-{{$i}}">{{x $creator.Name}}</dc:{{$creator.Element}}>
+    <meta refines="#creator-{{$i}}" property="role" scheme="marc:relators">{{$creator.Role}}</meta>
+    {{- end}}
+    {{- if .Book.Year}}
+    <dc:date>{{printf "%04d" .Book.Year}}</dc:date>
+    {{- end}}
+    {{- if .Book.Metadata.Publisher}}
+    <dc:publisher>{{x .Book.Metadata.Publisher}}</dc:publisher>
+    {{- end}}
+    {{- if .Book.Metadata.Description}}
+    <dc:description>{{x .Book.Metadata.Description}}</dc:description>
+    {{- end}}
+    {{- if .Book.Category}}
+    <dc:subject>{{x .Book.Category}}</dc:subject>
+    {{- end}}
+    <meta property="dcterms:modified">{{.Modified}}</meta>
+    {{- if .CoverFile}}
+    <meta name="cover" content="cover-image"/>
+    {{- end}}
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="ncx" href="toc.ncx" media-type="application/x-dtbncx+xml"/>
+    {{- if .CoverFile}}
+    <item id="cover-image" href="{{.CoverFile}}" media-type="{{.CoverType}}" properties="cover-image"/>
+    <item id="cover" href="cover.xhtml" media-type="application/xhtml+xml"/>
+    {{- end}}
+    {{- range .Pages}}
+    <item id="{{.ID}}" href="{{.File}}" media-type="application/xhtml+xml"/>
+    {{- end}}
+  </manifest>
+  <spine toc="ncx">
+    {{- if .CoverFile}}
+    <itemref idref="cover" linear="no"/>
+    {{- end}}
+    {{- range .Pages}}
+    <itemref idref="{{.ID}}"/>
+    {{- end}}
+  </spine>
+</package>
+{{end}}
+
+{{define "nav.xhtml"}}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{x .Language}}">
+<head><title>{{x .Book.Title}}</title></head>
+<body>
+  <nav epub:type="toc" id="toc">
+    <h1>{{x .Book.Title}}</h1>
+    <ol>
+      {{- range .Pages}}
+      <li><a href="{{.File}}">{{x .Title}}</a></li>
+      {{- end}}
+    </ol>
+  </nav>
+</body>
+</html>
+{{end}}
+
+{{define "toc.ncx"}}<?xml version="1.0" encoding="UTF-8"?>
+<ncx xmlns="http://www.daisy.org/z3986/2005/ncx/" version="2005-1">
+  <head><meta name="dtb:uid" content="{{x .Identifier}}"/></head>
+  <docTitle><text>{{x .Book.Title}}</text></docTitle>
+  <navMap>
+    {{- range $i, $page := .Pages}}
+    <navPoint id="nav-{{$page.ID}}" playOrder="{{inc $i}}">
+      <navLabel><text>{{x $page.Title}}</text></navLabel>
+      <content src="{{$page.File}}"/>
+    </navPoint>
+    {{- end}}
+  </navMap>
+</ncx>
+{{end}}
+
+{{define "cover.xhtml"}}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{x .Language}}">
+<head><title>{{x .Book.Title}}</title></head>
+<body><div style="text-align: center"><img src="{{.CoverFile}}" alt="{{x .Book.Title}}" style="max-width: 100%; max-height: 100%"/></div></body>
+</html>
+{{end}}
+
+{{define "page.xhtml"}}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{x .Language}}">
+<head><title>{{x .Title}}</title></head>
+<body>
+<section id="{{.ID}}">
+{{- range .Paragraphs}}
+<p>{{range $i, $line := .}}{{if $i}}<br/>{{end}}{{x $line}}{{end}}</p>
+{{- end}}
+</section>
+</body>
+</html>
+{{end}}
+`))
+
+type epubCreator struct {
+	Element string
+	Name    string
+	Role    string
+}
+
+type epubPage struct {
+	ID         string
+	File       string
+	Title      string
+	Language   string
+	Paragraphs [][]string
+}
+
+type epubPackage struct {
+	Book       *domain.Book
+	Identifier string
+	Language   string
+	Modified   string
+	Creators   []epubCreator
+	CoverFile  string
+	CoverType  string
+	Pages      []epubPage
+}
+
+// WriteEPUB writes an EPUB 3 book with one XHTML document per stored page and an EPUB 2 table of contents
+// for older readers. Cover is optional, unsupported cover types are skipped
+func WriteEPUB(w io.Writer, book *domain.Book, pages []domain.Page, cover *domain.BookCover) error {
+	packageDocument := newEPUBPackage(book, pages, cover)
+
+	zipWriter := zip.NewWriter(w)
+
+	// The mimetype has to be the first entry and must not be compressed
+	mimetypeWriter, err := zipWriter.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(mimetypeWriter, "application/epub+zip"); err != nil {
+		return err
+	}
+
+	templateFiles := [][2]string{
+		{"META-INF/container.xml", "container.xml"},
+		{"OEBPS/content.opf", "content.opf"},
+		{"OEBPS/nav.xhtml", "nav.xhtml"},
+		{"OEBPS/toc.ncx", "toc.ncx"},
+	}
+	if packageDocument.CoverFile != "" {
+		templateFiles = append(templateFiles, [2]string{"OEBPS/cover.xhtml", "cover.xhtml"})
+	}
+	for _, templateFile := range templateFiles {
+		if err := writeEPUBTemplate(zipWriter, templateFile[0], templateFile[1], packageDocument); err != nil {
+			return err
+		}
+	}
+
+	if packageDocument.CoverFile != "" {
+		coverWriter, err := zipWriter.Create("OEBPS/" + packageDocument.CoverFile)
+		if err != nil {
+			return err
+		}
+		if _, err := coverWriter.Write(cover.Content); err != nil {
+			return err
+		}
+	}
+
+	for i := range packageDocument.Pages {
+		if err := writeEPUBTemplate(zipWriter, "OEBPS/"+packageDocument.Pages[i].File, "page.xhtml", &packageDocument.Pages[i]); err != nil {
+			return err
+		}
+	}
+
+	return zipWriter.Close()
+}
+
+func newEPUBPackage(book *domain.Book, pages []domain.Page, cover *domain.BookCover) *epubPackage {
+	language := book.Metadata.Language
+	if language == "" {
+		language = "und"
+	}
+
+	packageDocument := &epubPackage{
+		Book:       book,
+		Identifier: bookIdentifier(book),
+		Language:   language,
+		Modified:   time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		Creators:   []epubCreator{{Element: "creator", Name: book.Author.Fullname, Role: "aut"}},
+		Pages:      make([]epubPage, len(pages)),
+	}
+
+	for i := range book.Contributors {
+		contributor := &book.Contributors[i]
+		if contributor.Author.ID == book.Author.ID && contributor.Role == domain.ContributorRoleAuthor {
+			continue
+		}
+		element := "contributor"
+		if marcRelators[contributor.Role] == "aut" {
+			element = "creator"
+		}
+		packageDocument.Creators = append(packageDocument.Creators, epubCreator{
+			Element: element,
+			Name:    contributor.Author.Fullname,
+			Role:    marcRelators[contributor.Role],
+		})
+	}
+
+	if cover != nil {
+		if extension, ok := coverExtensions[cover.ContentType]; ok {
+			packageDocument.CoverFile = "images/cover." + extension
+			packageDocument.CoverType = cover.ContentType
+		}
+	}
+
+	for i := range pages {
+		id := fmt.Sprintf("page-%04d", pages[i].Number)
+		packageDocument.Pages[i] = epubPage{
+			ID:         id,
+			File:       "text/" + id + ".xhtml",
+			Title:      pageTitle(&pages[i]),
+			Language:   language,
+			Paragraphs: paragraphs(pages[i].Content),
+		}
+	}
+	return packageDocument
+}
+
+func writeEPUBTemplate(zipWriter *zip.Writer, name, templateName string, data any) error {
+	fileWriter, err := zipWriter.Create(name)
+	if err != nil {
+		return err
+	}
+	return epubTemplates.ExecuteTemplate(fileWriter, templateName, data)
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	pdfBodyFontSize  = 11
+	pdfLineHeight    = 5.5
+	pdfTitleFontSize = 22
+)
+
+// pdfImageTypes are cover types the PDF writer can embed, WebP covers are left out
+var pdfImageTypes = map[string]string{
+	"image/jpeg": "JPG",
+	"image/png":  "PNG",
+}
+
+// WritePDF writes an A4 PDF with a title page and every stored page starting on a new PDF page.
+// Core PDF fonts only cover Western European characters, so fontFile should point to a TrueType font
+// with wider coverage. Without it other characters are replaced
+func WritePDF(w io.Writer, book *domain.Book, pages []domain.Page, cover *domain.BookCover, fontFile string) error {
+	// gofpdf joins font file names with the font directory, so absolute paths have to be split
+	pdf := gofpdf.New("P", "mm", "A4", filepath.Dir(fontFile))
+	pdf.SetTitle(book.Title, true)
+	pdf.SetAuthor(book.Author.Fullname, true)
+	pdf.SetSubject(book.Metadata.Description, true)
+	pdf.SetKeywords(bookIdentifier(book), true)
+	pdf.SetCreator("library-backend", true)
+	pdf.SetAutoPageBreak(true, 20)
+
+	fontFamily := "Helvetica"
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	if fontFile != "" {
+		fontFamily = "Body"
+		translate = func(s string) string { return s }
+		pdf.AddUTF8Font(fontFamily, "", filepath.Base(fontFile))
+	}
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont(fontFamily, "", 9)
+		pdf.CellFormat(0, 10, strconv.Itoa(pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	writePDFTitlePage(pdf, book, cover, fontFamily, translate)
+
+	for i := range pages {
+		pdf.AddPage()
+		pdf.SetFont(fontFamily, "", pdfBodyFontSize)
+		pdf.Bookmark(pageTitle(&pages[i]), 0, -1)
+		for _, paragraph := range paragraphs(pages[i].Content) {
+			pdf.MultiCell(0, pdfLineHeight, translate(strings.Join(paragraph, "\n")), "", "J", false)
+			pdf.Ln(pdfLineHeight / 2)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+func writePDFTitlePage(pdf *gofpdf.Fpdf, book *domain.Book, cover *domain.BookCover, fontFamily string, translate func(string) string) {
+	pdf.AddPage()
+	// Bookmarks are encoded for the current font, so it has to be set first
+	pdf.SetFont(fontFamily, "", pdfTitleFontSize)
+	pdf.Bookmark(translate(book.Title), 0, -1)
+
+	pageWidth, _ := pdf.GetPageSize()
+	leftMargin, _, rightMargin, _ := pdf.GetMargins()
+
+	if cover != nil {
+		if imageType, ok := pdfImageTypes[cover.ContentType]; ok {
+			imageOptions := gofpdf.ImageOptions{ImageType: imageType, ReadDpi: true}
+			pdf.RegisterImageOptionsReader("cover", imageOptions, bytes.NewReader(cover.Content))
+			// A broken image shouldn't fail the whole book, so the error is dropped and the cover skipped
+			if pdf.Ok() {
+				coverWidth := (pageWidth - leftMargin - rightMargin) * 0.6
+				pdf.ImageOptions("cover", (pageWidth-coverWidth)/2, 25, coverWidth, 0, true, imageOptions, 0, "")
+				pdf.Ln(10)
+			} else {
+				pdf.ClearError()
+			}
+		}
+	}
+	if pdf.GetY() < 60 {
+		pdf.SetY(60)
+	}
+
+	pdf.SetFont(fontFamily, "", pdfTitleFontSize)
+	pdf.MultiCell(0, 10, translate(book.Title), "", "C", false)
+	pdf.Ln(4)
+
+	pdf.SetFont(fontFamily, "", 14)
+	pdf.MultiCell(0, 7, translate(book.Author.Fullname), "", "C", false)
+	pdf.Ln(4)
+
+	var details []string
+	if book.Metadata.Publisher != "" {
+		details = append(details, book.Metadata.Publisher)
+	}
+	if book.Year != 0 {
+		details = append(details, strconv.Itoa(book.Year))
+	}
+	if book.Metadata.ISBN != "" {
+		details = append(details, "ISBN "+book.Metadata.ISBN)
+	}
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.MultiCell(0, 5, translate(strings.Join(details, " · ")), "", "C", false)
+}
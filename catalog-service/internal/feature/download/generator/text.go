@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+// paragraphs splits page content on blank lines. Every paragraph keeps its own line breaks
+func paragraphs(content string) [][]string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var result [][]string
+	for _, block := range strings.Split(content, "\n\n") {
+		var lines []string
+		for _, line := range strings.Split(block, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		if len(lines) > 0 {
+			result = append(result, lines)
+		}
+	}
+	return result
+}
+
+func pageTitle(page *domain.Page) string {
+	return "Page " + strconv.FormatUint(uint64(page.Number), 10)
+}
+
+// bookIdentifier prefers the ISBN, so readers recognize the same book downloaded twice
+func bookIdentifier(book *domain.Book) string {
+	if book.Metadata.ISBN != "" {
+		return "urn:isbn:" + book.Metadata.ISBN
+	}
+	return "urn:library:book:" + strconv.FormatUint(uint64(book.ID), 10)
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookFileRepository interface {
+	FindByBookIDAndFormat(ctx context.Context, bookID uint, format string) (*model.BookFile, error)
+	Save(ctx context.Context, bookFile *model.BookFile) error
+	ListByBookID(ctx context.Context, bookID uint) ([]model.BookFile, error)
+}
+
+type bookFileRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewBookFileRepository(db *gorm.DB) BookFileRepository {
+	return &bookFileRepository{name: "Book file", timeout: 1 * time.Second, db: db}
+}
+
+func (r *bookFileRepository) FindByBookIDAndFormat(ctx context.Context, bookID uint, format string) (*model.BookFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookFile model.BookFile
+	if err := r.db.WithContext(ctx).Where("book_id = ?", bookID).Where("format = ?", format).First(&bookFile).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &bookFile, nil
+}
+
+func (r *bookFileRepository) Save(ctx context.Context, bookFile *model.BookFile) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Omit("Book").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "book_id"}, {Name: "format"}},
+			DoUpdates: clause.AssignmentColumns([]string{"fingerprint", "file_key", "created_at"}),
+		}).
+		Create(bookFile).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *bookFileRepository) ListByBookID(ctx context.Context, bookID uint) ([]model.BookFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookFiles []model.BookFile
+	if err := r.db.WithContext(ctx).Where("book_id = ?", bookID).Find(&bookFiles).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookFiles, nil
+}
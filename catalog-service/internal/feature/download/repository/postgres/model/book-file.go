@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+// BookFile is a generated book file cached in blob storage.
+// Fingerprint identifies the metadata and pages the file was generated from
+type BookFile struct {
+	BookID      uint   `gorm:"primaryKey;autoIncrement:false"`
+	Format      string `gorm:"primaryKey"`
+	Fingerprint string `gorm:"not null"`
+	FileKey     string `gorm:"not null"`
+	CreatedAt   time.Time
+	Book        catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
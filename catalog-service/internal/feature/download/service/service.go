@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/generator"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+	"golang.org/x/sync/singleflight"
+)
+
+const BOOK_FILE_ENTITY_NAME = "Book file"
+
+// BOOK_FILE_VERSION is part of every fingerprint, bump it when generators change so cached files are regenerated
+const BOOK_FILE_VERSION = 1
+
+type DownloadService interface {
+	DownloadBook(ctx context.Context, bookID uint, format string) (io.ReadCloser, *domain.Book, error)
+}
+
+type downloadService struct {
+	logger                     *logging.Logger
+	blobStorage                blob.Storage
+	pdfFontFile                string
+	postgresBookRepository     catalogPostgres.BookRepository
+	postgresPageRepository     catalogPostgres.PageRepository
+	postgresBookFileRepository postgres.BookFileRepository
+	generation                 singleflight.Group
+}
+
+func NewDownloadService(
+	logger *logging.Logger,
+	blobStorage blob.Storage,
+	pdfFontFile string,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresPageRepository catalogPostgres.PageRepository,
+	postgresBookFileRepository postgres.BookFileRepository,
+) DownloadService {
+	return &downloadService{
+		logger:                     logger,
+		blobStorage:                blobStorage,
+		pdfFontFile:                pdfFontFile,
+		postgresBookRepository:     postgresBookRepository,
+		postgresPageRepository:     postgresPageRepository,
+		postgresBookFileRepository: postgresBookFileRepository,
+	}
+}
+
+// DownloadBook returns the book file in the given format. Files are generated on the first download and cached
+// in blob storage until the metadata or pages of the book change, concurrent downloads share one generation
+func (s *downloadService) DownloadBook(ctx context.Context, bookID uint, format string) (io.ReadCloser, *domain.Book, error) {
+	bookWithAuthorModel, err := s.postgresBookRepository.FindByID(ctx, bookID)
+	if err != nil {
+		return nil, nil, err
+	}
+	bookDomain := catalogMapper.BookWithAuthorModelToDomain(bookWithAuthorModel)
+
+	pagesFingerprint, err := s.postgresPageRepository.FingerprintByBookID(ctx, bookID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pagesFingerprint.PagesCount == 0 {
+		return nil, nil, errs.NewBadRequestError("Book has no pages to download")
+	}
+
+	fingerprint, err := bookFileFingerprint(&bookDomain, pagesFingerprint.Hash, format)
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError().WithCause(err)
+	}
+
+	bookFileModel, err := s.postgresBookFileRepository.FindByBookIDAndFormat(ctx, bookID, format)
+	if err != nil && !errs.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if bookFileModel != nil && bookFileModel.Fingerprint == fingerprint {
+		content, err := s.blobStorage.Get(ctx, bookFileModel.FileKey)
+		if err == nil {
+			return content, &bookDomain, nil
+		}
+		if !errors.Is(err, blob.ErrNotFound) {
+			return nil, nil, blob.NewError(err, BOOK_FILE_ENTITY_NAME)
+		}
+		s.logger.Warn(ctx, "Cached book file is missing, regenerate it", logging.String("fileKey", bookFileModel.FileKey))
+	}
+
+	generationKey := fmt.Sprintf("%d-%s-%s", bookID, format, fingerprint)
+	file, err, _ := s.generation.Do(generationKey, func() (any, error) {
+		// Finish the generation even if the first downloader goes away, others may be waiting for it
+		return s.generateBookFile(context.WithoutCancel(ctx), &bookDomain, format, fingerprint, bookFileModel)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return io.NopCloser(bytes.NewReader(file.([]byte))), &bookDomain, nil
+}
+
+func (s *downloadService) generateBookFile(ctx context.Context, bookDomain *domain.Book, format, fingerprint string, previousBookFileModel *model.BookFile) ([]byte, error) {
+	pageModels, err := s.postgresPageRepository.ListByBookID(ctx, bookDomain.ID)
+	if err != nil {
+		return nil, err
+	}
+	pageDomains := catalogMapper.PageModelsToDomains(pageModels)
+
+	cover := s.loadCover(ctx, bookDomain.Metadata.CoverKey)
+
+	var file bytes.Buffer
+	switch format {
+	case domain.BookFileFormatPDF:
+		err = generator.WritePDF(&file, bookDomain, pageDomains, cover, s.pdfFontFile)
+	default:
+		err = generator.WriteEPUB(&file, bookDomain, pageDomains, cover)
+	}
+	if err != nil {
+		return nil, errs.NewInternalServerError().WithCause(err)
+	}
+
+	fileKey := fmt.Sprintf("downloads/%d-%s.%s", bookDomain.ID, fingerprint[:16], format)
+	if err := s.blobStorage.Put(ctx, fileKey, bytes.NewReader(file.Bytes())); err != nil {
+		return nil, blob.NewError(err, BOOK_FILE_ENTITY_NAME)
+	}
+
+	bookFileModel := model.BookFile{BookID: bookDomain.ID, Format: format, Fingerprint: fingerprint, FileKey: fileKey}
+	if err := s.postgresBookFileRepository.Save(ctx, &bookFileModel); err != nil {
+		s.deleteBookFileBlob(ctx, fileKey)
+		return nil, err
+	}
+	if previousBookFileModel != nil && previousBookFileModel.FileKey != fileKey {
+		s.deleteBookFileBlob(ctx, previousBookFileModel.FileKey)
+	}
+
+	return file.Bytes(), nil
+}
+
+// loadCover returns nil if the book has no cover or it can't be read, a book file is still useful without it
+func (s *downloadService) loadCover(ctx context.Context, coverKey string) *domain.BookCover {
+	if coverKey == "" {
+		return nil
+	}
+
+	content, err := s.blobStorage.Get(ctx, coverKey)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip book file cover", logging.String("coverKey", coverKey), logging.Error(err))
+		return nil
+	}
+	defer content.Close()
+
+	coverContent, err := io.ReadAll(content)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip book file cover", logging.String("coverKey", coverKey), logging.Error(err))
+		return nil
+	}
+	return &domain.BookCover{Content: coverContent, ContentType: mime.TypeByExtension(path.Ext(coverKey))}
+}
+
+// deleteBookFileBlob only logs failures, a leftover file isn't referenced anymore
+func (s *downloadService) deleteBookFileBlob(ctx context.Context, fileKey string) {
+	if err := s.blobStorage.Delete(ctx, fileKey); err != nil {
+		s.logger.Warn(ctx, "Skip delete book file", logging.String("fileKey", fileKey), logging.Error(err))
+	}
+}
+
+// bookFileFingerprint changes whenever anything written into the file changes: metadata, cover, contributors or pages
+func bookFileFingerprint(bookDomain *domain.Book, pagesHash, format string) (string, error) {
+	fingerprintSource := struct {
+		Version      int
+		Format       string
+		Title        string
+		Author       string
+		Contributors []domain.Contributor
+		Year         int
+		Category     string
+		Metadata     domain.BookMetadata
+		PagesHash    string
+	}{
+		Version:      BOOK_FILE_VERSION,
+		Format:       format,
+		Title:        bookDomain.Title,
+		Author:       bookDomain.Author.Fullname,
+		Contributors: bookDomain.Contributors,
+		Year:         bookDomain.Year,
+		Category:     bookDomain.Category,
+		Metadata:     bookDomain.Metadata,
+		PagesHash:    pagesHash,
+	}
+
+	source, err := json.Marshal(fingerprintSource)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(source)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+var bookFileContentTypes = map[string]string{
+	domain.BookFileFormatEPUB: "application/epub+zip",
+	domain.BookFileFormatPDF:  "application/pdf",
+}
+
+type DownloadHandler interface {
+	DownloadBook(c *gin.Context)
+}
+
+type downloadHandler struct {
+	config          *config.Config
+	logger          *logging.Logger
+	downloadService service.DownloadService
+}
+
+func NewDownloadHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	downloadService service.DownloadService,
+) DownloadHandler {
+	return &downloadHandler{
+		config:          config,
+		logger:          logger,
+		downloadService: downloadService,
+	}
+}
+
+// DownloadBook godoc
+//
+//	@Summary		Download book
+//	@Description	Returns the whole book as an EPUB or PDF file assembled from its pages, with metadata and cover.
+//	@Description	Files are generated on the first download and cached until the book or its pages change
+//	@Tags			download
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Param			format	query	string	false	"File format (epub / pdf, default=epub)"
+//	@Produce		application/epub+zip,application/pdf
+//	@Success		200	{file}		binary
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/download [get]
+func (h *downloadHandler) DownloadBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.DownloadBook
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DownloadBook")
+	defer span.End()
+
+	content, bookDomain, err := h.downloadService.DownloadBook(ctx, uint(bookID), query.Format)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Download book error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+	defer content.Close()
+
+	fileName := strings.NewReplacer("/", "_", "\\", "_").Replace(bookDomain.Title) + "." + query.Format
+	extraHeaders := map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": fileName}),
+	}
+	c.DataFromReader(http.StatusOK, -1, bookFileContentTypes[query.Format], content, extraHeaders)
+}
@@ -0,0 +1,5 @@
+package query
+
+type DownloadBook struct {
+	Format string `form:"format,default=epub" binding:"oneof=epub pdf"`
+}
@@ -0,0 +1,15 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts download routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, downloadHandler DownloadHandler) {
+	bookGroup := r.Group(sharedRoute.CATALOG + sharedRoute.BOOKS)
+	{
+		bookGroup.GET("/:bookID"+route.DOWNLOAD, downloadHandler.DownloadBook)
+	}
+}
@@ -0,0 +1,29 @@
+package export
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogService "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires bulk export and OPDS feeds into the HTTP router of the catalog feature.
+// Feeds reuse the catalog service, so they list the same books as the JSON endpoints
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	httpRouter *gin.Engine,
+	catalogService catalogService.CatalogService,
+) {
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+
+	exportService := service.NewExportService(postgresBookRepository)
+
+	httpExportHandler := httpTransport.NewExportHandler(config, logger, exportService, catalogService)
+	httpTransport.RegisterRoutes(httpRouter, httpExportHandler)
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+)
+
+const EXPORT_BATCH_SIZE = 500
+
+type ExportService interface {
+	ExportBooks(ctx context.Context, yield func(bookDomains []domain.Book) error) error
+}
+
+type exportService struct {
+	postgresBookRepository catalogPostgres.BookRepository
+}
+
+func NewExportService(postgresBookRepository catalogPostgres.BookRepository) ExportService {
+	return &exportService{postgresBookRepository: postgresBookRepository}
+}
+
+// ExportBooks passes all books to yield in batches ordered by ID, so the whole catalog is never held in memory.
+// Books added during the export are included if their ID is bigger than the last exported one
+func (s *exportService) ExportBooks(ctx context.Context, yield func(bookDomains []domain.Book) error) error {
+	var lastBookID uint
+	for {
+		bookWithAuthorModels, err := s.postgresBookRepository.ListAfterID(ctx, lastBookID, EXPORT_BATCH_SIZE)
+		if err != nil {
+			return err
+		}
+		if len(bookWithAuthorModels) == 0 {
+			return nil
+		}
+
+		if err := yield(catalogMapper.BookWithAuthorModelsToDomains(bookWithAuthorModels)); err != nil {
+			return err
+		}
+
+		if len(bookWithAuthorModels) < EXPORT_BATCH_SIZE {
+			return nil
+		}
+		lastBookID = bookWithAuthorModels[len(bookWithAuthorModels)-1].ID
+	}
+}
@@ -0,0 +1,7 @@
+package dto
+
+// BOOK_CSV_HEADER columns match the CSV manifest accepted by imports, so an export can be imported elsewhere
+var BOOK_CSV_HEADER = []string{
+	"id", "title", "author", "contributors", "year", "category",
+	"isbn", "publisher", "language", "pageCount", "description", "ratingAverage", "ratingCount",
+}
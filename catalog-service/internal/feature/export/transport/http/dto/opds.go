@@ -0,0 +1,55 @@
+package dto
+
+import "encoding/xml"
+
+const (
+	OPDS_NAVIGATION_TYPE  = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	OPDS_ACQUISITION_TYPE = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+)
+
+// OPDSFeed is an OPDS 1.2 catalog, which is an Atom feed with OPDS link relations
+type OPDSFeed struct {
+	XMLName   xml.Name    `xml:"http://www.w3.org/2005/Atom feed" swaggerignore:"true"`
+	XMLNSDC   string      `xml:"xmlns:dc,attr"`
+	XMLNSOPDS string      `xml:"xmlns:opds,attr"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Links     []OPDSLink  `xml:"link"`
+	Entries   []OPDSEntry `xml:"entry"`
+}
+
+type OPDSLink struct {
+	Rel   string `xml:"rel,attr"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+}
+
+type OPDSEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Authors    []OPDSAuthor   `xml:"author"`
+	Language   string         `xml:"dc:language,omitempty"`
+	Publisher  string         `xml:"dc:publisher,omitempty"`
+	Issued     string         `xml:"dc:issued,omitempty"`
+	Identifier string         `xml:"dc:identifier,omitempty"`
+	Categories []OPDSCategory `xml:"category"`
+	Content    *OPDSContent   `xml:"content"`
+	Links      []OPDSLink     `xml:"link"`
+}
+
+type OPDSAuthor struct {
+	Name string `xml:"name"`
+}
+
+type OPDSCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+type OPDSContent struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogService "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler interface {
+	ExportBooks(c *gin.Context)
+	GetOPDSRoot(c *gin.Context)
+	GetOPDSCategories(c *gin.Context)
+	GetOPDSCategoryBooks(c *gin.Context)
+	GetOPDSNewBooks(c *gin.Context)
+	GetOPDSPopularBooks(c *gin.Context)
+}
+
+type exportHandler struct {
+	config         *config.Config
+	logger         *logging.Logger
+	exportService  service.ExportService
+	catalogService catalogService.CatalogService
+}
+
+func NewExportHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	exportService service.ExportService,
+	catalogService catalogService.CatalogService,
+) ExportHandler {
+	return &exportHandler{
+		config:         config,
+		logger:         logger,
+		exportService:  exportService,
+		catalogService: catalogService,
+	}
+}
+
+// ExportBooks godoc
+//
+//	@Summary		Export books
+//	@Description	Streams all books with their authors, contributors, categories and metadata, ordered by ID.
+//	@Description	JSONL has one book object per line, CSV columns can be imported back as a CSV manifest
+//	@Tags			export
+//	@Param			format	query	string	false	"Export format (jsonl / csv, default=jsonl)"
+//	@Produce		application/x-ndjson,text/csv
+//	@Security		BearerAuth
+//	@Success		200	{file}		binary
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/export [get]
+func (h *exportHandler) ExportBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.ExportBooks
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ExportBooks")
+	defer span.End()
+
+	var yield func(bookDomains []domain.Book) error
+	var csvWriter *csv.Writer
+	switch query.Format {
+	case "csv":
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="books.csv"`)
+
+		// The header stays buffered until the first batch, so an early error can still be rendered as JSON.
+		// Write errors are sticky and reported by Error after the flush
+		csvWriter = csv.NewWriter(c.Writer)
+		_ = csvWriter.Write(dto.BOOK_CSV_HEADER)
+		yield = func(bookDomains []domain.Book) error {
+			for i := range bookDomains {
+				if err := csvWriter.Write(mapper.BookDomainToCSVRecord(&bookDomains[i])); err != nil {
+					return err
+				}
+			}
+			csvWriter.Flush()
+			c.Writer.Flush()
+			return csvWriter.Error()
+		}
+	default:
+		c.Header("Content-Type", "application/x-ndjson")
+		c.Header("Content-Disposition", `attachment; filename="books.jsonl"`)
+
+		jsonEncoder := json.NewEncoder(c.Writer)
+		yield = func(bookDomains []domain.Book) error {
+			for i := range bookDomains {
+				if err := jsonEncoder.Encode(catalogMapper.BookDomainToDTO(&bookDomains[i])); err != nil {
+					return err
+				}
+			}
+			c.Writer.Flush()
+			return nil
+		}
+	}
+
+	if err := h.exportService.ExportBooks(ctx, yield); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Export books error", logging.Error(err))
+
+		// Once a batch is written the status can't be changed, the client sees a truncated file
+		if c.Writer.Written() {
+			c.Abort()
+			return
+		}
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+	}
+}
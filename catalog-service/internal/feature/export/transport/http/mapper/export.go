@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+// BookDomainToCSVRecord returns a record with the columns of dto.BOOK_CSV_HEADER.
+// Contributors are joined as "Fullname (role); ..." because CSV has no nested values
+func BookDomainToCSVRecord(bookDomain *domain.Book) []string {
+	contributors := make([]string, len(bookDomain.Contributors))
+	for i := range bookDomain.Contributors {
+		contributors[i] = bookDomain.Contributors[i].Author.Fullname + " (" + bookDomain.Contributors[i].Role + ")"
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(bookDomain.ID), 10),
+		bookDomain.Title,
+		bookDomain.Author.Fullname,
+		strings.Join(contributors, "; "),
+		strconv.Itoa(bookDomain.Year),
+		bookDomain.Category,
+		bookDomain.Metadata.ISBN,
+		bookDomain.Metadata.Publisher,
+		bookDomain.Metadata.Language,
+		formatOptionalUint(bookDomain.Metadata.PageCount),
+		bookDomain.Metadata.Description,
+		strconv.FormatFloat(bookDomain.RatingAverage, 'f', 2, 64),
+		strconv.FormatUint(uint64(bookDomain.RatingCount), 10),
+	}
+}
+
+func formatOptionalUint(value uint) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
@@ -0,0 +1,120 @@
+package mapper
+
+import (
+	"mime"
+	"path"
+	"strconv"
+
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+)
+
+const (
+	OPDS_ROOT_HREF       = sharedRoute.CATALOG + route.OPDS
+	OPDS_CATEGORIES_HREF = OPDS_ROOT_HREF + sharedRoute.CATEGORIES
+	OPDS_NEW_HREF        = OPDS_ROOT_HREF + sharedRoute.NEW
+	OPDS_POPULAR_HREF    = OPDS_ROOT_HREF + sharedRoute.POPULAR
+)
+
+// NewOPDSFeed returns a feed with self and start links, updated is an RFC 3339 timestamp
+func NewOPDSFeed(id, title, selfHref, feedType, updated string) dto.OPDSFeed {
+	return dto.OPDSFeed{
+		XMLNSDC:   "http://purl.org/dc/terms/",
+		XMLNSOPDS: "http://opds-spec.org/2010/catalog",
+		ID:        id,
+		Title:     title,
+		Updated:   updated,
+		Links: []dto.OPDSLink{
+			{Rel: "self", Href: selfHref, Type: feedType},
+			{Rel: "start", Href: OPDS_ROOT_HREF, Type: dto.OPDS_NAVIGATION_TYPE},
+		},
+		Entries: []dto.OPDSEntry{},
+	}
+}
+
+func NavigationEntry(id, title, href, feedType, content, updated string) dto.OPDSEntry {
+	return dto.OPDSEntry{
+		ID:      id,
+		Title:   title,
+		Updated: updated,
+		Content: &dto.OPDSContent{Type: "text", Text: content},
+		Links:   []dto.OPDSLink{{Rel: "subsection", Href: href, Type: feedType}},
+	}
+}
+
+// CategoryDomainsToOPDSEntries flattens the category tree, children are titled "Parent / Child"
+func CategoryDomainsToOPDSEntries(categoryDomains []domain.Category, updated string) []dto.OPDSEntry {
+	entries := make([]dto.OPDSEntry, 0, len(categoryDomains))
+	var walk func(categoryDomains []domain.Category, titlePrefix string)
+	walk = func(categoryDomains []domain.Category, titlePrefix string) {
+		for i := range categoryDomains {
+			title := titlePrefix + categoryDomains[i].Name
+			entries = append(entries, NavigationEntry(
+				"urn:library:category:"+strconv.FormatUint(uint64(categoryDomains[i].ID), 10),
+				title,
+				OPDS_CATEGORIES_HREF+"/"+categoryDomains[i].Slug,
+				dto.OPDS_ACQUISITION_TYPE,
+				categoryDomains[i].Description,
+				updated,
+			))
+			walk(categoryDomains[i].Children, title+" / ")
+		}
+	}
+	walk(categoryDomains, "")
+	return entries
+}
+
+func BookDomainsToOPDSEntries(bookDomains []domain.Book, updated string) []dto.OPDSEntry {
+	entries := make([]dto.OPDSEntry, len(bookDomains))
+	for i := range bookDomains {
+		entries[i] = BookDomainToOPDSEntry(&bookDomains[i], updated)
+	}
+	return entries
+}
+
+func BookDomainToOPDSEntry(bookDomain *domain.Book, updated string) dto.OPDSEntry {
+	bookHref := sharedRoute.CATALOG + sharedRoute.BOOKS + "/" + strconv.FormatUint(uint64(bookDomain.ID), 10)
+
+	entry := dto.OPDSEntry{
+		ID:        "urn:library:book:" + strconv.FormatUint(uint64(bookDomain.ID), 10),
+		Title:     bookDomain.Title,
+		Updated:   updated,
+		Authors:   []dto.OPDSAuthor{{Name: bookDomain.Author.Fullname}},
+		Language:  bookDomain.Metadata.Language,
+		Publisher: bookDomain.Metadata.Publisher,
+		Links: []dto.OPDSLink{
+			{Rel: "alternate", Href: bookHref + sharedRoute.PREVIEW, Type: "application/json", Title: "Preview"},
+			{Rel: "http://opds-spec.org/acquisition/open-access", Href: bookHref + route.DOWNLOAD + "?format=epub", Type: "application/epub+zip", Title: "EPUB"},
+			{Rel: "http://opds-spec.org/acquisition/open-access", Href: bookHref + route.DOWNLOAD + "?format=pdf", Type: "application/pdf", Title: "PDF"},
+		},
+	}
+	for i := range bookDomain.Contributors {
+		role := bookDomain.Contributors[i].Role
+		if (role == domain.ContributorRoleAuthor || role == domain.ContributorRoleCoAuthor) && bookDomain.Contributors[i].Author.ID != bookDomain.Author.ID {
+			entry.Authors = append(entry.Authors, dto.OPDSAuthor{Name: bookDomain.Contributors[i].Author.Fullname})
+		}
+	}
+	if bookDomain.Year != 0 {
+		entry.Issued = strconv.Itoa(bookDomain.Year)
+	}
+	if bookDomain.Metadata.ISBN != "" {
+		entry.Identifier = "urn:isbn:" + bookDomain.Metadata.ISBN
+	}
+	if bookDomain.Category != "" {
+		entry.Categories = []dto.OPDSCategory{{Term: bookDomain.Category, Label: bookDomain.Category}}
+	}
+	if bookDomain.Metadata.Description != "" {
+		entry.Content = &dto.OPDSContent{Type: "text", Text: bookDomain.Metadata.Description}
+	}
+	if bookDomain.Metadata.CoverKey != "" {
+		coverHref := bookHref + route.COVER
+		coverType := mime.TypeByExtension(path.Ext(bookDomain.Metadata.CoverKey))
+		entry.Links = append(entry.Links,
+			dto.OPDSLink{Rel: "http://opds-spec.org/image", Href: coverHref, Type: coverType},
+			dto.OPDSLink{Rel: "http://opds-spec.org/image/thumbnail", Href: coverHref, Type: coverType},
+		)
+	}
+	return entry
+}
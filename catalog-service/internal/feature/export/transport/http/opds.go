@@ -0,0 +1,198 @@
+package http
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+// GetOPDSRoot godoc
+//
+//	@Summary		OPDS catalog root
+//	@Description	Returns the OPDS 1.2 navigation feed which links to categories, new and popular books
+//	@Tags			opds
+//	@Produce		xml
+//	@Success		200	{object}	dto.OPDSFeed
+//	@Router			/catalog/opds [get]
+func (h *exportHandler) GetOPDSRoot(c *gin.Context) {
+	updated := opdsNow()
+
+	feed := mapper.NewOPDSFeed("urn:library:opds", "Library", mapper.OPDS_ROOT_HREF, dto.OPDS_NAVIGATION_TYPE, updated)
+	feed.Entries = append(feed.Entries,
+		mapper.NavigationEntry("urn:library:opds:categories", "Categories", mapper.OPDS_CATEGORIES_HREF, dto.OPDS_NAVIGATION_TYPE, "Browse books by category", updated),
+		mapper.NavigationEntry("urn:library:opds:new", "New books", mapper.OPDS_NEW_HREF, dto.OPDS_ACQUISITION_TYPE, "Recently added books", updated),
+		mapper.NavigationEntry("urn:library:opds:popular", "Popular books", mapper.OPDS_POPULAR_HREF, dto.OPDS_ACQUISITION_TYPE, "The most viewed books", updated),
+	)
+
+	renderOPDSFeed(c, dto.OPDS_NAVIGATION_TYPE, &feed)
+}
+
+// GetOPDSCategories godoc
+//
+//	@Summary		OPDS categories
+//	@Description	Returns the OPDS navigation feed of all categories, subcategories are titled "Parent / Child"
+//	@Tags			opds
+//	@Produce		xml
+//	@Success		200	{object}	dto.OPDSFeed
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/opds/categories [get]
+func (h *exportHandler) GetOPDSCategories(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetCategories")
+	defer span.End()
+
+	categoryDomains, err := h.catalogService.GetCategories(ctx)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get OPDS categories error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	updated := opdsNow()
+	feed := mapper.NewOPDSFeed("urn:library:opds:categories", "Categories", mapper.OPDS_CATEGORIES_HREF, dto.OPDS_NAVIGATION_TYPE, updated)
+	feed.Entries = mapper.CategoryDomainsToOPDSEntries(categoryDomains, updated)
+
+	renderOPDSFeed(c, dto.OPDS_NAVIGATION_TYPE, &feed)
+}
+
+// GetOPDSCategoryBooks godoc
+//
+//	@Summary		OPDS category books
+//	@Description	Returns the paginated OPDS acquisition feed of books of a category and all its subcategories, ordered by title
+//	@Tags			opds
+//	@Param			categoryName	path	string	true	"Category slug or name"
+//	@Param			page	query	int		false	"Page number (min=1, default=1)"
+//	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		xml
+//	@Success		200	{object}	dto.OPDSFeed
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/opds/categories/{categoryName} [get]
+func (h *exportHandler) GetOPDSCategoryBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	categoryName := c.Param("categoryName")
+
+	var query query.GetOPDSCategoryBooks
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ListBooksByCategory")
+	defer span.End()
+
+	bookDomains, err := h.catalogService.ListBooksByCategory(ctx, categoryName, query.Page, query.Count, "title", "asc")
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get OPDS category books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	updated := opdsNow()
+	selfHref := mapper.OPDS_CATEGORIES_HREF + "/" + categoryName
+	feed := mapper.NewOPDSFeed("urn:library:opds:category:"+categoryName, categoryName, opdsPageHref(selfHref, query.Page, query.Count), dto.OPDS_ACQUISITION_TYPE, updated)
+	feed.Links = append(feed.Links, dto.OPDSLink{Rel: "up", Href: mapper.OPDS_CATEGORIES_HREF, Type: dto.OPDS_NAVIGATION_TYPE})
+	if query.Page > 1 {
+		feed.Links = append(feed.Links, dto.OPDSLink{Rel: "previous", Href: opdsPageHref(selfHref, query.Page-1, query.Count), Type: dto.OPDS_ACQUISITION_TYPE})
+	}
+	// A full page is the only hint that there may be more books
+	if uint(len(bookDomains)) == query.Count {
+		feed.Links = append(feed.Links, dto.OPDSLink{Rel: "next", Href: opdsPageHref(selfHref, query.Page+1, query.Count), Type: dto.OPDS_ACQUISITION_TYPE})
+	}
+	feed.Entries = mapper.BookDomainsToOPDSEntries(bookDomains, updated)
+
+	renderOPDSFeed(c, dto.OPDS_ACQUISITION_TYPE, &feed)
+}
+
+// GetOPDSNewBooks godoc
+//
+//	@Summary		OPDS new books
+//	@Description	Returns the OPDS acquisition feed of recently added books
+//	@Tags			opds
+//	@Produce		xml
+//	@Success		200	{object}	dto.OPDSFeed
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/opds/new [get]
+func (h *exportHandler) GetOPDSNewBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetNewBooks")
+	defer span.End()
+
+	bookDomains, err := h.catalogService.GetNewBooks(ctx)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get OPDS new books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	updated := opdsNow()
+	feed := mapper.NewOPDSFeed("urn:library:opds:new", "New books", mapper.OPDS_NEW_HREF, dto.OPDS_ACQUISITION_TYPE, updated)
+	feed.Entries = mapper.BookDomainsToOPDSEntries(bookDomains, updated)
+
+	renderOPDSFeed(c, dto.OPDS_ACQUISITION_TYPE, &feed)
+}
+
+// GetOPDSPopularBooks godoc
+//
+//	@Summary		OPDS popular books
+//	@Description	Returns the OPDS acquisition feed of the most viewed books
+//	@Tags			opds
+//	@Produce		xml
+//	@Success		200	{object}	dto.OPDSFeed
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/opds/popular [get]
+func (h *exportHandler) GetOPDSPopularBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetPopularBooks")
+	defer span.End()
+
+	bookDomains, err := h.catalogService.GetPopularBooks(ctx)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get OPDS popular books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	updated := opdsNow()
+	feed := mapper.NewOPDSFeed("urn:library:opds:popular", "Popular books", mapper.OPDS_POPULAR_HREF, dto.OPDS_ACQUISITION_TYPE, updated)
+	feed.Entries = mapper.BookDomainsToOPDSEntries(bookDomains, updated)
+
+	renderOPDSFeed(c, dto.OPDS_ACQUISITION_TYPE, &feed)
+}
+
+func renderOPDSFeed(c *gin.Context, feedType string, feed *dto.OPDSFeed) {
+	body, err := xml.Marshal(feed)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, feedType, append([]byte(xml.Header), body...))
+}
+
+// opdsNow is the updated timestamp of feeds and entries, books don't track when they were changed
+func opdsNow() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func opdsPageHref(href string, page, count uint) string {
+	return href + "?page=" + strconv.FormatUint(uint64(page), 10) + "&count=" + strconv.FormatUint(uint64(count), 10)
+}
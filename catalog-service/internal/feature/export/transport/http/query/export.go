@@ -0,0 +1,10 @@
+package query
+
+type ExportBooks struct {
+	Format string `form:"format,default=jsonl" binding:"oneof=jsonl csv"`
+}
+
+type GetOPDSCategoryBooks struct {
+	Page  uint `form:"page,default=1" binding:"min=1"`
+	Count uint `form:"count,default=20" binding:"min=1,max=100"`
+}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts export and OPDS routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, exportHandler ExportHandler) {
+	catalogGroup := r.Group(sharedRoute.CATALOG)
+	{
+		catalogGroup.GET(sharedRoute.BOOKS+route.EXPORT, exportHandler.ExportBooks)
+
+		opdsGroup := catalogGroup.Group(route.OPDS)
+		{
+			opdsGroup.GET("", exportHandler.GetOPDSRoot)
+			opdsGroup.GET(sharedRoute.CATEGORIES, exportHandler.GetOPDSCategories)
+			opdsGroup.GET(sharedRoute.CATEGORIES+"/:categoryName", exportHandler.GetOPDSCategoryBooks)
+			opdsGroup.GET(sharedRoute.NEW, exportHandler.GetOPDSNewBooks)
+			opdsGroup.GET(sharedRoute.POPULAR, exportHandler.GetOPDSPopularBooks)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package importer
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogService "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires the import feature into the HTTP router of the catalog feature.
+// Books are added through the catalog service, so imports behave like books added by hand.
+// The returned job has to be run by the container
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	blobStorage blob.Storage,
+	httpRouter *gin.Engine,
+	catalogService catalogService.CatalogService,
+) job.Job {
+	postgresAuthorRepository := catalogPostgres.NewAuthorRepository(postgresDB)
+	postgresImportJobRepository := postgres.NewImportJobRepository(postgresDB)
+
+	importService := service.NewImportService(logger, blobStorage, catalogService, postgresAuthorRepository, postgresImportJobRepository)
+
+	httpImportHandler := httpTransport.NewImportHandler(config, logger, importService)
+	httpTransport.RegisterRoutes(httpRouter, httpImportHandler)
+
+	return job.NewJob(config, logger, importService)
+}
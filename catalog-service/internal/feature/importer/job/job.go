@@ -0,0 +1,69 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+)
+
+// Job imports queued files one by one. It wakes up when a file is uploaded to this instance
+// and polls periodically for files uploaded to other instances
+type Job interface {
+	Run(ctx context.Context)
+}
+
+type job struct {
+	config        *config.Config
+	logger        *logging.Logger
+	importService service.ImportService
+}
+
+func NewJob(config *config.Config, logger *logging.Logger, importService service.ImportService) Job {
+	return &job{
+		config:        config,
+		logger:        logger,
+		importService: importService,
+	}
+}
+
+// Run blocks until ctx is cancelled
+func (j *job) Run(ctx context.Context) {
+	if err := j.importService.FailInterruptedImportJobs(ctx); err != nil {
+		j.logger.Error(ctx, "Fail interrupted import jobs error", logging.Error(err))
+	}
+
+	ticker := time.NewTicker(j.config.ImportJobInterval)
+	defer ticker.Stop()
+
+	for {
+		for j.runOnce(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-j.importService.Queued():
+		}
+	}
+}
+
+// runOnce reports whether a job was run, so the caller continues until the queue is empty
+func (j *job) runOnce(ctx context.Context) bool {
+	ctx, span := tracing.Span(ctx, j.config.ServiceName, "job.Import")
+	defer span.End()
+
+	ran, err := j.importService.RunNextImportJob(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false
+		}
+		tracing.Error(span, err)
+		j.logger.Error(ctx, "Run import job error", logging.Error(err))
+	}
+	return ran && ctx.Err() == nil
+}
@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+// csvColumns are recognized manifest columns, header names are matched ignoring case, spaces, dashes and underscores
+var csvColumns = []string{"title", "author", "year", "category", "isbn", "publisher", "language", "pagecount", "description"}
+
+// CSVRow is one book of a CSV manifest. Err is set if the row can't be turned into a book
+type CSVRow struct {
+	Line uint
+	Book domain.Book
+	Err  error
+}
+
+// CSVManifestReader reads book metadata from a CSV file with a header row, one book per row.
+// Manifest books have no pages
+type CSVManifestReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func NewCSVManifestReader(content io.Reader) (*CSVManifestReader, error) {
+	reader := csv.NewReader(content)
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("CSV manifest is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "", "\ufeff", "").Replace(name))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("CSV manifest must have a title column, known columns are %s", strings.Join(csvColumns, ", "))
+	}
+
+	return &CSVManifestReader{reader: reader, columns: columns}, nil
+}
+
+// Next returns the next row or io.EOF after the last one. Malformed rows are returned with Err set,
+// other errors mean the rest of the file can't be read
+func (r *CSVManifestReader) Next() (*CSVRow, error) {
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &CSVRow{Line: uint(parseErr.StartLine), Err: parseErr.Err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	line, _ := r.reader.FieldPos(0)
+	row := &CSVRow{Line: uint(line)}
+	row.Book, row.Err = r.recordToBook(record)
+	return row, nil
+}
+
+func (r *CSVManifestReader) recordToBook(record []string) (domain.Book, error) {
+	book := domain.Book{
+		Title:    r.field(record, "title"),
+		Author:   domain.Author{Fullname: r.field(record, "author")},
+		Category: r.field(record, "category"),
+		Metadata: domain.BookMetadata{
+			ISBN:        r.field(record, "isbn"),
+			Publisher:   r.field(record, "publisher"),
+			Language:    r.field(record, "language"),
+			Description: r.field(record, "description"),
+		},
+	}
+
+	if year := r.field(record, "year"); year != "" {
+		parsedYear, err := strconv.Atoi(year)
+		if err != nil {
+			return book, fmt.Errorf("invalid year %q", year)
+		}
+		book.Year = parsedYear
+	}
+
+	if pageCount := r.field(record, "pagecount"); pageCount != "" {
+		parsedPageCount, err := strconv.ParseUint(pageCount, 10, 32)
+		if err != nil {
+			return book, fmt.Errorf("invalid page count %q", pageCount)
+		}
+		book.Metadata.PageCount = uint(parsedPageCount)
+	}
+
+	return book, nil
+}
+
+func (r *CSVManifestReader) field(record []string, column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
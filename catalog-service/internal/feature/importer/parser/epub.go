@@ -0,0 +1,214 @@
+package parser
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+// maxEPUBEntrySize guards against entries which unpack into something huge
+const maxEPUBEntrySize = 32 << 20
+
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+type epubCreator struct {
+	Name string `xml:",chardata"`
+	Role string `xml:"role,attr"`
+}
+
+type epubPackage struct {
+	Metadata struct {
+		Titles       []string      `xml:"title"`
+		Creators     []epubCreator `xml:"creator"`
+		Contributors []epubCreator `xml:"contributor"`
+		Languages    []string      `xml:"language"`
+		Publishers   []string      `xml:"publisher"`
+		Descriptions []string      `xml:"description"`
+		Identifiers  []string      `xml:"identifier"`
+		Dates        []string      `xml:"date"`
+	} `xml:"metadata"`
+	Manifest []struct {
+		ID        string `xml:"id,attr"`
+		Href      string `xml:"href,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+// epubContributorRoles maps MARC relator codes used by EPUB to contributor roles, unknown roles are skipped
+var epubContributorRoles = map[string]string{
+	"aut": domain.ContributorRoleAuthor,
+	"edt": domain.ContributorRoleEditor,
+	"trl": domain.ContributorRoleTranslator,
+	"ill": domain.ContributorRoleIllustrator,
+}
+
+// ParseEPUB reads metadata from the package document and turns spine chapters into pages,
+// every chapter starts on a new page. Contributors only have full names, IDs are resolved by the caller.
+// Subjects are free-form, so the category is left for import defaults
+func ParseEPUB(content io.ReaderAt, size int64, pageSize int) (*domain.Book, error) {
+	archive, err := zip.NewReader(content, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EPUB archive: %w", err)
+	}
+
+	var container epubContainer
+	if err := decodeEPUBEntry(archive, "META-INF/container.xml", &container); err != nil {
+		return nil, err
+	}
+	if len(container.Rootfiles) == 0 {
+		return nil, errors.New("EPUB container has no package document")
+	}
+
+	packagePath := container.Rootfiles[0].FullPath
+	var packageDocument epubPackage
+	if err := decodeEPUBEntry(archive, packagePath, &packageDocument); err != nil {
+		return nil, err
+	}
+
+	book := epubPackageToBook(&packageDocument)
+
+	manifestHrefs := make(map[string]string, len(packageDocument.Manifest))
+	for _, item := range packageDocument.Manifest {
+		manifestHrefs[item.ID] = item.Href
+	}
+
+	for _, itemRef := range packageDocument.Spine {
+		href, ok := manifestHrefs[itemRef.IDRef]
+		if !ok {
+			continue
+		}
+		href, _, _ = strings.Cut(href, "#")
+		href, err := url.PathUnescape(href)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPUB chapter path %q: %w", href, err)
+		}
+
+		chapter, err := openEPUBEntry(archive, path.Join(path.Dir(packagePath), href))
+		if err != nil {
+			return nil, err
+		}
+		text, err := htmlToText(chapter)
+		chapter.Close()
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPUB chapter %q: %w", href, err)
+		}
+
+		pages := SplitPages(text, pageSize)
+		book.Pages = append(book.Pages, PagesToDomains(pages, uint(len(book.Pages))+1)...)
+	}
+
+	if len(book.Pages) == 0 {
+		return nil, errors.New("EPUB has no readable chapters")
+	}
+	return book, nil
+}
+
+func epubPackageToBook(packageDocument *epubPackage) *domain.Book {
+	metadata := &packageDocument.Metadata
+	book := &domain.Book{
+		Title: firstNonEmpty(metadata.Titles),
+		Metadata: domain.BookMetadata{
+			Publisher:   firstNonEmpty(metadata.Publishers),
+			Language:    firstNonEmpty(metadata.Languages),
+			Description: firstNonEmpty(metadata.Descriptions),
+		},
+	}
+
+	for _, identifier := range metadata.Identifiers {
+		identifier = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(identifier)), "urn:isbn:")
+		if isbn, ok := domain.NormalizeISBN(identifier); ok {
+			book.Metadata.ISBN = isbn
+			break
+		}
+	}
+
+	if date := firstNonEmpty(metadata.Dates); len(date) >= 4 {
+		if year, err := strconv.Atoi(date[:4]); err == nil {
+			book.Year = year
+		}
+	}
+
+	// Creators without a role are authors, contributors without a role are skipped
+	for i := range metadata.Creators {
+		if strings.TrimSpace(metadata.Creators[i].Role) == "" {
+			metadata.Creators[i].Role = "aut"
+		}
+	}
+
+	for _, creator := range slices.Concat(metadata.Creators, metadata.Contributors) {
+		role, ok := epubContributorRoles[strings.ToLower(strings.TrimSpace(creator.Role))]
+		name := strings.Join(strings.Fields(creator.Name), " ")
+		if !ok || name == "" {
+			continue
+		}
+
+		if book.Author.Fullname == "" && role == domain.ContributorRoleAuthor {
+			book.Author.Fullname = name
+			continue
+		}
+		if role == domain.ContributorRoleAuthor {
+			role = domain.ContributorRoleCoAuthor
+		}
+		book.Contributors = append(book.Contributors, domain.Contributor{Author: domain.Author{Fullname: name}, Role: role})
+	}
+
+	return book
+}
+
+func decodeEPUBEntry(archive *zip.Reader, name string, target any) error {
+	entry, err := openEPUBEntry(archive, name)
+	if err != nil {
+		return err
+	}
+	defer entry.Close()
+
+	decoder := xml.NewDecoder(entry)
+	decoder.Strict = false
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("invalid EPUB entry %q: %w", name, err)
+	}
+	return nil
+}
+
+func openEPUBEntry(archive *zip.Reader, name string) (io.ReadCloser, error) {
+	for _, file := range archive.File {
+		if file.Name != name {
+			continue
+		}
+		if file.UncompressedSize64 > maxEPUBEntrySize {
+			return nil, fmt.Errorf("EPUB entry %q is too large", name)
+		}
+
+		entry, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPUB entry %q: %w", name, err)
+		}
+		return entry, nil
+	}
+	return nil, fmt.Errorf("EPUB entry %q not found", name)
+}
+
+func firstNonEmpty(values []string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+var blockElements = map[string]bool{
+	"p": true, "div": true, "br": true, "li": true, "tr": true, "blockquote": true, "pre": true,
+	"section": true, "article": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+var skippedElements = map[string]bool{"head": true, "script": true, "style": true}
+
+// htmlToText extracts readable text from an XHTML chapter, block elements become paragraphs.
+// The decoder is lenient, so sloppy HTML in real world EPUB files doesn't stop the import
+func htmlToText(content io.Reader) (string, error) {
+	decoder := xml.NewDecoder(content)
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	var text strings.Builder
+	var paragraph strings.Builder
+	skipDepth := 0
+
+	flushParagraph := func() {
+		if content := strings.Join(strings.Fields(paragraph.String()), " "); content != "" {
+			if text.Len() > 0 {
+				text.WriteString("\n\n")
+			}
+			text.WriteString(content)
+		}
+		paragraph.Reset()
+	}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := strings.ToLower(token.Name.Local)
+			if skippedElements[name] || skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+			if blockElements[name] {
+				flushParagraph()
+			}
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if blockElements[strings.ToLower(token.Name.Local)] {
+				flushParagraph()
+			}
+		case xml.CharData:
+			if skipDepth == 0 {
+				paragraph.Write(token)
+			}
+		}
+	}
+	flushParagraph()
+
+	return text.String(), nil
+}
@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+// SplitPages cuts text into pages of at most pageSize characters. Pages end on paragraph
+// boundaries when possible, a paragraph longer than a page is cut at the last space
+func SplitPages(text string, pageSize int) []string {
+	pages := []string{}
+	var page strings.Builder
+
+	flush := func() {
+		if content := strings.TrimSpace(page.String()); content != "" {
+			pages = append(pages, content)
+		}
+		page.Reset()
+	}
+
+	for _, paragraph := range splitParagraphs(text) {
+		pageLength := utf8.RuneCountInString(page.String())
+		paragraphLength := utf8.RuneCountInString(paragraph)
+
+		if pageLength > 0 && pageLength+2+paragraphLength > pageSize {
+			flush()
+			pageLength = 0
+		}
+
+		for paragraphLength > pageSize {
+			head, tail := cutAtSpace(paragraph, pageSize)
+			page.WriteString(head)
+			flush()
+			paragraph, paragraphLength = tail, utf8.RuneCountInString(tail)
+		}
+
+		if page.Len() > 0 {
+			page.WriteString("\n\n")
+		}
+		page.WriteString(paragraph)
+	}
+	flush()
+
+	return pages
+}
+
+// PagesToDomains numbers pages starting from firstNumber
+func PagesToDomains(pages []string, firstNumber uint) []domain.Page {
+	pageDomains := make([]domain.Page, len(pages))
+	for i := range pages {
+		pageDomains[i] = domain.Page{Number: firstNumber + uint(i), Content: pages[i]}
+	}
+	return pageDomains
+}
+
+func splitParagraphs(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	paragraphs := []string{}
+	for paragraph := range strings.SplitSeq(text, "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	return paragraphs
+}
+
+// cutAtSpace returns the first maxLength characters, shortened to the last space if there is one
+func cutAtSpace(text string, maxLength int) (string, string) {
+	runes := []rune(text)
+	cut := maxLength
+	for i := maxLength; i > maxLength/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = i
+			break
+		}
+	}
+	return strings.TrimSpace(string(runes[:cut])), strings.TrimSpace(string(runes[cut:]))
+}
@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"unicode/utf8"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// ParseText reads a UTF-8 plain text book. Title, author and category come from import defaults
+func ParseText(content io.Reader, pageSize int) (*domain.Book, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	if !utf8.Valid(data) {
+		return nil, errors.New("text file must be UTF-8 encoded")
+	}
+
+	pages := SplitPages(string(data), pageSize)
+	if len(pages) == 0 {
+		return nil, errors.New("text file is empty")
+	}
+
+	return &domain.Book{Pages: PagesToDomains(pages, 1)}, nil
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type ImportJobRepository interface {
+	Create(ctx context.Context, importJob *model.ImportJob) error
+	FindByIDWithRowErrors(ctx context.Context, importJobID uint) (*model.ImportJob, error)
+	List(ctx context.Context, page, count uint) ([]model.ImportJob, error)
+	ClaimNext(ctx context.Context, startedAt time.Time) (*model.ImportJob, error)
+	FailRunning(ctx context.Context, message string, finishedAt time.Time) (int64, error)
+	UpdateProgress(ctx context.Context, importJob *model.ImportJob) error
+	Finish(ctx context.Context, importJob *model.ImportJob) error
+	CreateRowError(ctx context.Context, rowError *model.ImportRowError) error
+}
+
+type importJobRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewImportJobRepository(db *gorm.DB) ImportJobRepository {
+	return &importJobRepository{name: "Import job(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *importJobRepository) Create(ctx context.Context, importJob *model.ImportJob) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Create(importJob).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *importJobRepository) FindByIDWithRowErrors(ctx context.Context, importJobID uint) (*model.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var importJob model.ImportJob
+	err := r.db.WithContext(ctx).
+		Preload("RowErrors", func(db *gorm.DB) *gorm.DB { return db.Order("row, id") }).
+		Where("id = ?", importJobID).
+		First(&importJob).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &importJob, nil
+}
+
+// List returns the most recent jobs first, without row errors
+func (r *importJobRepository) List(ctx context.Context, page, count uint) ([]model.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var importJobs []model.ImportJob
+	err := r.db.WithContext(ctx).
+		Order("created_at DESC, id DESC").
+		Offset(int((page - 1) * count)).
+		Limit(int(count)).
+		Find(&importJobs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return importJobs, nil
+}
+
+// ClaimNext marks the oldest queued job as running and returns it, or nil if the queue is empty.
+// Locked rows are skipped, so several service instances never pick the same job
+func (r *importJobRepository) ClaimNext(ctx context.Context, startedAt time.Time) (*model.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var importJobs []model.ImportJob
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE import_jobs SET status = ?, started_at = ?
+		WHERE id = (
+			SELECT id FROM import_jobs
+			WHERE status = ?
+			ORDER BY id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`, domain.ImportJobStatusRunning, startedAt, domain.ImportJobStatusQueued).Scan(&importJobs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	if len(importJobs) == 0 {
+		return nil, nil
+	}
+	return &importJobs[0], nil
+}
+
+// FailRunning finishes jobs which were interrupted by a restart
+func (r *importJobRepository) FailRunning(ctx context.Context, message string, finishedAt time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.ImportJob{}).
+		Where("status = ?", domain.ImportJobStatusRunning).
+		Updates(map[string]any{"status": domain.ImportJobStatusFailed, "error": message, "finished_at": finishedAt})
+	if result.Error != nil {
+		return 0, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *importJobRepository) UpdateProgress(ctx context.Context, importJob *model.ImportJob) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(importJob).
+		Select("total_rows", "imported_rows", "failed_rows").
+		Updates(importJob).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *importJobRepository) Finish(ctx context.Context, importJob *model.ImportJob) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(importJob).
+		Select("status", "total_rows", "imported_rows", "failed_rows", "error", "finished_at").
+		Updates(importJob).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *importJobRepository) CreateRowError(ctx context.Context, rowError *model.ImportRowError) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Create(rowError).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
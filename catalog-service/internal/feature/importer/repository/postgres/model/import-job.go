@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+type ImportJob struct {
+	ID           uint   `gorm:"primarykey"`
+	UserID       uint   `gorm:"not null;index"`
+	Format       string `gorm:"not null"`
+	FileName     string `gorm:"not null"`
+	FileKey      string `gorm:"not null"`
+	Author       string
+	Title        string
+	Category     string
+	PageSize     uint   `gorm:"not null"`
+	Status       string `gorm:"not null;index"`
+	TotalRows    uint   `gorm:"not null;default:0"`
+	ImportedRows uint   `gorm:"not null;default:0"`
+	FailedRows   uint   `gorm:"not null;default:0"`
+	Error        string `gorm:"type:text"`
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+	RowErrors    []ImportRowError `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type ImportRowError struct {
+	ID          uint   `gorm:"primarykey"`
+	ImportJobID uint   `gorm:"not null;index"`
+	Row         uint   `gorm:"not null"`
+	Message     string `gorm:"type:text;not null"`
+}
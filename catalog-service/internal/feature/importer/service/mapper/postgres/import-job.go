@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/repository/postgres/model"
+)
+
+func ImportJobModelToDomain(importJobModel *model.ImportJob) domain.ImportJob {
+	importJobDomain := domain.ImportJob{
+		ID:       importJobModel.ID,
+		UserID:   importJobModel.UserID,
+		Format:   importJobModel.Format,
+		FileName: importJobModel.FileName,
+		Defaults: domain.ImportDefaults{
+			Author:   importJobModel.Author,
+			Title:    importJobModel.Title,
+			Category: importJobModel.Category,
+			PageSize: importJobModel.PageSize,
+		},
+		Status:       importJobModel.Status,
+		TotalRows:    importJobModel.TotalRows,
+		ImportedRows: importJobModel.ImportedRows,
+		FailedRows:   importJobModel.FailedRows,
+		Error:        importJobModel.Error,
+		RowErrors:    make([]domain.ImportRowError, len(importJobModel.RowErrors)),
+		CreatedAt:    importJobModel.CreatedAt,
+		StartedAt:    importJobModel.StartedAt,
+		FinishedAt:   importJobModel.FinishedAt,
+	}
+	for i := range importJobModel.RowErrors {
+		importJobDomain.RowErrors[i] = domain.ImportRowError{
+			Row:     importJobModel.RowErrors[i].Row,
+			Message: importJobModel.RowErrors[i].Message,
+		}
+	}
+	return importJobDomain
+}
+
+func ImportJobModelsToDomains(importJobModels []model.ImportJob) []domain.ImportJob {
+	importJobDomains := make([]domain.ImportJob, len(importJobModels))
+	for i := range importJobModels {
+		importJobDomains[i] = ImportJobModelToDomain(&importJobModels[i])
+	}
+	return importJobDomains
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogService "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/parser"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+)
+
+const IMPORT_FILE_ENTITY_NAME = "Import file"
+
+type ImportService interface {
+	CreateImportJob(ctx context.Context, userID uint, fileName string, content io.Reader, defaults domain.ImportDefaults) (*domain.ImportJob, error)
+	GetImportJob(ctx context.Context, importJobID uint) (*domain.ImportJob, error)
+	ListImportJobs(ctx context.Context, page, count uint) ([]domain.ImportJob, error)
+	FailInterruptedImportJobs(ctx context.Context) error
+	RunNextImportJob(ctx context.Context) (bool, error)
+	Queued() <-chan struct{}
+}
+
+type importService struct {
+	logger                      *logging.Logger
+	blobStorage                 blob.Storage
+	catalogService              catalogService.CatalogService
+	postgresAuthorRepository    catalogPostgres.AuthorRepository
+	postgresImportJobRepository postgres.ImportJobRepository
+	queued                      chan struct{}
+}
+
+func NewImportService(
+	logger *logging.Logger,
+	blobStorage blob.Storage,
+	catalogService catalogService.CatalogService,
+	postgresAuthorRepository catalogPostgres.AuthorRepository,
+	postgresImportJobRepository postgres.ImportJobRepository) ImportService {
+	return &importService{
+		logger:                      logger,
+		blobStorage:                 blobStorage,
+		catalogService:              catalogService,
+		postgresAuthorRepository:    postgresAuthorRepository,
+		postgresImportJobRepository: postgresImportJobRepository,
+		queued:                      make(chan struct{}, 1),
+	}
+}
+
+// CreateImportJob keeps the file in blob storage and queues it, books are added later by the import job
+func (s *importService) CreateImportJob(ctx context.Context, userID uint, fileName string, content io.Reader, defaults domain.ImportDefaults) (*domain.ImportJob, error) {
+	format := strings.TrimPrefix(strings.ToLower(path.Ext(fileName)), ".")
+	if format != domain.ImportFormatEPUB && format != domain.ImportFormatText && format != domain.ImportFormatCSV {
+		return nil, errs.NewBadRequestError("Only EPUB, TXT and CSV files can be imported")
+	}
+
+	defaults.Author = strings.TrimSpace(defaults.Author)
+	defaults.Title = strings.TrimSpace(defaults.Title)
+	defaults.Category = strings.TrimSpace(defaults.Category)
+	if format == domain.ImportFormatText {
+		if defaults.Title == "" {
+			defaults.Title = strings.TrimSuffix(path.Base(fileName), path.Ext(fileName))
+		}
+		if defaults.Author == "" || defaults.Category == "" {
+			return nil, errs.NewBadRequestError("Author and category are required to import a text file")
+		}
+	}
+
+	fileKey := fmt.Sprintf("imports/%d-%d.%s", userID, time.Now().UnixNano(), format)
+	if err := s.blobStorage.Put(ctx, fileKey, content); err != nil {
+		return nil, blob.NewError(err, IMPORT_FILE_ENTITY_NAME)
+	}
+
+	importJobModel := model.ImportJob{
+		UserID:   userID,
+		Format:   format,
+		FileName: path.Base(fileName),
+		FileKey:  fileKey,
+		Author:   defaults.Author,
+		Title:    defaults.Title,
+		Category: defaults.Category,
+		PageSize: defaults.PageSize,
+		Status:   domain.ImportJobStatusQueued,
+	}
+	if err := s.postgresImportJobRepository.Create(ctx, &importJobModel); err != nil {
+		s.deleteImportFile(ctx, fileKey)
+		return nil, err
+	}
+
+	select {
+	case s.queued <- struct{}{}:
+	default:
+	}
+
+	importJobDomain := postgresMapper.ImportJobModelToDomain(&importJobModel)
+	return &importJobDomain, nil
+}
+
+func (s *importService) GetImportJob(ctx context.Context, importJobID uint) (*domain.ImportJob, error) {
+	importJobModel, err := s.postgresImportJobRepository.FindByIDWithRowErrors(ctx, importJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	importJobDomain := postgresMapper.ImportJobModelToDomain(importJobModel)
+	return &importJobDomain, nil
+}
+
+func (s *importService) ListImportJobs(ctx context.Context, page, count uint) ([]domain.ImportJob, error) {
+	importJobModels, err := s.postgresImportJobRepository.List(ctx, page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.ImportJobModelsToDomains(importJobModels), nil
+}
+
+// FailInterruptedImportJobs finishes jobs which were running when the service crashed.
+// They aren't restarted, because books imported before the crash would fail as duplicates
+func (s *importService) FailInterruptedImportJobs(ctx context.Context) error {
+	failedCount, err := s.postgresImportJobRepository.FailRunning(ctx, "Import was interrupted", time.Now())
+	if err != nil {
+		return err
+	}
+	if failedCount > 0 {
+		s.logger.Warn(ctx, "Interrupted import jobs failed", logging.Int("count", int(failedCount)))
+	}
+	return nil
+}
+
+// RunNextImportJob imports the oldest queued file, it returns false if there was nothing to import
+func (s *importService) RunNextImportJob(ctx context.Context) (bool, error) {
+	importJobModel, err := s.postgresImportJobRepository.ClaimNext(ctx, time.Now())
+	if err != nil {
+		return false, err
+	}
+	if importJobModel == nil {
+		return false, nil
+	}
+
+	importJobModel.Status = domain.ImportJobStatusCompleted
+	if err := s.runImportJob(ctx, importJobModel); err != nil {
+		importJobModel.Status = domain.ImportJobStatusFailed
+		importJobModel.Error = importErrorMessage(err)
+		if ctx.Err() != nil {
+			importJobModel.Error = "Import was interrupted"
+		}
+		s.logger.Error(ctx, "Import job error", logging.Int("importJobID", int(importJobModel.ID)), logging.Error(err))
+	}
+
+	// The job is finished even if the service is shutting down, so it doesn't stay running forever
+	finishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	importJobModel.FinishedAt = &now
+	if err := s.postgresImportJobRepository.Finish(finishCtx, importJobModel); err != nil {
+		return true, err
+	}
+
+	s.deleteImportFile(finishCtx, importJobModel.FileKey)
+	return true, nil
+}
+
+func (s *importService) Queued() <-chan struct{} {
+	return s.queued
+}
+
+func (s *importService) runImportJob(ctx context.Context, importJobModel *model.ImportJob) error {
+	content, err := s.blobStorage.Get(ctx, importJobModel.FileKey)
+	if err != nil {
+		return blob.NewError(err, IMPORT_FILE_ENTITY_NAME)
+	}
+	defer content.Close()
+
+	pageSize := int(importJobModel.PageSize)
+
+	switch importJobModel.Format {
+	case domain.ImportFormatEPUB:
+		// Zip needs random access, so the archive is read into memory, upload size is limited by the handler
+		data, err := io.ReadAll(content)
+		if err != nil {
+			return err
+		}
+		bookDomain, err := parser.ParseEPUB(bytes.NewReader(data), int64(len(data)), pageSize)
+		if err != nil {
+			return errs.NewBadRequestError(err.Error())
+		}
+		return s.importRow(ctx, importJobModel, 1, bookDomain, nil)
+	case domain.ImportFormatText:
+		bookDomain, err := parser.ParseText(content, pageSize)
+		if err != nil {
+			return errs.NewBadRequestError(err.Error())
+		}
+		return s.importRow(ctx, importJobModel, 1, bookDomain, nil)
+	case domain.ImportFormatCSV:
+		manifestReader, err := parser.NewCSVManifestReader(content)
+		if err != nil {
+			return errs.NewBadRequestError(err.Error())
+		}
+		for {
+			row, err := manifestReader.Next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := s.importRow(ctx, importJobModel, row.Line, &row.Book, row.Err); err != nil {
+				return err
+			}
+		}
+	default:
+		return errs.NewBadRequestError(fmt.Sprintf("Unknown import format %q", importJobModel.Format))
+	}
+}
+
+// importRow adds one book and records the outcome. Only failures to save the progress are returned,
+// a book which can't be added becomes a row error and the import goes on
+func (s *importService) importRow(ctx context.Context, importJobModel *model.ImportJob, row uint, bookDomain *domain.Book, rowErr error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if rowErr == nil {
+		rowErr = s.importBook(ctx, importJobModel, bookDomain)
+	}
+
+	importJobModel.TotalRows++
+	if rowErr == nil {
+		importJobModel.ImportedRows++
+	} else {
+		importJobModel.FailedRows++
+
+		rowErrorModel := model.ImportRowError{ImportJobID: importJobModel.ID, Row: row, Message: importErrorMessage(rowErr)}
+		if err := s.postgresImportJobRepository.CreateRowError(ctx, &rowErrorModel); err != nil {
+			return err
+		}
+	}
+
+	return s.postgresImportJobRepository.UpdateProgress(ctx, importJobModel)
+}
+
+func (s *importService) importBook(ctx context.Context, importJobModel *model.ImportJob, bookDomain *domain.Book) error {
+	if bookDomain.Title == "" {
+		bookDomain.Title = importJobModel.Title
+	}
+	if bookDomain.Author.Fullname == "" {
+		bookDomain.Author.Fullname = importJobModel.Author
+	}
+	if bookDomain.Category == "" {
+		bookDomain.Category = importJobModel.Category
+	}
+
+	switch {
+	case bookDomain.Title == "":
+		return errs.NewBadRequestError("Title is required")
+	case bookDomain.Author.Fullname == "":
+		return errs.NewBadRequestError("Author is required")
+	case bookDomain.Category == "":
+		return errs.NewBadRequestError("Category is required")
+	}
+
+	authorID, err := s.findOrCreateAuthor(ctx, bookDomain.Author.Fullname, importJobModel.UserID)
+	if err != nil {
+		return err
+	}
+	bookDomain.Author.ID = authorID
+
+	for i := range bookDomain.Contributors {
+		authorID, err := s.findOrCreateAuthor(ctx, bookDomain.Contributors[i].Author.Fullname, importJobModel.UserID)
+		if err != nil {
+			return err
+		}
+		bookDomain.Contributors[i].Author.ID = authorID
+	}
+
+	return s.catalogService.AddBook(ctx, bookDomain, importJobModel.UserID)
+}
+
+// findOrCreateAuthor matches authors by full name, so importing several books of one author doesn't duplicate them
+func (s *importService) findOrCreateAuthor(ctx context.Context, fullname string, userID uint) (uint, error) {
+	authorModel, err := s.postgresAuthorRepository.FindByFullname(ctx, fullname)
+	if err == nil {
+		return authorModel.ID, nil
+	}
+	if !errs.IsNotFound(err) {
+		return 0, err
+	}
+
+	authorDomain := domain.Author{Fullname: fullname}
+	if err := s.catalogService.CreateAuthor(ctx, &authorDomain, userID); err != nil {
+		return 0, err
+	}
+	return authorDomain.ID, nil
+}
+
+func (s *importService) deleteImportFile(ctx context.Context, fileKey string) {
+	if err := s.blobStorage.Delete(ctx, fileKey); err != nil {
+		s.logger.Warn(ctx, "Skip delete import file", logging.String("fileKey", fileKey), logging.Error(err))
+	}
+}
+
+// importErrorMessage prefers the client facing message of infrastructure errors over their JSON form
+func importErrorMessage(err error) string {
+	var infrastructureError *errs.Error
+	if errors.As(err, &infrastructureError) {
+		return infrastructureError.Message
+	}
+	return err.Error()
+}
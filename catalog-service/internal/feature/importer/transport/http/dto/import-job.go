@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type ImportJob struct {
+	ID           uint             `json:"id"`
+	Format       string           `json:"format"`
+	FileName     string           `json:"fileName"`
+	Status       string           `json:"status"`
+	TotalRows    uint             `json:"totalRows"`
+	ImportedRows uint             `json:"importedRows"`
+	FailedRows   uint             `json:"failedRows"`
+	Error        string           `json:"error,omitempty"`
+	RowErrors    []ImportRowError `json:"rowErrors,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	StartedAt    *time.Time       `json:"startedAt,omitempty"`
+	FinishedAt   *time.Time       `json:"finishedAt,omitempty"`
+}
+
+type ImportRowError struct {
+	Row     uint   `json:"row"`
+	Message string `json:"message"`
+}
+
+type CreateImportJobRequest struct {
+	Author   string `form:"author" binding:"max=255"`
+	Title    string `form:"title" binding:"max=255"`
+	Category string `form:"category" binding:"max=255"`
+	PageSize uint   `form:"pageSize,default=2000" binding:"min=200,max=20000"`
+}
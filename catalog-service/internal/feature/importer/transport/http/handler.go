@@ -0,0 +1,193 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+const MAX_IMPORT_FILE_SIZE = 50 << 20
+
+type ImportHandler interface {
+	CreateImportJob(c *gin.Context)
+	GetImportJob(c *gin.Context)
+	ListImportJobs(c *gin.Context)
+}
+
+type importHandler struct {
+	config        *config.Config
+	logger        *logging.Logger
+	importService service.ImportService
+}
+
+func NewImportHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	importService service.ImportService,
+) ImportHandler {
+	return &importHandler{
+		config:        config,
+		logger:        logger,
+		importService: importService,
+	}
+}
+
+// CreateImportJob godoc
+//
+//	@Summary		Import books from a file
+//	@Description	Queues an EPUB book, a UTF-8 plain text book or a CSV manifest of book metadata (one book per row) up to 50 MB.
+//	@Description	Form fields fill what the file doesn't have: text files need author and category, EPUB files need category.
+//	@Description	CSV columns are title, author, year, category, isbn, publisher, language, pageCount and description.
+//	@Description	Missing authors are created, books are added asynchronously, poll the returned job for progress and per-row errors
+//	@Tags			import
+//	@Accept			multipart/form-data
+//	@Param			file		formData	file	true	"EPUB, TXT or CSV file"
+//	@Param			author		formData	string	false	"Default author full name"
+//	@Param			title		formData	string	false	"Default title, text files default to the file name"
+//	@Param			category	formData	string	false	"Default category name or slug"
+//	@Param			pageSize	formData	int		false	"Max characters per page for EPUB and text files (min=200, max=20000, default=2000)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		202	{object}	dto.ImportJob
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/imports [post]
+func (h *importHandler) CreateImportJob(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	// Leave some room for the multipart envelope and form fields around the file itself
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MAX_IMPORT_FILE_SIZE+1<<20)
+
+	var createImportJobRequestDTO dto.CreateImportJobRequest
+	if err := c.ShouldBind(&createImportJobRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+	if fileHeader.Size > MAX_IMPORT_FILE_SIZE {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError("Import file can't be larger than 50 MB"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+	defer file.Close()
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CreateImportJob")
+	defer span.End()
+
+	defaults := mapper.CreateImportJobRequestToDomain(&createImportJobRequestDTO)
+	importJobDomain, err := h.importService.CreateImportJob(ctx, uint(userID), fileHeader.Filename, file, defaults)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Create import job error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, mapper.ImportJobDomainToDTO(importJobDomain))
+}
+
+// GetImportJob godoc
+//
+//	@Summary		Get import job
+//	@Description	Returns import status, progress counters and errors of rows which weren't imported
+//	@Tags			import
+//	@Param			importJobID	path	uint	true	"Import job ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.ImportJob
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/imports/{importJobID} [get]
+func (h *importHandler) GetImportJob(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	importJobIDString := c.Param("importJobID")
+	importJobID, err := strconv.ParseUint(importJobIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetImportJob")
+	defer span.End()
+
+	importJobDomain, err := h.importService.GetImportJob(ctx, uint(importJobID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get import job error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ImportJobDomainToDTO(importJobDomain))
+}
+
+// ListImportJobs godoc
+//
+//	@Summary		List import jobs
+//	@Description	Returns import jobs, the most recent first. Row errors are only returned by a single job
+//	@Tags			import
+//	@Param			page	query	int		false	"Page number (min=1, default=1)"
+//	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.ImportJob
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/imports [get]
+func (h *importHandler) ListImportJobs(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.ListImportJobs
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ListImportJobs")
+	defer span.End()
+
+	importJobDomains, err := h.importService.ListImportJobs(ctx, query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "List import jobs error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ImportJobDomainsToDTOs(importJobDomains))
+}
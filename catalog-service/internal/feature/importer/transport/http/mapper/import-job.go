@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/transport/http/dto"
+)
+
+func ImportJobDomainToDTO(importJobDomain *domain.ImportJob) dto.ImportJob {
+	importJobDTO := dto.ImportJob{
+		ID:           importJobDomain.ID,
+		Format:       importJobDomain.Format,
+		FileName:     importJobDomain.FileName,
+		Status:       importJobDomain.Status,
+		TotalRows:    importJobDomain.TotalRows,
+		ImportedRows: importJobDomain.ImportedRows,
+		FailedRows:   importJobDomain.FailedRows,
+		Error:        importJobDomain.Error,
+		RowErrors:    make([]dto.ImportRowError, len(importJobDomain.RowErrors)),
+		CreatedAt:    importJobDomain.CreatedAt,
+		StartedAt:    importJobDomain.StartedAt,
+		FinishedAt:   importJobDomain.FinishedAt,
+	}
+	for i := range importJobDomain.RowErrors {
+		importJobDTO.RowErrors[i] = dto.ImportRowError{
+			Row:     importJobDomain.RowErrors[i].Row,
+			Message: importJobDomain.RowErrors[i].Message,
+		}
+	}
+	return importJobDTO
+}
+
+func ImportJobDomainsToDTOs(importJobDomains []domain.ImportJob) []dto.ImportJob {
+	importJobDTOs := make([]dto.ImportJob, len(importJobDomains))
+	for i := range importJobDomains {
+		importJobDTOs[i] = ImportJobDomainToDTO(&importJobDomains[i])
+	}
+	return importJobDTOs
+}
+
+func CreateImportJobRequestToDomain(createImportJobRequestDTO *dto.CreateImportJobRequest) domain.ImportDefaults {
+	return domain.ImportDefaults{
+		Author:   createImportJobRequestDTO.Author,
+		Title:    createImportJobRequestDTO.Title,
+		Category: createImportJobRequestDTO.Category,
+		PageSize: createImportJobRequestDTO.PageSize,
+	}
+}
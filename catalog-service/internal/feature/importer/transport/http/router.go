@@ -0,0 +1,17 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts import routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, importHandler ImportHandler) {
+	importGroup := r.Group(sharedRoute.CATALOG + route.IMPORTS)
+	{
+		importGroup.GET("", importHandler.ListImportJobs)
+		importGroup.POST("", importHandler.CreateImportJob)
+		importGroup.GET("/:importJobID", importHandler.GetImportJob)
+	}
+}
@@ -0,0 +1,40 @@
+package lending
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/transport/http"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires the lending feature into the HTTP router of the catalog feature.
+// The returned job has to be run by the container
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	httpRouter *gin.Engine,
+	loanOverdueWriter *kafkaInfrastructure.OtelWriter,
+) job.Job {
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresInventoryRepository := postgres.NewInventoryRepository(postgresDB)
+	postgresLoanRepository := postgres.NewLoanRepository(postgresDB)
+	postgresHoldRepository := postgres.NewHoldRepository(postgresDB)
+
+	lendingService := service.NewLendingService(
+		config, logger, postgresDB,
+		loanOverdueWriter, postgresBookRepository,
+		postgresInventoryRepository, postgresLoanRepository, postgresHoldRepository,
+	)
+
+	httpLendingHandler := httpTransport.NewLendingHandler(config, logger, lendingService)
+	httpTransport.RegisterRoutes(httpRouter, httpLendingHandler)
+
+	return job.NewJob(config, logger, lendingService)
+}
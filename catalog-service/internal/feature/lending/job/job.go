@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+)
+
+// Job periodically releases expired holds and reports overdue loans to notification-service
+type Job interface {
+	Run(ctx context.Context)
+}
+
+type job struct {
+	config         *config.Config
+	logger         *logging.Logger
+	lendingService service.LendingService
+}
+
+func NewJob(config *config.Config, logger *logging.Logger, lendingService service.LendingService) Job {
+	return &job{
+		config:         config,
+		logger:         logger,
+		lendingService: lendingService,
+	}
+}
+
+// Run blocks until ctx is cancelled. The first pass starts right away to catch up after a restart
+func (j *job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.config.LendingJobInterval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *job) runOnce(ctx context.Context) {
+	ctx, span := tracing.Span(ctx, j.config.ServiceName, "job.Lending")
+	defer span.End()
+
+	if err := j.lendingService.ExpireHolds(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		tracing.Error(span, err)
+		j.logger.Error(ctx, "Expire holds error", logging.Error(err))
+	}
+
+	if err := j.lendingService.ReportOverdueLoans(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		tracing.Error(span, err)
+		j.logger.Error(ctx, "Report overdue loans error", logging.Error(err))
+	}
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type HoldRepository interface {
+	WithinTX(tx *gorm.DB) HoldRepository
+	Create(ctx context.Context, hold *model.Hold) error
+	FindByUserIDAndBookID(ctx context.Context, userID, bookID uint) (*model.Hold, error)
+	ListByUserID(ctx context.Context, userID uint) ([]model.HoldWithPosition, error)
+	ListExpired(ctx context.Context, readyBefore time.Time) ([]model.Hold, error)
+	CountByBookID(ctx context.Context, bookID uint) (int64, error)
+	CountReadyByBookID(ctx context.Context, bookID uint) (int64, error)
+	MarkNextReady(ctx context.Context, bookID uint, count int64, readyAt time.Time) error
+	Delete(ctx context.Context, holdID uint) error
+}
+
+type holdRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewHoldRepository(db *gorm.DB) HoldRepository {
+	return &holdRepository{name: "Hold(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *holdRepository) WithinTX(tx *gorm.DB) HoldRepository {
+	return &holdRepository{name: "Hold(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *holdRepository) Create(ctx context.Context, hold *model.Hold) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("Book").Create(hold).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *holdRepository) FindByUserIDAndBookID(ctx context.Context, userID, bookID uint) (*model.Hold, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var hold model.Hold
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID).
+		First(&hold).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &hold, nil
+}
+
+// ListByUserID returns holds with their 1-based position in the queue of the book
+func (r *holdRepository) ListByUserID(ctx context.Context, userID uint) ([]model.HoldWithPosition, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var holds []model.HoldWithPosition
+	err := r.db.WithContext(ctx).
+		Model(&model.Hold{}).
+		Select(`holds.*, (
+			SELECT COUNT(*) FROM holds AS queue
+			WHERE queue.book_id = holds.book_id AND (queue.created_at, queue.id) <= (holds.created_at, holds.id)
+		) AS position`).
+		Where("holds.user_id = ?", userID).
+		Order("holds.created_at").
+		Scan(&holds).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return holds, nil
+}
+
+func (r *holdRepository) ListExpired(ctx context.Context, readyBefore time.Time) ([]model.Hold, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var holds []model.Hold
+	if err := r.db.WithContext(ctx).Where("ready_at < ?", readyBefore).Find(&holds).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return holds, nil
+}
+
+func (r *holdRepository) CountByBookID(ctx context.Context, bookID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var holdsCount int64
+	if err := r.db.WithContext(ctx).Model(&model.Hold{}).Where("book_id = ?", bookID).Count(&holdsCount).Error; err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return holdsCount, nil
+}
+
+func (r *holdRepository) CountReadyByBookID(ctx context.Context, bookID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var holdsCount int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Hold{}).
+		Where("book_id = ?", bookID).
+		Where("ready_at IS NOT NULL").
+		Count(&holdsCount).Error
+	if err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return holdsCount, nil
+}
+
+// MarkNextReady reserves copies for the first count waiting holders in FIFO order
+func (r *holdRepository) MarkNextReady(ctx context.Context, bookID uint, count int64, readyAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	nextHoldIDs := r.db.
+		Model(&model.Hold{}).
+		Select("id").
+		Where("book_id = ?", bookID).
+		Where("ready_at IS NULL").
+		Order("created_at, id").
+		Limit(int(count))
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Hold{}).
+		Where("id IN (?)", nextHoldIDs).
+		Update("ready_at", readyAt).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *holdRepository) Delete(ctx context.Context, holdID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Delete(&model.Hold{}, holdID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
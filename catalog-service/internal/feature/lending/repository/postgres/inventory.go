@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InventoryRepository interface {
+	WithinTX(tx *gorm.DB) InventoryRepository
+	FindByBookID(ctx context.Context, bookID uint) (*model.Inventory, error)
+	LockByBookID(ctx context.Context, bookID, defaultCopiesCount uint) (*model.Inventory, error)
+	Save(ctx context.Context, inventory *model.Inventory) error
+}
+
+type inventoryRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewInventoryRepository(db *gorm.DB) InventoryRepository {
+	return &inventoryRepository{name: "Inventory", timeout: 1 * time.Second, db: db}
+}
+
+func (r *inventoryRepository) WithinTX(tx *gorm.DB) InventoryRepository {
+	return &inventoryRepository{name: "Inventory", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *inventoryRepository) FindByBookID(ctx context.Context, bookID uint) (*model.Inventory, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var inventory model.Inventory
+	if err := r.db.WithContext(ctx).Where("book_id = ?", bookID).First(&inventory).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &inventory, nil
+}
+
+// LockByBookID creates a missing inventory row with the default copies count and locks it till the end of the transaction.
+// Every change of loans and holds of a book goes through this lock, so copies can't be handed out twice
+func (r *inventoryRepository) LockByBookID(ctx context.Context, bookID, defaultCopiesCount uint) (*model.Inventory, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	defaultInventory := model.Inventory{BookID: bookID, CopiesCount: defaultCopiesCount}
+	err := r.db.WithContext(ctx).
+		Omit("Book").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&defaultInventory).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+
+	var inventory model.Inventory
+	err = r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("book_id = ?", bookID).
+		First(&inventory).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &inventory, nil
+}
+
+func (r *inventoryRepository) Save(ctx context.Context, inventory *model.Inventory) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Omit("Book").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "book_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"copies_count", "updated_at"}),
+		}).
+		Create(inventory).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
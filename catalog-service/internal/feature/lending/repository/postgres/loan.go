@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type LoanRepository interface {
+	WithinTX(tx *gorm.DB) LoanRepository
+	Create(ctx context.Context, loan *model.Loan) error
+	FindByID(ctx context.Context, loanID uint) (*model.Loan, error)
+	ListActiveByUserID(ctx context.Context, userID uint) ([]model.Loan, error)
+	ListOverdue(ctx context.Context, now time.Time, count int) ([]model.OverdueLoan, error)
+	CountActiveByBookID(ctx context.Context, bookID uint) (int64, error)
+	CountActiveByUserID(ctx context.Context, userID uint) (int64, error)
+	ExistsActiveByUserIDAndBookID(ctx context.Context, userID, bookID uint) (bool, error)
+	Update(ctx context.Context, loan *model.Loan) error
+	MarkOverdueNotified(ctx context.Context, loanIDs []uint, notifiedAt time.Time) error
+}
+
+type loanRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewLoanRepository(db *gorm.DB) LoanRepository {
+	return &loanRepository{name: "Loan(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *loanRepository) WithinTX(tx *gorm.DB) LoanRepository {
+	return &loanRepository{name: "Loan(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *loanRepository) Create(ctx context.Context, loan *model.Loan) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("Book").Create(loan).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *loanRepository) FindByID(ctx context.Context, loanID uint) (*model.Loan, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var loan model.Loan
+	if err := r.db.WithContext(ctx).Where("id = ?", loanID).First(&loan).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &loan, nil
+}
+
+// ListActiveByUserID returns loans which weren't returned yet, the closest due date goes first
+func (r *loanRepository) ListActiveByUserID(ctx context.Context, userID uint) ([]model.Loan, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var loans []model.Loan
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("returned_at IS NULL").
+		Order("due_at").
+		Find(&loans).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return loans, nil
+}
+
+// ListOverdue returns active overdue loans which weren't reported yet
+func (r *loanRepository) ListOverdue(ctx context.Context, now time.Time, count int) ([]model.OverdueLoan, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var overdueLoans []model.OverdueLoan
+	err := r.db.WithContext(ctx).
+		Model(&model.Loan{}).
+		Select("loans.*, books.title, authors.fullname AS author_fullname").
+		Joins("INNER JOIN books ON loans.book_id = books.id").
+		Joins("LEFT JOIN authors ON books.author_id = authors.id").
+		Where("loans.returned_at IS NULL").
+		Where("loans.overdue_notified_at IS NULL").
+		Where("loans.due_at < ?", now).
+		Order("loans.due_at").
+		Limit(count).
+		Scan(&overdueLoans).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return overdueLoans, nil
+}
+
+func (r *loanRepository) CountActiveByBookID(ctx context.Context, bookID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var loansCount int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Loan{}).
+		Where("book_id = ?", bookID).
+		Where("returned_at IS NULL").
+		Count(&loansCount).Error
+	if err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return loansCount, nil
+}
+
+func (r *loanRepository) CountActiveByUserID(ctx context.Context, userID uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var loansCount int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Loan{}).
+		Where("user_id = ?", userID).
+		Where("returned_at IS NULL").
+		Count(&loansCount).Error
+	if err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return loansCount, nil
+}
+
+func (r *loanRepository) ExistsActiveByUserIDAndBookID(ctx context.Context, userID, bookID uint) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var loansCount int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Loan{}).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID).
+		Where("returned_at IS NULL").
+		Count(&loansCount).Error
+	if err != nil {
+		return false, postgresInfrastructure.NewError(err, r.name)
+	}
+	return loansCount > 0, nil
+}
+
+func (r *loanRepository) Update(ctx context.Context, loan *model.Loan) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(loan).
+		Select("due_at", "renewals_count", "returned_at", "overdue_notified_at").
+		Updates(loan).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *loanRepository) MarkOverdueNotified(ctx context.Context, loanIDs []uint, notifiedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Loan{}).
+		Where("id IN ?", loanIDs).
+		Update("overdue_notified_at", notifiedAt).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+// Hold is a place in the queue for a book. ReadyAt is set once a returned copy is reserved for the holder
+type Hold struct {
+	ID        uint `gorm:"primarykey"`
+	UserID    uint `gorm:"not null;uniqueIndex:user_id_book_id_index"`
+	BookID    uint `gorm:"not null;uniqueIndex:user_id_book_id_index;index"`
+	ReadyAt   *time.Time
+	CreatedAt time.Time
+	Book      catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type HoldWithPosition struct {
+	Hold
+	Position uint
+}
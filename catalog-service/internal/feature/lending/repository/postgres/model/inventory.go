@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+// Inventory keeps the number of physical copies of a book. Books without a row have the default number of copies
+type Inventory struct {
+	BookID      uint `gorm:"primaryKey;autoIncrement:false"`
+	CopiesCount uint `gorm:"not null"`
+	UpdatedAt   time.Time
+	Book        catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
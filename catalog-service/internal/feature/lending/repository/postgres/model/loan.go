@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+type Loan struct {
+	ID                uint      `gorm:"primarykey"`
+	UserID            uint      `gorm:"not null;index;uniqueIndex:user_id_book_id_active_index,where:returned_at IS NULL"`
+	BookID            uint      `gorm:"not null;index;uniqueIndex:user_id_book_id_active_index"`
+	BorrowedAt        time.Time `gorm:"not null"`
+	DueAt             time.Time `gorm:"not null;index"`
+	RenewalsCount     uint      `gorm:"not null;default:0"`
+	ReturnedAt        *time.Time
+	OverdueNotifiedAt *time.Time
+	Book              catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type OverdueLoan struct {
+	Loan
+	Title          string
+	AuthorFullname string
+}
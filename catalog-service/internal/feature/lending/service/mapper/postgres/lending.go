@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+)
+
+func LoanModelToDomain(loanModel *model.Loan) domain.Loan {
+	return domain.Loan{
+		ID:            loanModel.ID,
+		UserID:        loanModel.UserID,
+		BookID:        loanModel.BookID,
+		BorrowedAt:    loanModel.BorrowedAt,
+		DueAt:         loanModel.DueAt,
+		RenewalsCount: loanModel.RenewalsCount,
+		ReturnedAt:    loanModel.ReturnedAt,
+	}
+}
+
+func LoanModelsToDomains(loanModels []model.Loan) []domain.Loan {
+	loanDomains := make([]domain.Loan, len(loanModels))
+	for i := range loanModels {
+		loanDomains[i] = LoanModelToDomain(&loanModels[i])
+	}
+	return loanDomains
+}
+
+func HoldModelToDomain(holdModel *model.Hold, position uint, pickupPeriod time.Duration) domain.Hold {
+	holdDomain := domain.Hold{
+		ID:        holdModel.ID,
+		UserID:    holdModel.UserID,
+		BookID:    holdModel.BookID,
+		Position:  position,
+		ReadyAt:   holdModel.ReadyAt,
+		CreatedAt: holdModel.CreatedAt,
+	}
+	if holdModel.ReadyAt != nil {
+		expiresAt := holdModel.ReadyAt.Add(pickupPeriod)
+		holdDomain.ExpiresAt = &expiresAt
+	}
+	return holdDomain
+}
+
+func HoldWithPositionModelsToDomains(holdWithPositionModels []model.HoldWithPosition, pickupPeriod time.Duration) []domain.Hold {
+	holdDomains := make([]domain.Hold, len(holdWithPositionModels))
+	for i := range holdWithPositionModels {
+		holdDomains[i] = HoldModelToDomain(&holdWithPositionModels[i].Hold, holdWithPositionModels[i].Position, pickupPeriod)
+	}
+	return holdDomains
+}
+
+func OverdueLoanModelToEvent(overdueLoanModel *model.OverdueLoan) event.LoanOverdue {
+	return event.LoanOverdue{
+		ID:             overdueLoanModel.ID,
+		UserID:         overdueLoanModel.UserID,
+		BookID:         overdueLoanModel.BookID,
+		Title:          overdueLoanModel.Title,
+		AuthorFullname: overdueLoanModel.AuthorFullname,
+		BorrowedAt:     overdueLoanModel.BorrowedAt,
+		DueAt:          overdueLoanModel.DueAt,
+	}
+}
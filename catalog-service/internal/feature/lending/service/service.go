@@ -0,0 +1,471 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/service/mapper/postgres"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+)
+
+type LendingService interface {
+	GetAvailability(ctx context.Context, bookID uint) (*domain.BookAvailability, error)
+	SetCopiesCount(ctx context.Context, bookID, copiesCount uint) error
+	GetLoans(ctx context.Context, userID uint) ([]domain.Loan, error)
+	BorrowBook(ctx context.Context, userID, bookID uint) (*domain.Loan, error)
+	ReturnBook(ctx context.Context, userID, loanID uint) (*domain.Loan, error)
+	RenewLoan(ctx context.Context, userID, loanID uint) (*domain.Loan, error)
+	GetHolds(ctx context.Context, userID uint) ([]domain.Hold, error)
+	PlaceHold(ctx context.Context, userID, bookID uint) (*domain.Hold, error)
+	CancelHold(ctx context.Context, userID, bookID uint) error
+	ExpireHolds(ctx context.Context) error
+	ReportOverdueLoans(ctx context.Context) error
+}
+
+type lendingService struct {
+	config                      *config.Config
+	logger                      *logging.Logger
+	postgresDB                  *gorm.DB
+	loanOverdueWriter           *kafkaInfrastructure.OtelWriter
+	postgresBookRepository      catalogPostgres.BookRepository
+	postgresInventoryRepository postgres.InventoryRepository
+	postgresLoanRepository      postgres.LoanRepository
+	postgresHoldRepository      postgres.HoldRepository
+}
+
+// lendingRepositories are bound to one transaction
+type lendingRepositories struct {
+	inventory postgres.InventoryRepository
+	loan      postgres.LoanRepository
+	hold      postgres.HoldRepository
+}
+
+func NewLendingService(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	loanOverdueWriter *kafkaInfrastructure.OtelWriter,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresInventoryRepository postgres.InventoryRepository,
+	postgresLoanRepository postgres.LoanRepository,
+	postgresHoldRepository postgres.HoldRepository) LendingService {
+	return &lendingService{
+		config:                      config,
+		logger:                      logger,
+		postgresDB:                  postgresDB,
+		loanOverdueWriter:           loanOverdueWriter,
+		postgresBookRepository:      postgresBookRepository,
+		postgresInventoryRepository: postgresInventoryRepository,
+		postgresLoanRepository:      postgresLoanRepository,
+		postgresHoldRepository:      postgresHoldRepository,
+	}
+}
+
+func (s *lendingService) GetAvailability(ctx context.Context, bookID uint) (*domain.BookAvailability, error) {
+	if _, err := s.postgresBookRepository.FindByID(ctx, bookID); err != nil {
+		return nil, err
+	}
+
+	copiesCount := s.config.LendingDefaultCopies
+	inventoryModel, err := s.postgresInventoryRepository.FindByBookID(ctx, bookID)
+	if err != nil && !errs.IsNotFound(err) {
+		return nil, err
+	}
+	if inventoryModel != nil {
+		copiesCount = inventoryModel.CopiesCount
+	}
+
+	return s.getAvailability(ctx, lendingRepositories{
+		inventory: s.postgresInventoryRepository,
+		loan:      s.postgresLoanRepository,
+		hold:      s.postgresHoldRepository,
+	}, bookID, copiesCount)
+}
+
+// SetCopiesCount changes the number of copies. Extra copies go to the holds queue at once,
+// while removed copies which are on loan stay with readers till they are returned
+func (s *lendingService) SetCopiesCount(ctx context.Context, bookID, copiesCount uint) error {
+	return s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+		if _, err := s.postgresBookRepository.FindByID(txCtx, bookID); err != nil {
+			return err
+		}
+
+		inventoryModel, err := repositories.inventory.LockByBookID(txCtx, bookID, s.config.LendingDefaultCopies)
+		if err != nil {
+			return err
+		}
+
+		inventoryModel.CopiesCount = copiesCount
+		if err := repositories.inventory.Save(txCtx, inventoryModel); err != nil {
+			return err
+		}
+		return s.promoteHolds(txCtx, repositories, inventoryModel)
+	})
+}
+
+func (s *lendingService) GetLoans(ctx context.Context, userID uint) ([]domain.Loan, error) {
+	loanModels, err := s.postgresLoanRepository.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.LoanModelsToDomains(loanModels), nil
+}
+
+// BorrowBook hands out a free copy. Copies reserved for holders are given only to them
+func (s *lendingService) BorrowBook(ctx context.Context, userID, bookID uint) (*domain.Loan, error) {
+	var loanModel model.Loan
+
+	err := s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+		if _, err := s.postgresBookRepository.FindByID(txCtx, bookID); err != nil {
+			return err
+		}
+
+		inventoryModel, err := repositories.inventory.LockByBookID(txCtx, bookID, s.config.LendingDefaultCopies)
+		if err != nil {
+			return err
+		}
+
+		userLoansCount, err := repositories.loan.CountActiveByUserID(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if uint(userLoansCount) >= s.config.LendingMaxActiveLoans {
+			return errs.NewBadRequestError(fmt.Sprintf("Can't borrow more than %d books at once", s.config.LendingMaxActiveLoans))
+		}
+
+		availability, err := s.getAvailability(txCtx, repositories, bookID, inventoryModel.CopiesCount)
+		if err != nil {
+			return err
+		}
+
+		holdModel, err := repositories.hold.FindByUserIDAndBookID(txCtx, userID, bookID)
+		if err != nil && !errs.IsNotFound(err) {
+			return err
+		}
+
+		hasReadyHold := holdModel != nil && holdModel.ReadyAt != nil
+		if !hasReadyHold && availability.AvailableCount <= availability.ReadyHoldsCount {
+			return errs.NewBadRequestError("All copies of the book are on loan or reserved, place a hold instead")
+		}
+
+		if holdModel != nil {
+			if err := repositories.hold.Delete(txCtx, holdModel.ID); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		loanModel = model.Loan{
+			UserID:     userID,
+			BookID:     bookID,
+			BorrowedAt: now,
+			DueAt:      now.Add(s.config.LendingLoanPeriod),
+		}
+		return repositories.loan.Create(txCtx, &loanModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loanDomain := postgresMapper.LoanModelToDomain(&loanModel)
+	return &loanDomain, nil
+}
+
+// ReturnBook closes the loan and reserves the copy for the next holder
+func (s *lendingService) ReturnBook(ctx context.Context, userID, loanID uint) (*domain.Loan, error) {
+	var loanModel *model.Loan
+
+	err := s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+		var err error
+		loanModel, err = s.findOwnActiveLoan(txCtx, repositories.loan, userID, loanID)
+		if err != nil {
+			return err
+		}
+
+		inventoryModel, err := repositories.inventory.LockByBookID(txCtx, loanModel.BookID, s.config.LendingDefaultCopies)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		loanModel.ReturnedAt = &now
+		if err := repositories.loan.Update(txCtx, loanModel); err != nil {
+			return err
+		}
+		return s.promoteHolds(txCtx, repositories, inventoryModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loanDomain := postgresMapper.LoanModelToDomain(loanModel)
+	return &loanDomain, nil
+}
+
+// RenewLoan extends the due date by one loan period unless the loan is overdue or somebody waits for the book
+func (s *lendingService) RenewLoan(ctx context.Context, userID, loanID uint) (*domain.Loan, error) {
+	var loanModel *model.Loan
+
+	err := s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+		var err error
+		loanModel, err = s.findOwnActiveLoan(txCtx, repositories.loan, userID, loanID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := repositories.inventory.LockByBookID(txCtx, loanModel.BookID, s.config.LendingDefaultCopies); err != nil {
+			return err
+		}
+
+		if loanModel.RenewalsCount >= s.config.LendingMaxRenewals {
+			return errs.NewBadRequestError(fmt.Sprintf("Loan can't be renewed more than %d times", s.config.LendingMaxRenewals))
+		}
+		if time.Now().After(loanModel.DueAt) {
+			return errs.NewBadRequestError("Overdue loan can't be renewed")
+		}
+
+		holdsCount, err := repositories.hold.CountByBookID(txCtx, loanModel.BookID)
+		if err != nil {
+			return err
+		}
+		if holdsCount > 0 {
+			return errs.NewBadRequestError("Loan can't be renewed while other readers wait for the book")
+		}
+
+		loanModel.DueAt = loanModel.DueAt.Add(s.config.LendingLoanPeriod)
+		loanModel.RenewalsCount++
+		return repositories.loan.Update(txCtx, loanModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loanDomain := postgresMapper.LoanModelToDomain(loanModel)
+	return &loanDomain, nil
+}
+
+func (s *lendingService) GetHolds(ctx context.Context, userID uint) ([]domain.Hold, error) {
+	holdWithPositionModels, err := s.postgresHoldRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.HoldWithPositionModelsToDomains(holdWithPositionModels, s.config.LendingHoldPickupPeriod), nil
+}
+
+// PlaceHold puts the user at the end of the queue. Holds are accepted only when there is no free copy to borrow
+func (s *lendingService) PlaceHold(ctx context.Context, userID, bookID uint) (*domain.Hold, error) {
+	var holdModel model.Hold
+	var position uint
+
+	err := s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+		if _, err := s.postgresBookRepository.FindByID(txCtx, bookID); err != nil {
+			return err
+		}
+
+		inventoryModel, err := repositories.inventory.LockByBookID(txCtx, bookID, s.config.LendingDefaultCopies)
+		if err != nil {
+			return err
+		}
+
+		hasLoan, err := repositories.loan.ExistsActiveByUserIDAndBookID(txCtx, userID, bookID)
+		if err != nil {
+			return err
+		}
+		if hasLoan {
+			return errs.NewBadRequestError("Book is already borrowed by the user")
+		}
+
+		availability, err := s.getAvailability(txCtx, repositories, bookID, inventoryModel.CopiesCount)
+		if err != nil {
+			return err
+		}
+		if availability.AvailableCount > availability.ReadyHoldsCount {
+			return errs.NewBadRequestError("Book has free copies, borrow it instead")
+		}
+
+		holdModel = model.Hold{UserID: userID, BookID: bookID}
+		if err := repositories.hold.Create(txCtx, &holdModel); err != nil {
+			return err
+		}
+
+		position = availability.HoldsCount + 1
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holdDomain := postgresMapper.HoldModelToDomain(&holdModel, position, s.config.LendingHoldPickupPeriod)
+	return &holdDomain, nil
+}
+
+func (s *lendingService) CancelHold(ctx context.Context, userID, bookID uint) error {
+	return s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+		holdModel, err := repositories.hold.FindByUserIDAndBookID(txCtx, userID, bookID)
+		if err != nil {
+			return err
+		}
+
+		inventoryModel, err := repositories.inventory.LockByBookID(txCtx, bookID, s.config.LendingDefaultCopies)
+		if err != nil {
+			return err
+		}
+
+		if err := repositories.hold.Delete(txCtx, holdModel.ID); err != nil {
+			return err
+		}
+		return s.promoteHolds(txCtx, repositories, inventoryModel)
+	})
+}
+
+// ExpireHolds releases copies which weren't picked up in time to the next holders
+func (s *lendingService) ExpireHolds(ctx context.Context) error {
+	expiredHoldModels, err := s.postgresHoldRepository.ListExpired(ctx, time.Now().Add(-s.config.LendingHoldPickupPeriod))
+	if err != nil {
+		return err
+	}
+
+	for i := range expiredHoldModels {
+		err := s.withinTX(ctx, func(txCtx context.Context, repositories lendingRepositories) error {
+			inventoryModel, err := repositories.inventory.LockByBookID(txCtx, expiredHoldModels[i].BookID, s.config.LendingDefaultCopies)
+			if err != nil {
+				return err
+			}
+
+			if err := repositories.hold.Delete(txCtx, expiredHoldModels[i].ID); err != nil {
+				return err
+			}
+			return s.promoteHolds(txCtx, repositories, inventoryModel)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReportOverdueLoans emits an event once per overdue loan. Loans are marked only after the events are written,
+// so a failed write is retried on the next run
+func (s *lendingService) ReportOverdueLoans(ctx context.Context) error {
+	const BATCH_SIZE = 100
+
+	for {
+		overdueLoanModels, err := s.postgresLoanRepository.ListOverdue(ctx, time.Now(), BATCH_SIZE)
+		if err != nil {
+			return err
+		}
+		if len(overdueLoanModels) == 0 {
+			return nil
+		}
+
+		messages := make([]kafka.Message, len(overdueLoanModels))
+		loanIDs := make([]uint, len(overdueLoanModels))
+		for i := range overdueLoanModels {
+			loanOverdueEvent, err := json.Marshal(postgresMapper.OverdueLoanModelToEvent(&overdueLoanModels[i]))
+			if err != nil {
+				return err
+			}
+			messages[i] = kafka.Message{Value: loanOverdueEvent}
+			loanIDs[i] = overdueLoanModels[i].ID
+		}
+
+		kafkaCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		err = s.loanOverdueWriter.WriteMessages(kafkaCtx, messages...)
+		cancel()
+		if err != nil {
+			return err
+		}
+
+		if err := s.postgresLoanRepository.MarkOverdueNotified(ctx, loanIDs, time.Now()); err != nil {
+			return err
+		}
+		s.logger.Info(ctx, "Overdue loans reported", logging.Int("count", len(loanIDs)))
+
+		if len(overdueLoanModels) < BATCH_SIZE {
+			return nil
+		}
+	}
+}
+
+func (s *lendingService) getAvailability(ctx context.Context, repositories lendingRepositories, bookID, copiesCount uint) (*domain.BookAvailability, error) {
+	loansCount, err := repositories.loan.CountActiveByBookID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdsCount, err := repositories.hold.CountByBookID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	readyHoldsCount, err := repositories.hold.CountReadyByBookID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := domain.BookAvailability{
+		BookID:          bookID,
+		CopiesCount:     copiesCount,
+		LoansCount:      uint(loansCount),
+		HoldsCount:      uint(holdsCount),
+		ReadyHoldsCount: uint(readyHoldsCount),
+	}
+	if availability.LoansCount < copiesCount {
+		availability.AvailableCount = copiesCount - availability.LoansCount
+	}
+	return &availability, nil
+}
+
+// promoteHolds reserves every copy which is neither on loan nor reserved for the first waiting holders.
+// Callers must hold the inventory lock
+func (s *lendingService) promoteHolds(ctx context.Context, repositories lendingRepositories, inventoryModel *model.Inventory) error {
+	availability, err := s.getAvailability(ctx, repositories, inventoryModel.BookID, inventoryModel.CopiesCount)
+	if err != nil {
+		return err
+	}
+
+	freeCopiesCount := int64(availability.AvailableCount) - int64(availability.ReadyHoldsCount)
+	if freeCopiesCount <= 0 || availability.HoldsCount == availability.ReadyHoldsCount {
+		return nil
+	}
+	return repositories.hold.MarkNextReady(ctx, inventoryModel.BookID, freeCopiesCount, time.Now())
+}
+
+// findOwnActiveLoan hides loans of other users behind not found error
+func (s *lendingService) findOwnActiveLoan(ctx context.Context, postgresLoanRepository postgres.LoanRepository, userID, loanID uint) (*model.Loan, error) {
+	loanModel, err := postgresLoanRepository.FindByID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loanModel.UserID != userID {
+		return nil, errs.NewEntityNotFoundError("Loan(s)")
+	}
+	if loanModel.ReturnedAt != nil {
+		return nil, errs.NewBadRequestError("Loan is already returned")
+	}
+	return loanModel, nil
+}
+
+func (s *lendingService) withinTX(ctx context.Context, fn func(txCtx context.Context, repositories lendingRepositories) error) error {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		return fn(txCtx, lendingRepositories{
+			inventory: s.postgresInventoryRepository.WithinTX(tx),
+			loan:      s.postgresLoanRepository.WithinTX(tx),
+			hold:      s.postgresHoldRepository.WithinTX(tx),
+		})
+	})
+}
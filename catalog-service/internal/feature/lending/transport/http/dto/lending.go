@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+type BookAvailability struct {
+	BookID          uint `json:"bookId"`
+	CopiesCount     uint `json:"copiesCount"`
+	AvailableCount  uint `json:"availableCount"`
+	LoansCount      uint `json:"loansCount"`
+	HoldsCount      uint `json:"holdsCount"`
+	ReadyHoldsCount uint `json:"readyHoldsCount"`
+}
+
+type Loan struct {
+	ID            uint       `json:"id"`
+	BookID        uint       `json:"bookId"`
+	BorrowedAt    time.Time  `json:"borrowedAt"`
+	DueAt         time.Time  `json:"dueAt"`
+	RenewalsCount uint       `json:"renewalsCount"`
+	ReturnedAt    *time.Time `json:"returnedAt,omitempty"`
+	Overdue       bool       `json:"overdue"`
+}
+
+type Hold struct {
+	ID        uint       `json:"id"`
+	BookID    uint       `json:"bookId"`
+	Position  uint       `json:"position"`
+	ReadyAt   *time.Time `json:"readyAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type SetInventoryRequest struct {
+	CopiesCount *uint `json:"copiesCount" binding:"required,max=10000"`
+}
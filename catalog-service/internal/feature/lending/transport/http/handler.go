@@ -0,0 +1,420 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+type LendingHandler interface {
+	GetAvailability(c *gin.Context)
+	SetInventory(c *gin.Context)
+	GetLoans(c *gin.Context)
+	BorrowBook(c *gin.Context)
+	ReturnBook(c *gin.Context)
+	RenewLoan(c *gin.Context)
+	GetHolds(c *gin.Context)
+	PlaceHold(c *gin.Context)
+	CancelHold(c *gin.Context)
+}
+
+type lendingHandler struct {
+	config         *config.Config
+	logger         *logging.Logger
+	lendingService service.LendingService
+}
+
+func NewLendingHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	lendingService service.LendingService,
+) LendingHandler {
+	return &lendingHandler{
+		config:         config,
+		logger:         logger,
+		lendingService: lendingService,
+	}
+}
+
+// GetAvailability godoc
+//
+//	@Summary		Get book availability
+//	@Description	Returns the number of copies, how many of them are free and how long the holds queue is
+//	@Tags			lending
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Success		200	{object}	dto.BookAvailability
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/availability [get]
+func (h *lendingHandler) GetAvailability(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetAvailability")
+	defer span.End()
+
+	bookAvailabilityDomain, err := h.lendingService.GetAvailability(ctx, uint(bookID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get availability error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BookAvailabilityDomainToDTO(bookAvailabilityDomain))
+}
+
+// SetInventory godoc
+//
+//	@Summary		Set number of book copies
+//	@Description	Extra copies are reserved for waiting holders at once. Removed copies which are on loan stay with readers till they are returned
+//	@Tags			lending
+//	@Param			bookID		path	uint					true	"Book ID"
+//	@Param			inventory	body	dto.SetInventoryRequest	true	"Inventory info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/inventory [put]
+func (h *lendingHandler) SetInventory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var setInventoryRequestDTO dto.SetInventoryRequest
+	if err := c.ShouldBindJSON(&setInventoryRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SetCopiesCount")
+	defer span.End()
+
+	if err := h.lendingService.SetCopiesCount(ctx, uint(bookID), *setInventoryRequestDTO.CopiesCount); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Set copies count error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// GetLoans godoc
+//
+//	@Summary		Get own loans
+//	@Description	Returns books borrowed by the authorized user which aren't returned yet, the closest due date first
+//	@Tags			lending
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Loan
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/loans [get]
+func (h *lendingHandler) GetLoans(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetLoans")
+	defer span.End()
+
+	loanDomains, err := h.lendingService.GetLoans(ctx, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get loans error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.LoanDomainsToDTOs(loanDomains))
+}
+
+// BorrowBook godoc
+//
+//	@Summary		Borrow a book
+//	@Description	Borrows a free copy or the copy reserved for the authorized user by a hold
+//	@Tags			lending
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	dto.Loan
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/loans [post]
+func (h *lendingHandler) BorrowBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.BorrowBook")
+	defer span.End()
+
+	loanDomain, err := h.lendingService.BorrowBook(ctx, uint(userID), uint(bookID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Borrow book error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.LoanDomainToDTO(loanDomain))
+}
+
+// ReturnBook godoc
+//
+//	@Summary		Return a borrowed book
+//	@Description	Closes own loan. The copy is reserved for the next reader in the holds queue
+//	@Tags			lending
+//	@Param			loanID	path	uint	true	"Loan ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Loan
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/loans/{loanID}/return [post]
+func (h *lendingHandler) ReturnBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	loanIDString := c.Param("loanID")
+	loanID, err := strconv.ParseUint(loanIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ReturnBook")
+	defer span.End()
+
+	loanDomain, err := h.lendingService.ReturnBook(ctx, uint(userID), uint(loanID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Return book error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.LoanDomainToDTO(loanDomain))
+}
+
+// RenewLoan godoc
+//
+//	@Summary		Renew own loan
+//	@Description	Extends the due date by one loan period. Overdue loans and books with waiting holders can't be renewed
+//	@Tags			lending
+//	@Param			loanID	path	uint	true	"Loan ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Loan
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/loans/{loanID}/renewal [post]
+func (h *lendingHandler) RenewLoan(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	loanIDString := c.Param("loanID")
+	loanID, err := strconv.ParseUint(loanIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.RenewLoan")
+	defer span.End()
+
+	loanDomain, err := h.lendingService.RenewLoan(ctx, uint(userID), uint(loanID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Renew loan error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.LoanDomainToDTO(loanDomain))
+}
+
+// GetHolds godoc
+//
+//	@Summary		Get own holds
+//	@Description	Returns holds of the authorized user with the position in the queue. Ready holds expire if the book isn't borrowed in time
+//	@Tags			lending
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Hold
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/holds [get]
+func (h *lendingHandler) GetHolds(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetHolds")
+	defer span.End()
+
+	holdDomains, err := h.lendingService.GetHolds(ctx, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get holds error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.HoldDomainsToDTOs(holdDomains))
+}
+
+// PlaceHold godoc
+//
+//	@Summary		Place a hold
+//	@Description	Joins the end of the holds queue of a book which has no free copies
+//	@Tags			lending
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	dto.Hold
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/holds [post]
+func (h *lendingHandler) PlaceHold(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.PlaceHold")
+	defer span.End()
+
+	holdDomain, err := h.lendingService.PlaceHold(ctx, uint(userID), uint(bookID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Place hold error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.HoldDomainToDTO(holdDomain))
+}
+
+// CancelHold godoc
+//
+//	@Summary		Cancel own hold
+//	@Description	Leaves the holds queue of a book. A reserved copy goes to the next holder
+//	@Tags			lending
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/holds [delete]
+func (h *lendingHandler) CancelHold(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CancelHold")
+	defer span.End()
+
+	if err := h.lendingService.CancelHold(ctx, uint(userID), uint(bookID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Cancel hold error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
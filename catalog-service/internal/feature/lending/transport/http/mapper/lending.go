@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/transport/http/dto"
+)
+
+func BookAvailabilityDomainToDTO(bookAvailabilityDomain *domain.BookAvailability) dto.BookAvailability {
+	return dto.BookAvailability{
+		BookID:          bookAvailabilityDomain.BookID,
+		CopiesCount:     bookAvailabilityDomain.CopiesCount,
+		AvailableCount:  bookAvailabilityDomain.AvailableCount,
+		LoansCount:      bookAvailabilityDomain.LoansCount,
+		HoldsCount:      bookAvailabilityDomain.HoldsCount,
+		ReadyHoldsCount: bookAvailabilityDomain.ReadyHoldsCount,
+	}
+}
+
+func LoanDomainToDTO(loanDomain *domain.Loan) dto.Loan {
+	return dto.Loan{
+		ID:            loanDomain.ID,
+		BookID:        loanDomain.BookID,
+		BorrowedAt:    loanDomain.BorrowedAt,
+		DueAt:         loanDomain.DueAt,
+		RenewalsCount: loanDomain.RenewalsCount,
+		ReturnedAt:    loanDomain.ReturnedAt,
+		Overdue:       loanDomain.ReturnedAt == nil && time.Now().After(loanDomain.DueAt),
+	}
+}
+
+func LoanDomainsToDTOs(loanDomains []domain.Loan) []dto.Loan {
+	loanDTOs := make([]dto.Loan, len(loanDomains))
+	for i := range loanDomains {
+		loanDTOs[i] = LoanDomainToDTO(&loanDomains[i])
+	}
+	return loanDTOs
+}
+
+func HoldDomainToDTO(holdDomain *domain.Hold) dto.Hold {
+	return dto.Hold{
+		ID:        holdDomain.ID,
+		BookID:    holdDomain.BookID,
+		Position:  holdDomain.Position,
+		ReadyAt:   holdDomain.ReadyAt,
+		ExpiresAt: holdDomain.ExpiresAt,
+		CreatedAt: holdDomain.CreatedAt,
+	}
+}
+
+func HoldDomainsToDTOs(holdDomains []domain.Hold) []dto.Hold {
+	holdDTOs := make([]dto.Hold, len(holdDomains))
+	for i := range holdDomains {
+		holdDTOs[i] = HoldDomainToDTO(&holdDomains[i])
+	}
+	return holdDTOs
+}
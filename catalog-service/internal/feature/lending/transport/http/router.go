@@ -0,0 +1,31 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts lending routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, lendingHandler LendingHandler) {
+	bookGroup := r.Group(sharedRoute.CATALOG + sharedRoute.BOOKS + "/:bookID")
+	{
+		bookGroup.GET(route.AVAILABILITY, lendingHandler.GetAvailability)
+		bookGroup.PUT(route.INVENTORY, lendingHandler.SetInventory)
+		bookGroup.POST(route.LOANS, lendingHandler.BorrowBook)
+		bookGroup.POST(route.HOLDS, lendingHandler.PlaceHold)
+		bookGroup.DELETE(route.HOLDS, lendingHandler.CancelHold)
+	}
+
+	loanGroup := r.Group(sharedRoute.CATALOG + route.LOANS)
+	{
+		loanGroup.POST("/:loanID"+route.RETURN, lendingHandler.ReturnBook)
+		loanGroup.POST("/:loanID"+route.RENEWAL, lendingHandler.RenewLoan)
+	}
+
+	meGroup := r.Group(sharedRoute.ME)
+	{
+		meGroup.GET(route.LOANS, lendingHandler.GetLoans)
+		meGroup.GET(route.HOLDS, lendingHandler.GetHolds)
+	}
+}
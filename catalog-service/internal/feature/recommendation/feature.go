@@ -0,0 +1,44 @@
+package recommendation
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogRedis "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/grpc/client/subscription"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// Register wires recommendations into the HTTP router of the catalog feature.
+// The returned job recomputes book similarities and has to be run by the container
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	redisClient *redis.Client,
+	httpRouter *gin.Engine,
+	subscriptionMicroserviceClient subscription.Client,
+) job.Job {
+	redisBookRepository := catalogRedis.NewBookRepository(redisClient)
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresCategoryRepository := catalogPostgres.NewCategoryRepository(postgresDB)
+	postgresBookSimilarityRepository := postgres.NewBookSimilarityRepository(postgresDB)
+	postgresUserHistoryRepository := postgres.NewUserHistoryRepository(postgresDB)
+
+	recommendationService := service.NewRecommendationService(
+		logger, postgresDB, redisBookRepository,
+		postgresBookRepository, postgresCategoryRepository, postgresBookSimilarityRepository, postgresUserHistoryRepository,
+		subscriptionMicroserviceClient,
+	)
+
+	httpRecommendationHandler := httpTransport.NewRecommendationHandler(config, logger, recommendationService)
+	httpTransport.RegisterRoutes(httpRouter, httpRecommendationHandler)
+
+	return job.NewJob(config, logger, recommendationService)
+}
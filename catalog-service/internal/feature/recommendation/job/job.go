@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+)
+
+// Job periodically recomputes book similarities from user views
+type Job interface {
+	Run(ctx context.Context)
+}
+
+type job struct {
+	config                *config.Config
+	logger                *logging.Logger
+	recommendationService service.RecommendationService
+}
+
+func NewJob(config *config.Config, logger *logging.Logger, recommendationService service.RecommendationService) Job {
+	return &job{
+		config:                config,
+		logger:                logger,
+		recommendationService: recommendationService,
+	}
+}
+
+// Run blocks until ctx is cancelled. The first pass starts right away, so similarities exist after the first deploy
+func (j *job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.config.SimilarityJobInterval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *job) runOnce(ctx context.Context) {
+	ctx, span := tracing.Span(ctx, j.config.ServiceName, "job.ComputeSimilarities")
+	defer span.End()
+
+	if err := j.recommendationService.ComputeSimilarities(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		tracing.Error(span, err)
+		j.logger.Error(ctx, "Compute book similarities error", logging.Error(err))
+	}
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type BookSimilarityRepository interface {
+	WithinTX(tx *gorm.DB) BookSimilarityRepository
+	DeleteAll(ctx context.Context) error
+	Compute(ctx context.Context, since, computedAt time.Time, maxViewsPerUser, minCoViewers, perBookCount int) (int64, error)
+	ListSimilarBookIDs(ctx context.Context, bookID uint, count int) ([]uint, error)
+	ListSimilarToBookIDs(ctx context.Context, bookIDs []uint, count int) ([]uint, error)
+}
+
+type bookSimilarityRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewBookSimilarityRepository(db *gorm.DB) BookSimilarityRepository {
+	return &bookSimilarityRepository{name: "Book similarity(ies)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *bookSimilarityRepository) WithinTX(tx *gorm.DB) BookSimilarityRepository {
+	return &bookSimilarityRepository{name: "Book similarity(ies)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *bookSimilarityRepository) DeleteAll(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Exec("DELETE FROM book_similarities").Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// Compute scores every pair of books viewed by the same users with the cosine similarity of their viewers,
+// only the best perBookCount pairs of each book are kept. Users with lots of views are capped to their latest ones,
+// otherwise crawlers would tie the whole catalog together
+func (r *bookSimilarityRepository) Compute(ctx context.Context, since, computedAt time.Time, maxViewsPerUser, minCoViewers, perBookCount int) (int64, error) {
+	const COMPUTE_TIMEOUT = 30 * time.Second
+
+	ctx, cancel := context.WithTimeout(ctx, COMPUTE_TIMEOUT)
+	defer cancel()
+
+	query := `
+		WITH views AS (
+			SELECT user_id, book_id FROM (
+				SELECT v.user_id, v.book_id, ROW_NUMBER() OVER (PARTITION BY v.user_id ORDER BY v.viewed_at DESC, v.book_id) AS position
+				FROM user_book_views v
+				INNER JOIN books b ON b.id = v.book_id AND b.deleted_at IS NULL
+				WHERE v.viewed_at >= ?
+			) recent
+			WHERE position <= ?
+		),
+		viewers AS (
+			SELECT book_id, COUNT(*) AS count FROM views GROUP BY book_id
+		),
+		pairs AS (
+			SELECT a.book_id, b.book_id AS similar_book_id, COUNT(*) AS co_viewers
+			FROM views a
+			INNER JOIN views b ON a.user_id = b.user_id AND a.book_id <> b.book_id
+			GROUP BY a.book_id, b.book_id
+			HAVING COUNT(*) >= ?
+		),
+		scored AS (
+			SELECT p.book_id, p.similar_book_id, p.co_viewers, p.co_viewers / SQRT((va.count * vb.count)::float8) AS score
+			FROM pairs p
+			INNER JOIN viewers va ON va.book_id = p.book_id
+			INNER JOIN viewers vb ON vb.book_id = p.similar_book_id
+		),
+		ranked AS (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY score DESC, co_viewers DESC, similar_book_id) AS position
+			FROM scored
+		)
+		INSERT INTO book_similarities (book_id, similar_book_id, score, co_viewers, computed_at)
+		SELECT book_id, similar_book_id, score, co_viewers, ?
+		FROM ranked
+		WHERE position <= ?
+	`
+
+	result := r.db.WithContext(ctx).Exec(query, since, maxViewsPerUser, minCoViewers, computedAt, perBookCount)
+	if result.Error != nil {
+		return 0, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	return result.RowsAffected, nil
+}
+
+// ListSimilarBookIDs skips trashed books, the most similar go first
+func (r *bookSimilarityRepository) ListSimilarBookIDs(ctx context.Context, bookID uint, count int) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookIDs []uint
+	err := r.db.WithContext(ctx).
+		Model(&model.BookSimilarity{}).
+		Joins("INNER JOIN books ON books.id = book_similarities.similar_book_id AND books.deleted_at IS NULL").
+		Where("book_similarities.book_id = ?", bookID).
+		Order("book_similarities.score DESC, book_similarities.similar_book_id").
+		Limit(count).
+		Pluck("book_similarities.similar_book_id", &bookIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs, nil
+}
+
+// ListSimilarToBookIDs sums up similarities to all the given books, which are never listed themselves
+func (r *bookSimilarityRepository) ListSimilarToBookIDs(ctx context.Context, bookIDs []uint, count int) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var similarBookIDs []uint
+	if len(bookIDs) == 0 {
+		return similarBookIDs, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.BookSimilarity{}).
+		Joins("INNER JOIN books ON books.id = book_similarities.similar_book_id AND books.deleted_at IS NULL").
+		Where("book_similarities.book_id IN ?", bookIDs).
+		Where("book_similarities.similar_book_id NOT IN ?", bookIDs).
+		Group("book_similarities.similar_book_id").
+		Order("SUM(book_similarities.score) DESC, book_similarities.similar_book_id").
+		Limit(count).
+		Pluck("book_similarities.similar_book_id", &similarBookIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return similarBookIDs, nil
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+// BookSimilarity tells how often readers of a book also viewed another one, rows are recomputed as a whole
+type BookSimilarity struct {
+	BookID        uint              `gorm:"primaryKey;autoIncrement:false"`
+	SimilarBookID uint              `gorm:"primaryKey;autoIncrement:false;index"`
+	Score         float64           `gorm:"not null"`
+	CoViewers     int64             `gorm:"not null"`
+	ComputedAt    time.Time         `gorm:"not null"`
+	Book          catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	SimilarBook   catalogModel.Book `gorm:"foreignKey:SimilarBookID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
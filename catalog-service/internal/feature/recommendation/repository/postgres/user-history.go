@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+// userHistoryQuery joins viewed and read books of a user, trashed books are left out
+const userHistoryQuery = `
+	SELECT history.book_id, history.seen_at, books.category_id
+	FROM (
+		SELECT book_id, viewed_at AS seen_at FROM user_book_views WHERE user_id = ?
+		UNION ALL
+		SELECT book_id, updated_at AS seen_at FROM reading_progresses WHERE user_id = ?
+	) history
+	INNER JOIN books ON books.id = history.book_id AND books.deleted_at IS NULL
+`
+
+type UserHistoryRepository interface {
+	ListBookIDs(ctx context.Context, userID uint, count int) ([]uint, error)
+	ListCategoryIDs(ctx context.Context, userID uint, count int) ([]uint, error)
+}
+
+type userHistoryRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewUserHistoryRepository(db *gorm.DB) UserHistoryRepository {
+	return &userHistoryRepository{name: "User history", timeout: 1 * time.Second, db: db}
+}
+
+// ListBookIDs lists books the user viewed or read, most recently seen first
+func (r *userHistoryRepository) ListBookIDs(ctx context.Context, userID uint, count int) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		SELECT book_id FROM (` + userHistoryQuery + `) seen
+		GROUP BY book_id
+		ORDER BY MAX(seen_at) DESC, book_id
+		LIMIT ?
+	`
+
+	var bookIDs []uint
+	err := r.db.WithContext(ctx).Raw(query, userID, userID, count).Scan(&bookIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs, nil
+}
+
+// ListCategoryIDs lists categories of the books the user viewed or read, the most seen categories go first
+func (r *userHistoryRepository) ListCategoryIDs(ctx context.Context, userID uint, count int) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		SELECT category_id FROM (` + userHistoryQuery + `) seen
+		WHERE category_id IS NOT NULL
+		GROUP BY category_id
+		ORDER BY COUNT(DISTINCT book_id) DESC, category_id
+		LIMIT ?
+	`
+
+	var categoryIDs []uint
+	err := r.db.WithContext(ctx).Raw(query, userID, userID, count).Scan(&categoryIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return categoryIDs, nil
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogRedis "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/grpc/client/subscription"
+	"gorm.io/gorm"
+)
+
+const (
+	// Views older than this don't tell much about what readers are into now
+	SIMILARITY_WINDOW_DAYS = 90
+	MAX_VIEWS_PER_USER     = 200
+	MIN_CO_VIEWERS         = 2
+	SIMILAR_BOOKS_PER_BOOK = 20
+
+	HISTORY_BOOKS_COUNT      = 50
+	HISTORY_CATEGORIES_COUNT = 3
+)
+
+type RecommendationService interface {
+	ComputeSimilarities(ctx context.Context) error
+	GetSimilarBooks(ctx context.Context, bookID, count uint) ([]domain.Book, error)
+	GetRecommendations(ctx context.Context, userID, count uint) ([]domain.Book, error)
+}
+
+type recommendationService struct {
+	logger                           *logging.Logger
+	postgresDB                       *gorm.DB
+	redisBookRepository              catalogRedis.BookRepository
+	postgresBookRepository           catalogPostgres.BookRepository
+	postgresCategoryRepository       catalogPostgres.CategoryRepository
+	postgresBookSimilarityRepository postgres.BookSimilarityRepository
+	postgresUserHistoryRepository    postgres.UserHistoryRepository
+	subscriptionMicroserviceClient   subscription.Client
+}
+
+func NewRecommendationService(
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	redisBookRepository catalogRedis.BookRepository,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresCategoryRepository catalogPostgres.CategoryRepository,
+	postgresBookSimilarityRepository postgres.BookSimilarityRepository,
+	postgresUserHistoryRepository postgres.UserHistoryRepository,
+	subscriptionMicroserviceClient subscription.Client,
+) RecommendationService {
+	return &recommendationService{
+		logger:                           logger,
+		postgresDB:                       postgresDB,
+		redisBookRepository:              redisBookRepository,
+		postgresBookRepository:           postgresBookRepository,
+		postgresCategoryRepository:       postgresCategoryRepository,
+		postgresBookSimilarityRepository: postgresBookSimilarityRepository,
+		postgresUserHistoryRepository:    postgresUserHistoryRepository,
+		subscriptionMicroserviceClient:   subscriptionMicroserviceClient,
+	}
+}
+
+// ComputeSimilarities replaces all similarities at once, readers keep seeing the previous ones until it commits
+func (s *recommendationService) ComputeSimilarities(ctx context.Context) error {
+	now := time.Now()
+	since := now.AddDate(0, 0, -SIMILARITY_WINDOW_DAYS)
+
+	txCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+
+	return s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		bookSimilarityRepository := s.postgresBookSimilarityRepository.WithinTX(tx)
+
+		if err := bookSimilarityRepository.DeleteAll(txCtx); err != nil {
+			return err
+		}
+		computedCount, err := bookSimilarityRepository.Compute(txCtx, since, now, MAX_VIEWS_PER_USER, MIN_CO_VIEWERS, SIMILAR_BOOKS_PER_BOOK)
+		if err != nil {
+			return err
+		}
+
+		s.logger.Info(ctx, "Computed book similarities", logging.Int("count", int(computedCount)))
+		return nil
+	})
+}
+
+// GetSimilarBooks lists books co-viewed with the given one. Books without enough co-views
+// are completed with the best rated books of the same category and then with popular ones
+func (s *recommendationService) GetSimilarBooks(ctx context.Context, bookID, count uint) ([]domain.Book, error) {
+	bookWithAuthorModel, err := s.postgresBookRepository.FindByID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookIDs, err := s.postgresBookSimilarityRepository.ListSimilarBookIDs(ctx, bookID, int(count))
+	if err != nil {
+		return nil, err
+	}
+
+	excludedBookIDs := []uint{bookID}
+	if bookWithAuthorModel.CategoryID != nil && len(bookIDs) < int(count) {
+		categoryBookIDs, err := s.postgresBookRepository.ListTopRatedIDs(ctx, []uint{*bookWithAuthorModel.CategoryID}, slices.Concat(excludedBookIDs, bookIDs), int(count))
+		if err != nil {
+			return nil, err
+		}
+		bookIDs = appendUnseen(bookIDs, categoryBookIDs, excludedBookIDs, int(count))
+	}
+	if len(bookIDs) < int(count) {
+		bookIDs = appendUnseen(bookIDs, s.getPopularBookIDs(ctx), excludedBookIDs, int(count))
+	}
+
+	return s.getRankedBooks(ctx, bookIDs)
+}
+
+// GetRecommendations ranks books co-viewed with the user's history first, then the best rated books
+// of subscribed and most seen categories, then popular and new books. Books the user has already seen are skipped,
+// so new users get the same popular and new books as anyone else
+func (s *recommendationService) GetRecommendations(ctx context.Context, userID, count uint) ([]domain.Book, error) {
+	seenBookIDs, err := s.postgresUserHistoryRepository.ListBookIDs(ctx, userID, HISTORY_BOOKS_COUNT)
+	if err != nil {
+		return nil, err
+	}
+
+	bookIDs, err := s.postgresBookSimilarityRepository.ListSimilarToBookIDs(ctx, seenBookIDs, int(count))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bookIDs) < int(count) {
+		categoryIDs, err := s.getPreferredCategoryIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if len(categoryIDs) > 0 {
+			categoryBookIDs, err := s.postgresBookRepository.ListTopRatedIDs(ctx, categoryIDs, slices.Concat(seenBookIDs, bookIDs), int(count))
+			if err != nil {
+				return nil, err
+			}
+			bookIDs = appendUnseen(bookIDs, categoryBookIDs, seenBookIDs, int(count))
+		}
+	}
+
+	if len(bookIDs) < int(count) {
+		bookIDs = appendUnseen(bookIDs, s.getPopularBookIDs(ctx), seenBookIDs, int(count))
+	}
+	if len(bookIDs) < int(count) {
+		newBookWithAuthorModels, err := s.postgresBookRepository.GetNew(ctx)
+		if err != nil {
+			return nil, err
+		}
+		newBookIDs := make([]uint, len(newBookWithAuthorModels))
+		for i := range newBookWithAuthorModels {
+			newBookIDs[i] = newBookWithAuthorModels[i].ID
+		}
+		bookIDs = appendUnseen(bookIDs, newBookIDs, seenBookIDs, int(count))
+	}
+
+	return s.getRankedBooks(ctx, bookIDs)
+}
+
+// getPreferredCategoryIDs joins subscribed categories with their subcategories and the most seen categories.
+// Subscriptions may still name categories which were deleted since, those are skipped
+func (s *recommendationService) getPreferredCategoryIDs(ctx context.Context, userID uint) ([]uint, error) {
+	var categoryIDs []uint
+	for _, subscribedCategoryName := range s.getSubscribedCategoryNames(ctx, userID) {
+		categoryModel, err := s.postgresCategoryRepository.FindBySlugOrName(ctx, subscribedCategoryName)
+		if errs.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		subtreeIDs, err := s.postgresCategoryRepository.ListSubtreeIDs(ctx, categoryModel.ID)
+		if err != nil {
+			return nil, err
+		}
+		categoryIDs = append(categoryIDs, subtreeIDs...)
+	}
+
+	seenCategoryIDs, err := s.postgresUserHistoryRepository.ListCategoryIDs(ctx, userID, HISTORY_CATEGORIES_COUNT)
+	if err != nil {
+		return nil, err
+	}
+	return append(categoryIDs, seenCategoryIDs...), nil
+}
+
+// getSubscribedCategoryNames asks subscription-service for the user's categories.
+// Recommendations still work from the history when it's unavailable
+func (s *recommendationService) getSubscribedCategoryNames(ctx context.Context, userID uint) []string {
+	subscribedCategoryNames, err := s.subscriptionMicroserviceClient.GetUserSubscribedBookCategories(ctx, userID)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip subscribed categories", logging.Int("userID", int(userID)), logging.Error(err))
+		return nil
+	}
+	return subscribedCategoryNames
+}
+
+// getPopularBookIDs only completes recommendations, so Redis failures leave them shorter instead of failing them
+func (s *recommendationService) getPopularBookIDs(ctx context.Context) []uint {
+	popularBookIDStrings, err := s.redisBookRepository.GetPopularBookIDs(ctx)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip popular books", logging.Error(err))
+		return nil
+	}
+
+	popularBookIDs := make([]uint, 0, len(popularBookIDStrings))
+	for _, popularBookIDString := range popularBookIDStrings {
+		popularBookID, err := strconv.ParseUint(popularBookIDString, 10, 64)
+		if err != nil {
+			continue
+		}
+		popularBookIDs = append(popularBookIDs, uint(popularBookID))
+	}
+	return popularBookIDs
+}
+
+// getRankedBooks keeps the order of the ranking
+func (s *recommendationService) getRankedBooks(ctx context.Context, bookIDs []uint) ([]domain.Book, error) {
+	bookIDStrings := make([]string, len(bookIDs))
+	for i, bookID := range bookIDs {
+		bookIDStrings[i] = strconv.FormatUint(uint64(bookID), 10)
+	}
+
+	bookWithAuthorModels, err := s.postgresBookRepository.GetBooksByIDs(ctx, bookIDStrings)
+	if err != nil {
+		return nil, err
+	}
+	bookDomains := catalogMapper.BookWithAuthorModelsToDomains(bookWithAuthorModels)
+
+	bookDomainsMap := make(map[uint]domain.Book)
+	for _, bookDomain := range bookDomains {
+		bookDomainsMap[bookDomain.ID] = bookDomain
+	}
+
+	sortedBooks := make([]domain.Book, 0)
+	for _, bookID := range bookIDs {
+		// Popular books may have been trashed in the meantime
+		if bookDomain, ok := bookDomainsMap[bookID]; ok {
+			sortedBooks = append(sortedBooks, bookDomain)
+		}
+	}
+	return sortedBooks, nil
+}
+
+// appendUnseen adds candidates which are neither picked nor excluded yet, until count IDs are picked
+func appendUnseen(pickedIDs, candidateIDs, excludedIDs []uint, count int) []uint {
+	for _, candidateID := range candidateIDs {
+		if len(pickedIDs) >= count {
+			break
+		}
+		if !slices.Contains(pickedIDs, candidateID) && !slices.Contains(excludedIDs, candidateID) {
+			pickedIDs = append(pickedIDs, candidateID)
+		}
+	}
+	return pickedIDs
+}
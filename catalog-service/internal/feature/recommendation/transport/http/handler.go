@@ -0,0 +1,125 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+type RecommendationHandler interface {
+	GetSimilarBooks(c *gin.Context)
+	GetRecommendations(c *gin.Context)
+}
+
+type recommendationHandler struct {
+	config                *config.Config
+	logger                *logging.Logger
+	recommendationService service.RecommendationService
+}
+
+func NewRecommendationHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	recommendationService service.RecommendationService,
+) RecommendationHandler {
+	return &recommendationHandler{
+		config:                config,
+		logger:                logger,
+		recommendationService: recommendationService,
+	}
+}
+
+// GetSimilarBooks godoc
+//
+//	@Summary		Readers also viewed
+//	@Description	Returns books viewed by the same readers, most similar first. Books with too few co-views are completed with the best rated books of their category and popular books
+//	@Tags			recommendation
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Param			count	query	int		false	"Number of books (min=1, max=50, default=10)"
+//	@Produce		json
+//	@Success		200	{array}		dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/similar [get]
+func (h *recommendationHandler) GetSimilarBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.GetSimilarBooks
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetSimilarBooks")
+	defer span.End()
+
+	bookDomains, err := h.recommendationService.GetSimilarBooks(ctx, uint(bookID), query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get similar books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, catalogMapper.BookDomainsToDTOs(bookDomains))
+}
+
+// GetRecommendations godoc
+//
+//	@Summary		Get personal recommendations
+//	@Description	Returns books similar to the ones the authorized user viewed or read, then the best rated books of subscribed and most seen categories, then popular and new books. Already seen books are skipped
+//	@Tags			recommendation
+//	@Param			count	query	int	false	"Number of books (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/me/recommendations [get]
+func (h *recommendationHandler) GetRecommendations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var query query.GetRecommendations
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetRecommendations")
+	defer span.End()
+
+	bookDomains, err := h.recommendationService.GetRecommendations(ctx, uint(userID), query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get recommendations error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, catalogMapper.BookDomainsToDTOs(bookDomains))
+}
@@ -0,0 +1,9 @@
+package query
+
+type GetSimilarBooks struct {
+	Count uint `form:"count,default=10" binding:"min=1,max=50"`
+}
+
+type GetRecommendations struct {
+	Count uint `form:"count,default=20" binding:"min=1,max=100"`
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts recommendation routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, recommendationHandler RecommendationHandler) {
+	bookGroup := r.Group(sharedRoute.CATALOG + sharedRoute.BOOKS)
+	{
+		bookGroup.GET("/:bookID"+route.SIMILAR, recommendationHandler.GetSimilarBooks)
+	}
+
+	meGroup := r.Group(sharedRoute.ME)
+	{
+		meGroup.GET(route.RECOMMENDATIONS, recommendationHandler.GetRecommendations)
+	}
+}
@@ -0,0 +1,24 @@
+package review
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/transport/http"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires the review feature into the HTTP router of the catalog feature
+func Register(config *config.Config, logger *logging.Logger, postgresDB *gorm.DB, httpRouter *gin.Engine, bookChangedWriter *kafkaInfrastructure.BookChangedWriter) {
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresReviewRepository := postgres.NewReviewRepository(postgresDB)
+
+	reviewService := service.NewReviewService(postgresDB, bookChangedWriter, postgresBookRepository, postgresReviewRepository)
+
+	httpReviewHandler := httpTransport.NewReviewHandler(config, logger, reviewService)
+	httpTransport.RegisterRoutes(httpRouter, httpReviewHandler)
+}
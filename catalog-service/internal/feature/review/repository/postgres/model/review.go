@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+type Review struct {
+	ID        uint  `gorm:"primarykey"`
+	UserID    uint  `gorm:"uniqueIndex:user_id_book_id_index"`
+	BookID    uint  `gorm:"uniqueIndex:user_id_book_id_index;index"`
+	Rating    uint8 `gorm:"not null;check:rating BETWEEN 1 AND 5"`
+	Text      string
+	Hidden    bool `gorm:"not null;default:false"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Book      catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
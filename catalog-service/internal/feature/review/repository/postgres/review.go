@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type ReviewRepository interface {
+	WithinTX(tx *gorm.DB) ReviewRepository
+	Create(ctx context.Context, review *model.Review) error
+	FindByID(ctx context.Context, reviewID uint) (*model.Review, error)
+	ListVisibleByBookID(ctx context.Context, bookID, page, count uint) ([]model.Review, error)
+	Update(ctx context.Context, review *model.Review) error
+	UpdateHidden(ctx context.Context, reviewID uint, hidden bool) error
+	Delete(ctx context.Context, reviewID uint) error
+	RefreshBookRating(ctx context.Context, bookID uint) error
+}
+
+type reviewRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return &reviewRepository{name: "Review(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *reviewRepository) WithinTX(tx *gorm.DB) ReviewRepository {
+	return &reviewRepository{name: "Review(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *reviewRepository) Create(ctx context.Context, review *model.Review) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("Book").Create(review).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *reviewRepository) FindByID(ctx context.Context, reviewID uint) (*model.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var review model.Review
+	if err := r.db.WithContext(ctx).Where("id = ?", reviewID).First(&review).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &review, nil
+}
+
+func (r *reviewRepository) ListVisibleByBookID(ctx context.Context, bookID, page, count uint) ([]model.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var reviews []model.Review
+	err := r.db.WithContext(ctx).
+		Where("book_id = ?", bookID).
+		Where("hidden = ?", false).
+		Order("created_at DESC").
+		Limit(int(count)).
+		Offset(int((page - 1) * count)).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return reviews, nil
+}
+
+func (r *reviewRepository) Update(ctx context.Context, review *model.Review) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(review).
+		Select("rating", "text", "updated_at").
+		Updates(review).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *reviewRepository) UpdateHidden(ctx context.Context, reviewID uint, hidden bool) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).Model(&model.Review{}).Where("id = ?", reviewID).Update("hidden", hidden)
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
+
+func (r *reviewRepository) Delete(ctx context.Context, reviewID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Delete(&model.Review{}, reviewID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// RefreshBookRating recalculates denormalized rating of the book, hidden reviews aren't counted.
+// The book row is locked first, so concurrent transactions refresh one after another
+// and the aggregation sees reviews committed by the previous one
+func (r *reviewRepository) RefreshBookRating(ctx context.Context, bookID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Exec("SELECT id FROM books WHERE id = ? FOR UPDATE", bookID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+
+	query := `
+		UPDATE books
+		SET
+			rating_average = COALESCE(ratings.average, 0),
+			rating_count = ratings.count
+		FROM (
+			SELECT ROUND(AVG(rating)::numeric, 2) AS average, COUNT(*) AS count
+			FROM reviews
+			WHERE book_id = ? AND hidden = FALSE
+		) ratings
+		WHERE books.id = ?
+	`
+
+	if err := r.db.WithContext(ctx).Exec(query, bookID, bookID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/repository/postgres/model"
+)
+
+func ReviewModelToDomain(reviewModel *model.Review) domain.Review {
+	return domain.Review{
+		ID:        reviewModel.ID,
+		UserID:    reviewModel.UserID,
+		BookID:    reviewModel.BookID,
+		Rating:    reviewModel.Rating,
+		Text:      reviewModel.Text,
+		Hidden:    reviewModel.Hidden,
+		CreatedAt: reviewModel.CreatedAt,
+		UpdatedAt: reviewModel.UpdatedAt,
+	}
+}
+
+func ReviewModelsToDomains(reviewModels []model.Review) []domain.Review {
+	reviewDomains := make([]domain.Review, len(reviewModels))
+	for i := range reviewModels {
+		reviewDomains[i] = ReviewModelToDomain(&reviewModels[i])
+	}
+	return reviewDomains
+}
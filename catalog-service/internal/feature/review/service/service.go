@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/service/mapper/postgres"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"gorm.io/gorm"
+)
+
+type ReviewService interface {
+	GetReviews(ctx context.Context, bookID, page, count uint) ([]domain.Review, error)
+	CreateReview(ctx context.Context, reviewDomain *domain.Review) error
+	UpdateReview(ctx context.Context, reviewDomain *domain.Review) error
+	DeleteReview(ctx context.Context, userID, bookID, reviewID uint) error
+	SetReviewHidden(ctx context.Context, reviewID uint, hidden bool) error
+	DeleteReviewByAdmin(ctx context.Context, reviewID uint) error
+}
+
+type reviewService struct {
+	postgresDB               *gorm.DB
+	bookChangedWriter        *kafkaInfrastructure.BookChangedWriter
+	postgresBookRepository   catalogPostgres.BookRepository
+	postgresReviewRepository postgres.ReviewRepository
+}
+
+func NewReviewService(
+	postgresDB *gorm.DB,
+	bookChangedWriter *kafkaInfrastructure.BookChangedWriter,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresReviewRepository postgres.ReviewRepository) ReviewService {
+	return &reviewService{
+		postgresDB:               postgresDB,
+		bookChangedWriter:        bookChangedWriter,
+		postgresBookRepository:   postgresBookRepository,
+		postgresReviewRepository: postgresReviewRepository,
+	}
+}
+
+func (s *reviewService) GetReviews(ctx context.Context, bookID, page, count uint) ([]domain.Review, error) {
+	reviewModels, err := s.postgresReviewRepository.ListVisibleByBookID(ctx, bookID, page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.ReviewModelsToDomains(reviewModels), nil
+}
+
+func (s *reviewService) CreateReview(ctx context.Context, reviewDomain *domain.Review) error {
+	reviewModel := model.Review{
+		UserID: reviewDomain.UserID,
+		BookID: reviewDomain.BookID,
+		Rating: reviewDomain.Rating,
+		Text:   reviewDomain.Text,
+	}
+
+	err := s.withinTX(ctx, func(txCtx context.Context, postgresReviewRepositoryTX postgres.ReviewRepository) error {
+		if _, err := s.postgresBookRepository.FindByID(txCtx, reviewDomain.BookID); err != nil {
+			return err
+		}
+		if err := postgresReviewRepositoryTX.Create(txCtx, &reviewModel); err != nil {
+			return err
+		}
+		return postgresReviewRepositoryTX.RefreshBookRating(txCtx, reviewDomain.BookID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{reviewDomain.BookID})
+	*reviewDomain = postgresMapper.ReviewModelToDomain(&reviewModel)
+	return nil
+}
+
+func (s *reviewService) UpdateReview(ctx context.Context, reviewDomain *domain.Review) error {
+	var reviewModel *model.Review
+
+	err := s.withinTX(ctx, func(txCtx context.Context, postgresReviewRepositoryTX postgres.ReviewRepository) error {
+		var err error
+		reviewModel, err = s.findOwnReview(txCtx, postgresReviewRepositoryTX, reviewDomain.UserID, reviewDomain.BookID, reviewDomain.ID)
+		if err != nil {
+			return err
+		}
+
+		reviewModel.Rating = reviewDomain.Rating
+		reviewModel.Text = reviewDomain.Text
+		if err := postgresReviewRepositoryTX.Update(txCtx, reviewModel); err != nil {
+			return err
+		}
+		return postgresReviewRepositoryTX.RefreshBookRating(txCtx, reviewModel.BookID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{reviewModel.BookID})
+	*reviewDomain = postgresMapper.ReviewModelToDomain(reviewModel)
+	return nil
+}
+
+func (s *reviewService) DeleteReview(ctx context.Context, userID, bookID, reviewID uint) error {
+	err := s.withinTX(ctx, func(txCtx context.Context, postgresReviewRepositoryTX postgres.ReviewRepository) error {
+		if _, err := s.findOwnReview(txCtx, postgresReviewRepositoryTX, userID, bookID, reviewID); err != nil {
+			return err
+		}
+		if err := postgresReviewRepositoryTX.Delete(txCtx, reviewID); err != nil {
+			return err
+		}
+		return postgresReviewRepositoryTX.RefreshBookRating(txCtx, bookID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookID})
+	return nil
+}
+
+func (s *reviewService) SetReviewHidden(ctx context.Context, reviewID uint, hidden bool) error {
+	var bookID uint
+	err := s.withinTX(ctx, func(txCtx context.Context, postgresReviewRepositoryTX postgres.ReviewRepository) error {
+		reviewModel, err := postgresReviewRepositoryTX.FindByID(txCtx, reviewID)
+		if err != nil {
+			return err
+		}
+		if err := postgresReviewRepositoryTX.UpdateHidden(txCtx, reviewID, hidden); err != nil {
+			return err
+		}
+		bookID = reviewModel.BookID
+		return postgresReviewRepositoryTX.RefreshBookRating(txCtx, bookID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookID})
+	return nil
+}
+
+func (s *reviewService) DeleteReviewByAdmin(ctx context.Context, reviewID uint) error {
+	var bookID uint
+	err := s.withinTX(ctx, func(txCtx context.Context, postgresReviewRepositoryTX postgres.ReviewRepository) error {
+		reviewModel, err := postgresReviewRepositoryTX.FindByID(txCtx, reviewID)
+		if err != nil {
+			return err
+		}
+		if err := postgresReviewRepositoryTX.Delete(txCtx, reviewID); err != nil {
+			return err
+		}
+		bookID = reviewModel.BookID
+		return postgresReviewRepositoryTX.RefreshBookRating(txCtx, bookID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, []uint{bookID})
+	return nil
+}
+
+// findOwnReview hides reviews of other users behind not found error
+func (s *reviewService) findOwnReview(ctx context.Context, postgresReviewRepository postgres.ReviewRepository, userID, bookID, reviewID uint) (*model.Review, error) {
+	reviewModel, err := postgresReviewRepository.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if reviewModel.UserID != userID || reviewModel.BookID != bookID {
+		return nil, errs.NewEntityNotFoundError("Review(s)")
+	}
+	return reviewModel, nil
+}
+
+// withinTX keeps review changes and the denormalized book rating consistent
+func (s *reviewService) withinTX(ctx context.Context, fn func(txCtx context.Context, postgresReviewRepositoryTX postgres.ReviewRepository) error) error {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		return fn(txCtx, s.postgresReviewRepository.WithinTX(tx))
+	})
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type Review struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"userId"`
+	BookID    uint      `json:"bookId"`
+	Rating    uint8     `json:"rating"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type SaveReviewRequest struct {
+	Rating uint8  `json:"rating" binding:"required,min=1,max=5"`
+	Text   string `json:"text" binding:"max=5000"`
+}
+
+type SetReviewVisibilityRequest struct {
+	Hidden *bool `json:"hidden" binding:"required"`
+}
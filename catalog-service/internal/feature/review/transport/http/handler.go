@@ -0,0 +1,339 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewHandler interface {
+	GetReviews(c *gin.Context)
+	CreateReview(c *gin.Context)
+	UpdateReview(c *gin.Context)
+	DeleteReview(c *gin.Context)
+	SetReviewVisibility(c *gin.Context)
+	DeleteReviewByAdmin(c *gin.Context)
+}
+
+type reviewHandler struct {
+	config        *config.Config
+	logger        *logging.Logger
+	reviewService service.ReviewService
+}
+
+func NewReviewHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	reviewService service.ReviewService,
+) ReviewHandler {
+	return &reviewHandler{
+		config:        config,
+		logger:        logger,
+		reviewService: reviewService,
+	}
+}
+
+// GetReviews godoc
+//
+//	@Summary		Get book reviews
+//	@Description	Returns reviews of a book which weren't hidden by moderators, newest first
+//	@Tags			review
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Param			page	query	int		false	"Page number (min=1, default=1)"
+//	@Param			count	query	int		false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Success		200	{array}		dto.Review
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/reviews [get]
+func (h *reviewHandler) GetReviews(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var query query.GetReviews
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetReviews")
+	defer span.End()
+
+	reviewDomains, err := h.reviewService.GetReviews(ctx, uint(bookID), query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get reviews error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ReviewDomainsToDTOs(reviewDomains))
+}
+
+// CreateReview godoc
+//
+//	@Summary		Review a book
+//	@Description	Rates a book from 1 to 5 stars with an optional text. Every user can review a book once
+//	@Tags			review
+//	@Param			bookID	path	uint					true	"Book ID"
+//	@Param			review	body	dto.SaveReviewRequest	true	"Review info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	dto.Review
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/reviews [post]
+func (h *reviewHandler) CreateReview(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var saveReviewRequestDTO dto.SaveReviewRequest
+	if err := c.ShouldBindJSON(&saveReviewRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CreateReview")
+	defer span.End()
+
+	reviewDomain := mapper.SaveReviewRequestDTOToDomain(uint(userID), uint(bookID), 0, &saveReviewRequestDTO)
+	if err := h.reviewService.CreateReview(ctx, &reviewDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Create review error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ReviewDomainToDTO(&reviewDomain))
+}
+
+// UpdateReview godoc
+//
+//	@Summary		Update own review
+//	@Description	Replaces rating and text of a review written by the authorized user
+//	@Tags			review
+//	@Param			bookID		path	uint					true	"Book ID"
+//	@Param			reviewID	path	uint					true	"Review ID"
+//	@Param			review		body	dto.SaveReviewRequest	true	"Review info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Review
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/reviews/{reviewID} [put]
+func (h *reviewHandler) UpdateReview(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	reviewIDString := c.Param("reviewID")
+	reviewID, err := strconv.ParseUint(reviewIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var saveReviewRequestDTO dto.SaveReviewRequest
+	if err := c.ShouldBindJSON(&saveReviewRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UpdateReview")
+	defer span.End()
+
+	reviewDomain := mapper.SaveReviewRequestDTOToDomain(uint(userID), uint(bookID), uint(reviewID), &saveReviewRequestDTO)
+	if err := h.reviewService.UpdateReview(ctx, &reviewDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Update review error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ReviewDomainToDTO(&reviewDomain))
+}
+
+// DeleteReview godoc
+//
+//	@Summary		Delete own review
+//	@Description	Deletes a review written by the authorized user
+//	@Tags			review
+//	@Param			bookID		path	uint	true	"Book ID"
+//	@Param			reviewID	path	uint	true	"Review ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/reviews/{reviewID} [delete]
+func (h *reviewHandler) DeleteReview(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	reviewIDString := c.Param("reviewID")
+	reviewID, err := strconv.ParseUint(reviewIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteReview")
+	defer span.End()
+
+	if err := h.reviewService.DeleteReview(ctx, uint(userID), uint(bookID), uint(reviewID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete review error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// SetReviewVisibility godoc
+//
+//	@Summary		Hide or show a review
+//	@Description	Hidden reviews aren't listed and don't count in the book rating
+//	@Tags			review
+//	@Param			reviewID	path	uint							true	"Review ID"
+//	@Param			visibility	body	dto.SetReviewVisibilityRequest	true	"Visibility info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/reviews/{reviewID}/visibility [put]
+func (h *reviewHandler) SetReviewVisibility(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	reviewIDString := c.Param("reviewID")
+	reviewID, err := strconv.ParseUint(reviewIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var setReviewVisibilityRequestDTO dto.SetReviewVisibilityRequest
+	if err := c.ShouldBindJSON(&setReviewVisibilityRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SetReviewHidden")
+	defer span.End()
+
+	if err := h.reviewService.SetReviewHidden(ctx, uint(reviewID), *setReviewVisibilityRequestDTO.Hidden); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Set review hidden error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// DeleteReviewByAdmin godoc
+//
+//	@Summary		Delete any review
+//	@Description	Deletes a review of any user
+//	@Tags			review
+//	@Param			reviewID	path	uint	true	"Review ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/reviews/{reviewID} [delete]
+func (h *reviewHandler) DeleteReviewByAdmin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	reviewIDString := c.Param("reviewID")
+	reviewID, err := strconv.ParseUint(reviewIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteReviewByAdmin")
+	defer span.End()
+
+	if err := h.reviewService.DeleteReviewByAdmin(ctx, uint(reviewID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete review by admin error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/transport/http/dto"
+)
+
+func ReviewDomainToDTO(reviewDomain *domain.Review) dto.Review {
+	return dto.Review{
+		ID:        reviewDomain.ID,
+		UserID:    reviewDomain.UserID,
+		BookID:    reviewDomain.BookID,
+		Rating:    reviewDomain.Rating,
+		Text:      reviewDomain.Text,
+		CreatedAt: reviewDomain.CreatedAt,
+		UpdatedAt: reviewDomain.UpdatedAt,
+	}
+}
+
+func ReviewDomainsToDTOs(reviewDomains []domain.Review) []dto.Review {
+	reviewDTOs := make([]dto.Review, len(reviewDomains))
+	for i := range reviewDomains {
+		reviewDTOs[i] = ReviewDomainToDTO(&reviewDomains[i])
+	}
+	return reviewDTOs
+}
+
+func SaveReviewRequestDTOToDomain(userID, bookID, reviewID uint, saveReviewRequestDTO *dto.SaveReviewRequest) domain.Review {
+	return domain.Review{
+		ID:     reviewID,
+		UserID: userID,
+		BookID: bookID,
+		Rating: saveReviewRequestDTO.Rating,
+		Text:   saveReviewRequestDTO.Text,
+	}
+}
@@ -0,0 +1,6 @@
+package query
+
+type GetReviews struct {
+	Page  uint `form:"page,default=1" binding:"min=1"`
+	Count uint `form:"count,default=20" binding:"min=1,max=100"`
+}
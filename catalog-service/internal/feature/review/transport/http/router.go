@@ -0,0 +1,24 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts review routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, reviewHandler ReviewHandler) {
+	bookReviewGroup := r.Group(sharedRoute.CATALOG + sharedRoute.BOOKS + "/:bookID" + route.REVIEWS)
+	{
+		bookReviewGroup.GET("", reviewHandler.GetReviews)
+		bookReviewGroup.POST("", reviewHandler.CreateReview)
+		bookReviewGroup.PUT("/:reviewID", reviewHandler.UpdateReview)
+		bookReviewGroup.DELETE("/:reviewID", reviewHandler.DeleteReview)
+	}
+
+	adminGroup := r.Group(sharedRoute.CATALOG + route.REVIEWS)
+	{
+		adminGroup.PUT("/:reviewID"+route.VISIBILITY, reviewHandler.SetReviewVisibility)
+		adminGroup.DELETE("/:reviewID", reviewHandler.DeleteReviewByAdmin)
+	}
+}
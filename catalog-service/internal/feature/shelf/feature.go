@@ -0,0 +1,24 @@
+package shelf
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires the shelf feature into the HTTP router of the catalog feature
+func Register(config *config.Config, logger *logging.Logger, postgresDB *gorm.DB, httpRouter *gin.Engine) {
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresShelfRepository := postgres.NewShelfRepository(postgresDB)
+	postgresShelfBookRepository := postgres.NewShelfBookRepository(postgresDB)
+
+	shelfService := service.NewShelfService(postgresDB, postgresBookRepository, postgresShelfRepository, postgresShelfBookRepository)
+
+	httpShelfHandler := httpTransport.NewShelfHandler(config, logger, shelfService)
+	httpTransport.RegisterRoutes(httpRouter, httpShelfHandler)
+}
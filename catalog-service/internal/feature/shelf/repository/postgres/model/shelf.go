@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+type Shelf struct {
+	ID         uint   `gorm:"primarykey"`
+	UserID     uint   `gorm:"uniqueIndex:user_id_name_index"`
+	Name       string `gorm:"uniqueIndex:user_id_name_index"`
+	Kind       string
+	IsPublic   bool
+	ShareToken *string `gorm:"uniqueIndex"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Books      []ShelfBook `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type ShelfWithBooksCount struct {
+	Shelf
+	BooksCount uint
+}
+
+type ShelfBook struct {
+	ShelfID   uint `gorm:"primaryKey;autoIncrement:false"`
+	BookID    uint `gorm:"primaryKey;autoIncrement:false"`
+	Position  uint
+	CreatedAt time.Time
+	Book      catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type ShelfBookWithBook struct {
+	catalogModel.BookWithAuthor
+	Position  uint
+	CreatedAt time.Time
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type ShelfBookRepository interface {
+	WithinTX(tx *gorm.DB) ShelfBookRepository
+	Create(ctx context.Context, shelfBook *model.ShelfBook) error
+	ListByShelfID(ctx context.Context, shelfID uint) ([]model.ShelfBookWithBook, error)
+	GetBookIDs(ctx context.Context, shelfID uint) ([]uint, error)
+	GetNextPosition(ctx context.Context, shelfID uint) (uint, error)
+	UpdatePosition(ctx context.Context, shelfID, bookID, position uint) error
+	Delete(ctx context.Context, shelfID, bookID uint) error
+	DeleteFromShelves(ctx context.Context, shelfIDs []uint, bookID uint) error
+}
+
+type shelfBookRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewShelfBookRepository(db *gorm.DB) ShelfBookRepository {
+	return &shelfBookRepository{name: "Shelf book(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *shelfBookRepository) WithinTX(tx *gorm.DB) ShelfBookRepository {
+	return &shelfBookRepository{name: "Shelf book(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *shelfBookRepository) Create(ctx context.Context, shelfBook *model.ShelfBook) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("Book").Create(shelfBook).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *shelfBookRepository) ListByShelfID(ctx context.Context, shelfID uint) ([]model.ShelfBookWithBook, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var shelfBooks []model.ShelfBookWithBook
+	err := r.db.WithContext(ctx).
+		Model(&model.ShelfBook{}).
+		Select(catalogModel.BookWithAuthorColumns()+", shelf_books.position, shelf_books.created_at").
+		Joins("INNER JOIN books ON shelf_books.book_id = books.id AND books.deleted_at IS NULL").
+		Joins("LEFT JOIN authors ON books.author_id = authors.id").
+		Where("shelf_books.shelf_id = ?", shelfID).
+		Order("shelf_books.position, shelf_books.created_at").
+		Scan(&shelfBooks).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return shelfBooks, nil
+}
+
+func (r *shelfBookRepository) GetBookIDs(ctx context.Context, shelfID uint) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var bookIDs []uint
+	if err := r.db.WithContext(ctx).Model(&model.ShelfBook{}).Where("shelf_id = ?", shelfID).Pluck("book_id", &bookIDs).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return bookIDs, nil
+}
+
+func (r *shelfBookRepository) GetNextPosition(ctx context.Context, shelfID uint) (uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var nextPosition uint
+	err := r.db.WithContext(ctx).
+		Model(&model.ShelfBook{}).
+		Select("COALESCE(MAX(position) + 1, 0)").
+		Where("shelf_id = ?", shelfID).
+		Scan(&nextPosition).Error
+	if err != nil {
+		return 0, postgresInfrastructure.NewError(err, r.name)
+	}
+	return nextPosition, nil
+}
+
+func (r *shelfBookRepository) UpdatePosition(ctx context.Context, shelfID, bookID, position uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.ShelfBook{}).
+		Where("shelf_id = ?", shelfID).
+		Where("book_id = ?", bookID).
+		Update("position", position).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *shelfBookRepository) Delete(ctx context.Context, shelfID, bookID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Where("shelf_id = ?", shelfID).
+		Where("book_id = ?", bookID).
+		Delete(&model.ShelfBook{})
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
+
+func (r *shelfBookRepository) DeleteFromShelves(ctx context.Context, shelfIDs []uint, bookID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if len(shelfIDs) == 0 {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("shelf_id IN ?", shelfIDs).
+		Where("book_id = ?", bookID).
+		Delete(&model.ShelfBook{}).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ShelfRepository interface {
+	WithinTX(tx *gorm.DB) ShelfRepository
+	CreateDefaults(ctx context.Context, shelves []model.Shelf) error
+	Create(ctx context.Context, shelf *model.Shelf) error
+	FindByID(ctx context.Context, shelfID uint) (*model.Shelf, error)
+	FindByShareToken(ctx context.Context, shareToken string) (*model.Shelf, error)
+	ListByUserID(ctx context.Context, userID uint) ([]model.ShelfWithBooksCount, error)
+	GetIDsByUserIDAndKinds(ctx context.Context, userID uint, kinds []string) ([]uint, error)
+	Update(ctx context.Context, shelf *model.Shelf) error
+	Delete(ctx context.Context, shelfID uint) error
+}
+
+type shelfRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewShelfRepository(db *gorm.DB) ShelfRepository {
+	return &shelfRepository{name: "Shelf(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *shelfRepository) WithinTX(tx *gorm.DB) ShelfRepository {
+	return &shelfRepository{name: "Shelf(s)", timeout: 1 * time.Second, db: tx}
+}
+
+// CreateDefaults skips shelves which already exist, so it's safe to call on every listing
+func (r *shelfRepository) CreateDefaults(ctx context.Context, shelves []model.Shelf) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Omit("Books").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&shelves).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *shelfRepository) Create(ctx context.Context, shelf *model.Shelf) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("Books").Create(shelf).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *shelfRepository) FindByID(ctx context.Context, shelfID uint) (*model.Shelf, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var shelf model.Shelf
+	if err := r.db.WithContext(ctx).Where("id = ?", shelfID).First(&shelf).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &shelf, nil
+}
+
+func (r *shelfRepository) FindByShareToken(ctx context.Context, shareToken string) (*model.Shelf, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var shelf model.Shelf
+	err := r.db.WithContext(ctx).
+		Where("share_token = ?", shareToken).
+		Where("is_public = ?", true).
+		First(&shelf).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &shelf, nil
+}
+
+func (r *shelfRepository) ListByUserID(ctx context.Context, userID uint) ([]model.ShelfWithBooksCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var shelves []model.ShelfWithBooksCount
+	err := r.db.WithContext(ctx).
+		Model(&model.Shelf{}).
+		Select("shelves.*, COUNT(books.id) AS books_count").
+		Joins("LEFT JOIN shelf_books ON shelf_books.shelf_id = shelves.id").
+		Joins("LEFT JOIN books ON shelf_books.book_id = books.id AND books.deleted_at IS NULL").
+		Where("shelves.user_id = ?", userID).
+		Group("shelves.id").
+		Order("shelves.id").
+		Scan(&shelves).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return shelves, nil
+}
+
+func (r *shelfRepository) GetIDsByUserIDAndKinds(ctx context.Context, userID uint, kinds []string) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var shelfIDs []uint
+	err := r.db.WithContext(ctx).
+		Model(&model.Shelf{}).
+		Where("user_id = ?", userID).
+		Where("kind IN ?", kinds).
+		Pluck("id", &shelfIDs).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return shelfIDs, nil
+}
+
+func (r *shelfRepository) Update(ctx context.Context, shelf *model.Shelf) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Model(shelf).
+		Select("name", "is_public", "share_token", "updated_at").
+		Updates(shelf).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *shelfRepository) Delete(ctx context.Context, shelfID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Delete(&model.Shelf{}, shelfID).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres/model"
+)
+
+func ShelfModelToDomain(shelfModel *model.Shelf) domain.Shelf {
+	shelfDomain := domain.Shelf{
+		ID:        shelfModel.ID,
+		UserID:    shelfModel.UserID,
+		Name:      shelfModel.Name,
+		Kind:      shelfModel.Kind,
+		IsPublic:  shelfModel.IsPublic,
+		CreatedAt: shelfModel.CreatedAt,
+		UpdatedAt: shelfModel.UpdatedAt,
+	}
+	if shelfModel.ShareToken != nil {
+		shelfDomain.ShareToken = *shelfModel.ShareToken
+	}
+	return shelfDomain
+}
+
+func ShelfWithBooksCountModelsToDomains(shelfWithBooksCountModels []model.ShelfWithBooksCount) []domain.Shelf {
+	shelfDomains := make([]domain.Shelf, len(shelfWithBooksCountModels))
+	for i := range shelfWithBooksCountModels {
+		shelfDomains[i] = ShelfModelToDomain(&shelfWithBooksCountModels[i].Shelf)
+		shelfDomains[i].BooksCount = shelfWithBooksCountModels[i].BooksCount
+	}
+	return shelfDomains
+}
+
+func ShelfBookWithBookModelsToDomains(shelfBookWithBookModels []model.ShelfBookWithBook) []domain.ShelfBook {
+	shelfBookDomains := make([]domain.ShelfBook, len(shelfBookWithBookModels))
+	for i := range shelfBookWithBookModels {
+		shelfBookDomains[i] = domain.ShelfBook{
+			Book:     catalogMapper.BookWithAuthorModelToDomain(&shelfBookWithBookModels[i].BookWithAuthor),
+			Position: shelfBookWithBookModels[i].Position,
+			AddedAt:  shelfBookWithBookModels[i].CreatedAt,
+		}
+	}
+	return shelfBookDomains
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"gorm.io/gorm"
+)
+
+// defaultShelves are created for every user. A book stays on one of them at a time
+var defaultShelves = []struct {
+	Name string
+	Kind string
+}{
+	{Name: "Want to read", Kind: domain.ShelfKindWantToRead},
+	{Name: "Reading", Kind: domain.ShelfKindReading},
+	{Name: "Finished", Kind: domain.ShelfKindFinished},
+}
+
+type ShelfService interface {
+	GetShelves(ctx context.Context, userID uint) ([]domain.Shelf, error)
+	GetShelf(ctx context.Context, userID, shelfID uint) (*domain.Shelf, error)
+	GetSharedShelf(ctx context.Context, shareToken string) (*domain.Shelf, error)
+	CreateShelf(ctx context.Context, shelfDomain *domain.Shelf) error
+	UpdateShelf(ctx context.Context, shelfDomain *domain.Shelf) error
+	DeleteShelf(ctx context.Context, userID, shelfID uint) error
+	AddBookToShelf(ctx context.Context, userID, shelfID, bookID uint) error
+	RemoveBookFromShelf(ctx context.Context, userID, shelfID, bookID uint) error
+	ReorderShelfBooks(ctx context.Context, userID, shelfID uint, bookIDs []uint) error
+}
+
+type shelfService struct {
+	postgresDB                  *gorm.DB
+	postgresBookRepository      catalogPostgres.BookRepository
+	postgresShelfRepository     postgres.ShelfRepository
+	postgresShelfBookRepository postgres.ShelfBookRepository
+}
+
+func NewShelfService(
+	postgresDB *gorm.DB,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresShelfRepository postgres.ShelfRepository,
+	postgresShelfBookRepository postgres.ShelfBookRepository) ShelfService {
+	return &shelfService{
+		postgresDB:                  postgresDB,
+		postgresBookRepository:      postgresBookRepository,
+		postgresShelfRepository:     postgresShelfRepository,
+		postgresShelfBookRepository: postgresShelfBookRepository,
+	}
+}
+
+func (s *shelfService) GetShelves(ctx context.Context, userID uint) ([]domain.Shelf, error) {
+	defaultShelfModels := make([]model.Shelf, len(defaultShelves))
+	for i, defaultShelf := range defaultShelves {
+		defaultShelfModels[i] = model.Shelf{UserID: userID, Name: defaultShelf.Name, Kind: defaultShelf.Kind}
+	}
+	if err := s.postgresShelfRepository.CreateDefaults(ctx, defaultShelfModels); err != nil {
+		return nil, err
+	}
+
+	shelfWithBooksCountModels, err := s.postgresShelfRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.ShelfWithBooksCountModelsToDomains(shelfWithBooksCountModels), nil
+}
+
+func (s *shelfService) GetShelf(ctx context.Context, userID, shelfID uint) (*domain.Shelf, error) {
+	shelfModel, err := s.findOwnShelf(ctx, s.postgresShelfRepository, userID, shelfID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getShelfWithBooks(ctx, shelfModel)
+}
+
+func (s *shelfService) GetSharedShelf(ctx context.Context, shareToken string) (*domain.Shelf, error) {
+	shelfModel, err := s.postgresShelfRepository.FindByShareToken(ctx, shareToken)
+	if err != nil {
+		return nil, err
+	}
+	return s.getShelfWithBooks(ctx, shelfModel)
+}
+
+func (s *shelfService) CreateShelf(ctx context.Context, shelfDomain *domain.Shelf) error {
+	shelfModel := model.Shelf{
+		UserID:   shelfDomain.UserID,
+		Name:     shelfDomain.Name,
+		Kind:     domain.ShelfKindCustom,
+		IsPublic: shelfDomain.IsPublic,
+	}
+	setShareToken(&shelfModel)
+
+	if err := s.postgresShelfRepository.Create(ctx, &shelfModel); err != nil {
+		return err
+	}
+
+	*shelfDomain = postgresMapper.ShelfModelToDomain(&shelfModel)
+	return nil
+}
+
+// UpdateShelf renames custom shelves and switches visibility. Default shelves keep their names
+func (s *shelfService) UpdateShelf(ctx context.Context, shelfDomain *domain.Shelf) error {
+	shelfModel, err := s.findOwnShelf(ctx, s.postgresShelfRepository, shelfDomain.UserID, shelfDomain.ID)
+	if err != nil {
+		return err
+	}
+
+	if shelfModel.Kind != domain.ShelfKindCustom && shelfModel.Name != shelfDomain.Name {
+		return errs.NewBadRequestError("Default shelf can't be renamed")
+	}
+
+	shelfModel.Name = shelfDomain.Name
+	shelfModel.IsPublic = shelfDomain.IsPublic
+	setShareToken(shelfModel)
+
+	if err := s.postgresShelfRepository.Update(ctx, shelfModel); err != nil {
+		return err
+	}
+
+	*shelfDomain = postgresMapper.ShelfModelToDomain(shelfModel)
+	return nil
+}
+
+func (s *shelfService) DeleteShelf(ctx context.Context, userID, shelfID uint) error {
+	shelfModel, err := s.findOwnShelf(ctx, s.postgresShelfRepository, userID, shelfID)
+	if err != nil {
+		return err
+	}
+	if shelfModel.Kind != domain.ShelfKindCustom {
+		return errs.NewBadRequestError("Default shelf can't be deleted")
+	}
+	return s.postgresShelfRepository.Delete(ctx, shelfID)
+}
+
+// AddBookToShelf appends the book to the end of the shelf.
+// Adding a book to a default shelf removes it from the other default shelves
+func (s *shelfService) AddBookToShelf(ctx context.Context, userID, shelfID, bookID uint) error {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+		postgresShelfRepositoryTX := s.postgresShelfRepository.WithinTX(tx)
+		postgresShelfBookRepositoryTX := s.postgresShelfBookRepository.WithinTX(tx)
+
+		shelfModel, err := s.findOwnShelf(txCtx, postgresShelfRepositoryTX, userID, shelfID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := postgresBookRepositoryTX.FindByID(txCtx, bookID); err != nil {
+			return err
+		}
+
+		if shelfModel.Kind != domain.ShelfKindCustom {
+			defaultShelfKinds := make([]string, len(defaultShelves))
+			for i, defaultShelf := range defaultShelves {
+				defaultShelfKinds[i] = defaultShelf.Kind
+			}
+
+			defaultShelfIDs, err := postgresShelfRepositoryTX.GetIDsByUserIDAndKinds(txCtx, userID, defaultShelfKinds)
+			if err != nil {
+				return err
+			}
+			otherDefaultShelfIDs := slices.DeleteFunc(defaultShelfIDs, func(id uint) bool { return id == shelfID })
+			if err := postgresShelfBookRepositoryTX.DeleteFromShelves(txCtx, otherDefaultShelfIDs, bookID); err != nil {
+				return err
+			}
+		}
+
+		nextPosition, err := postgresShelfBookRepositoryTX.GetNextPosition(txCtx, shelfID)
+		if err != nil {
+			return err
+		}
+
+		return postgresShelfBookRepositoryTX.Create(txCtx, &model.ShelfBook{
+			ShelfID:  shelfID,
+			BookID:   bookID,
+			Position: nextPosition,
+		})
+	})
+}
+
+func (s *shelfService) RemoveBookFromShelf(ctx context.Context, userID, shelfID, bookID uint) error {
+	if _, err := s.findOwnShelf(ctx, s.postgresShelfRepository, userID, shelfID); err != nil {
+		return err
+	}
+	return s.postgresShelfBookRepository.Delete(ctx, shelfID, bookID)
+}
+
+// ReorderShelfBooks expects every book of the shelf exactly once, in the new order
+func (s *shelfService) ReorderShelfBooks(ctx context.Context, userID, shelfID uint, bookIDs []uint) error {
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresShelfRepositoryTX := s.postgresShelfRepository.WithinTX(tx)
+		postgresShelfBookRepositoryTX := s.postgresShelfBookRepository.WithinTX(tx)
+
+		if _, err := s.findOwnShelf(txCtx, postgresShelfRepositoryTX, userID, shelfID); err != nil {
+			return err
+		}
+
+		shelfBookIDs, err := postgresShelfBookRepositoryTX.GetBookIDs(txCtx, shelfID)
+		if err != nil {
+			return err
+		}
+
+		sortedBookIDs := slices.Sorted(slices.Values(bookIDs))
+		slices.Sort(shelfBookIDs)
+		if !slices.Equal(sortedBookIDs, shelfBookIDs) {
+			return errs.NewBadRequestError(fmt.Sprintf("Book IDs must list all %d books of the shelf exactly once", len(shelfBookIDs)))
+		}
+
+		for position, bookID := range bookIDs {
+			if err := postgresShelfBookRepositoryTX.UpdatePosition(txCtx, shelfID, bookID, uint(position)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *shelfService) getShelfWithBooks(ctx context.Context, shelfModel *model.Shelf) (*domain.Shelf, error) {
+	shelfBookWithBookModels, err := s.postgresShelfBookRepository.ListByShelfID(ctx, shelfModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	shelfDomain := postgresMapper.ShelfModelToDomain(shelfModel)
+	shelfDomain.Books = postgresMapper.ShelfBookWithBookModelsToDomains(shelfBookWithBookModels)
+	shelfDomain.BooksCount = uint(len(shelfDomain.Books))
+	return &shelfDomain, nil
+}
+
+// findOwnShelf hides shelves of other users behind not found error
+func (s *shelfService) findOwnShelf(ctx context.Context, postgresShelfRepository postgres.ShelfRepository, userID, shelfID uint) (*model.Shelf, error) {
+	shelfModel, err := postgresShelfRepository.FindByID(ctx, shelfID)
+	if err != nil {
+		return nil, err
+	}
+	if shelfModel.UserID != userID {
+		return nil, errs.NewEntityNotFoundError("Shelf(s)")
+	}
+	return shelfModel, nil
+}
+
+// setShareToken keeps a token while the shelf is public and drops it once the shelf becomes private,
+// so a link shared before stops working
+func setShareToken(shelfModel *model.Shelf) {
+	if !shelfModel.IsPublic {
+		shelfModel.ShareToken = nil
+		return
+	}
+	if shelfModel.ShareToken == nil {
+		shareToken := rand.Text()
+		shelfModel.ShareToken = &shareToken
+	}
+}
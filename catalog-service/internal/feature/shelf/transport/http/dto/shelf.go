@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+type Shelf struct {
+	ID         uint        `json:"id"`
+	Name       string      `json:"name"`
+	Kind       string      `json:"kind" enums:"want_to_read,reading,finished,custom"`
+	IsPublic   bool        `json:"isPublic"`
+	ShareToken string      `json:"shareToken,omitempty"`
+	BooksCount uint        `json:"booksCount"`
+	Books      []ShelfBook `json:"books,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+}
+
+type ShelfBook struct {
+	Book     dto.Book  `json:"book"`
+	Position uint      `json:"position"`
+	AddedAt  time.Time `json:"addedAt"`
+}
+
+type SaveShelfRequest struct {
+	Name     string `json:"name" binding:"required,max=100"`
+	IsPublic bool   `json:"isPublic"`
+}
+
+type AddShelfBookRequest struct {
+	BookID uint `json:"bookId" binding:"required,min=1"`
+}
+
+type ReorderShelfBooksRequest struct {
+	BookIDs []uint `json:"bookIds" binding:"required,dive,min=1"`
+}
@@ -0,0 +1,452 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+type ShelfHandler interface {
+	GetShelves(c *gin.Context)
+	GetShelf(c *gin.Context)
+	GetSharedShelf(c *gin.Context)
+	CreateShelf(c *gin.Context)
+	UpdateShelf(c *gin.Context)
+	DeleteShelf(c *gin.Context)
+	AddBookToShelf(c *gin.Context)
+	RemoveBookFromShelf(c *gin.Context)
+	ReorderShelfBooks(c *gin.Context)
+}
+
+type shelfHandler struct {
+	config       *config.Config
+	logger       *logging.Logger
+	shelfService service.ShelfService
+}
+
+func NewShelfHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	shelfService service.ShelfService,
+) ShelfHandler {
+	return &shelfHandler{
+		config:       config,
+		logger:       logger,
+		shelfService: shelfService,
+	}
+}
+
+// GetShelves godoc
+//
+//	@Summary		Get own shelves
+//	@Description	Returns shelves of the authorized user with books count. "Want to read", "Reading" and "Finished" shelves are always present
+//	@Tags			shelf
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Shelf
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/shelves [get]
+func (h *shelfHandler) GetShelves(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetShelves")
+	defer span.End()
+
+	shelfDomains, err := h.shelfService.GetShelves(ctx, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get shelves error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ShelfDomainsToDTOs(shelfDomains))
+}
+
+// GetShelf godoc
+//
+//	@Summary		Get own shelf
+//	@Description	Returns a shelf of the authorized user with its books in shelf order
+//	@Tags			shelf
+//	@Param			shelfID	path	uint	true	"Shelf ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Shelf
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/{shelfID} [get]
+func (h *shelfHandler) GetShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	shelfIDString := c.Param("shelfID")
+	shelfID, err := strconv.ParseUint(shelfIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetShelf")
+	defer span.End()
+
+	shelfDomain, err := h.shelfService.GetShelf(ctx, uint(userID), uint(shelfID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ShelfDomainToDTO(shelfDomain))
+}
+
+// GetSharedShelf godoc
+//
+//	@Summary		Get shared shelf
+//	@Description	Returns a public shelf with its books by the share token. The link stops working once the owner makes the shelf private
+//	@Tags			shelf
+//	@Param			shareToken	path	string	true	"Share token"
+//	@Produce		json
+//	@Success		200	{object}	dto.Shelf
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/shared/{shareToken} [get]
+func (h *shelfHandler) GetSharedShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	shareToken := c.Param("shareToken")
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetSharedShelf")
+	defer span.End()
+
+	shelfDomain, err := h.shelfService.GetSharedShelf(ctx, shareToken)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get shared shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ShelfDomainToDTO(shelfDomain))
+}
+
+// CreateShelf godoc
+//
+//	@Summary		Create custom shelf
+//	@Description	Creates a named shelf of the authorized user. A public shelf gets a share token for a read-only link
+//	@Tags			shelf
+//	@Param			shelf	body	dto.SaveShelfRequest	true	"Shelf info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	dto.Shelf
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/shelves [post]
+func (h *shelfHandler) CreateShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var saveShelfRequestDTO dto.SaveShelfRequest
+	if err := c.ShouldBindJSON(&saveShelfRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.CreateShelf")
+	defer span.End()
+
+	shelfDomain := mapper.SaveShelfRequestDTOToDomain(uint(userID), 0, &saveShelfRequestDTO)
+	if err := h.shelfService.CreateShelf(ctx, &shelfDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Create shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ShelfDomainToDTO(&shelfDomain))
+}
+
+// UpdateShelf godoc
+//
+//	@Summary		Update own shelf
+//	@Description	Renames a custom shelf and changes visibility of any own shelf. Making a shelf private revokes its share link
+//	@Tags			shelf
+//	@Param			shelfID	path	uint					true	"Shelf ID"
+//	@Param			shelf	body	dto.SaveShelfRequest	true	"Shelf info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Shelf
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/{shelfID} [put]
+func (h *shelfHandler) UpdateShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	shelfIDString := c.Param("shelfID")
+	shelfID, err := strconv.ParseUint(shelfIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var saveShelfRequestDTO dto.SaveShelfRequest
+	if err := c.ShouldBindJSON(&saveShelfRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UpdateShelf")
+	defer span.End()
+
+	shelfDomain := mapper.SaveShelfRequestDTOToDomain(uint(userID), uint(shelfID), &saveShelfRequestDTO)
+	if err := h.shelfService.UpdateShelf(ctx, &shelfDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Update shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ShelfDomainToDTO(&shelfDomain))
+}
+
+// DeleteShelf godoc
+//
+//	@Summary		Delete own custom shelf
+//	@Description	Deletes a custom shelf of the authorized user. Default shelves can't be deleted
+//	@Tags			shelf
+//	@Param			shelfID	path	uint	true	"Shelf ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/{shelfID} [delete]
+func (h *shelfHandler) DeleteShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	shelfIDString := c.Param("shelfID")
+	shelfID, err := strconv.ParseUint(shelfIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteShelf")
+	defer span.End()
+
+	if err := h.shelfService.DeleteShelf(ctx, uint(userID), uint(shelfID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// AddBookToShelf godoc
+//
+//	@Summary		Add book to own shelf
+//	@Description	Appends a book to the end of the shelf. Putting a book on "Want to read", "Reading" or "Finished" takes it off the other two
+//	@Tags			shelf
+//	@Param			shelfID	path	uint					true	"Shelf ID"
+//	@Param			book	body	dto.AddShelfBookRequest	true	"Book info"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		409 {object}	dto.Error "Entity already exists"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/{shelfID}/books [post]
+func (h *shelfHandler) AddBookToShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	shelfIDString := c.Param("shelfID")
+	shelfID, err := strconv.ParseUint(shelfIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var addShelfBookRequestDTO dto.AddShelfBookRequest
+	if err := c.ShouldBindJSON(&addShelfBookRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.AddBookToShelf")
+	defer span.End()
+
+	if err := h.shelfService.AddBookToShelf(ctx, uint(userID), uint(shelfID), addShelfBookRequestDTO.BookID); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Add book to shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// RemoveBookFromShelf godoc
+//
+//	@Summary		Remove book from own shelf
+//	@Tags			shelf
+//	@Param			shelfID	path	uint	true	"Shelf ID"
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/{shelfID}/books/{bookID} [delete]
+func (h *shelfHandler) RemoveBookFromShelf(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	shelfIDString := c.Param("shelfID")
+	shelfID, err := strconv.ParseUint(shelfIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.RemoveBookFromShelf")
+	defer span.End()
+
+	if err := h.shelfService.RemoveBookFromShelf(ctx, uint(userID), uint(shelfID), uint(bookID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Remove book from shelf error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// ReorderShelfBooks godoc
+//
+//	@Summary		Reorder books on own shelf
+//	@Description	Sets the order of books on the shelf. Every book of the shelf must be listed exactly once
+//	@Tags			shelf
+//	@Param			shelfID	path	uint							true	"Shelf ID"
+//	@Param			order	body	dto.ReorderShelfBooksRequest	true	"Book IDs in the new order"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/shelves/{shelfID}/books/order [put]
+func (h *shelfHandler) ReorderShelfBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	shelfIDString := c.Param("shelfID")
+	shelfID, err := strconv.ParseUint(shelfIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var reorderShelfBooksRequestDTO dto.ReorderShelfBooksRequest
+	if err := c.ShouldBindJSON(&reorderShelfBooksRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ReorderShelfBooks")
+	defer span.End()
+
+	if err := h.shelfService.ReorderShelfBooks(ctx, uint(userID), uint(shelfID), reorderShelfBooksRequestDTO.BookIDs); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Reorder shelf books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/transport/http/dto"
+)
+
+func ShelfDomainToDTO(shelfDomain *domain.Shelf) dto.Shelf {
+	shelfDTO := dto.Shelf{
+		ID:         shelfDomain.ID,
+		Name:       shelfDomain.Name,
+		Kind:       shelfDomain.Kind,
+		IsPublic:   shelfDomain.IsPublic,
+		ShareToken: shelfDomain.ShareToken,
+		BooksCount: shelfDomain.BooksCount,
+		CreatedAt:  shelfDomain.CreatedAt,
+		UpdatedAt:  shelfDomain.UpdatedAt,
+	}
+	if shelfDomain.Books != nil {
+		shelfDTO.Books = ShelfBookDomainsToDTOs(shelfDomain.Books)
+	}
+	return shelfDTO
+}
+
+func ShelfDomainsToDTOs(shelfDomains []domain.Shelf) []dto.Shelf {
+	shelfDTOs := make([]dto.Shelf, len(shelfDomains))
+	for i := range shelfDomains {
+		shelfDTOs[i] = ShelfDomainToDTO(&shelfDomains[i])
+	}
+	return shelfDTOs
+}
+
+func ShelfBookDomainsToDTOs(shelfBookDomains []domain.ShelfBook) []dto.ShelfBook {
+	shelfBookDTOs := make([]dto.ShelfBook, len(shelfBookDomains))
+	for i := range shelfBookDomains {
+		shelfBookDTOs[i] = dto.ShelfBook{
+			Book:     catalogMapper.BookDomainToDTO(&shelfBookDomains[i].Book),
+			Position: shelfBookDomains[i].Position,
+			AddedAt:  shelfBookDomains[i].AddedAt,
+		}
+	}
+	return shelfBookDTOs
+}
+
+func SaveShelfRequestDTOToDomain(userID, shelfID uint, saveShelfRequestDTO *dto.SaveShelfRequest) domain.Shelf {
+	return domain.Shelf{
+		ID:       shelfID,
+		UserID:   userID,
+		Name:     saveShelfRequestDTO.Name,
+		IsPublic: saveShelfRequestDTO.IsPublic,
+	}
+}
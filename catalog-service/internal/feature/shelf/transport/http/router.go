@@ -0,0 +1,23 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts shelf routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, shelfHandler ShelfHandler) {
+	shelfGroup := r.Group(sharedRoute.CATALOG + route.SHELVES)
+	{
+		shelfGroup.GET("", shelfHandler.GetShelves)
+		shelfGroup.POST("", shelfHandler.CreateShelf)
+		shelfGroup.GET(route.SHARED+"/:shareToken", shelfHandler.GetSharedShelf)
+		shelfGroup.GET("/:shelfID", shelfHandler.GetShelf)
+		shelfGroup.PUT("/:shelfID", shelfHandler.UpdateShelf)
+		shelfGroup.DELETE("/:shelfID", shelfHandler.DeleteShelf)
+		shelfGroup.POST("/:shelfID"+sharedRoute.BOOKS, shelfHandler.AddBookToShelf)
+		shelfGroup.PUT("/:shelfID"+sharedRoute.BOOKS+route.ORDER, shelfHandler.ReorderShelfBooks)
+		shelfGroup.DELETE("/:shelfID"+sharedRoute.BOOKS+"/:bookID", shelfHandler.RemoveBookFromShelf)
+	}
+}
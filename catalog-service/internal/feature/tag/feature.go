@@ -0,0 +1,24 @@
+package tag
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/transport/http"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Register wires the tag feature into the HTTP router of the catalog feature
+func Register(config *config.Config, logger *logging.Logger, postgresDB *gorm.DB, httpRouter *gin.Engine, bookTaggedWriter *kafkaInfrastructure.OtelWriter) {
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresTagRepository := postgres.NewTagRepository(postgresDB)
+
+	tagService := service.NewTagService(logger, postgresDB, bookTaggedWriter, postgresBookRepository, postgresTagRepository)
+
+	httpTagHandler := httpTransport.NewTagHandler(config, logger, tagService)
+	httpTransport.RegisterRoutes(httpRouter, httpTagHandler)
+}
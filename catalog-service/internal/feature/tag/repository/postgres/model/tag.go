@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+)
+
+type Tag struct {
+	ID        uint   `gorm:"primarykey"`
+	Name      string `gorm:"not null;uniqueIndex"`
+	CreatedAt time.Time
+}
+
+type BookTag struct {
+	BookID uint              `gorm:"primaryKey;autoIncrement:false"`
+	TagID  uint              `gorm:"primaryKey;autoIncrement:false;index"`
+	Book   catalogModel.Book `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Tag    Tag               `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type TagWithBooksCount struct {
+	Tag
+	BooksCount uint
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagRepository interface {
+	WithinTX(tx *gorm.DB) TagRepository
+	FindByName(ctx context.Context, name string) (*model.TagWithBooksCount, error)
+	ListPopular(ctx context.Context, count uint) ([]model.TagWithBooksCount, error)
+	ListByBookID(ctx context.Context, bookID uint) ([]model.TagWithBooksCount, error)
+	CreateMissing(ctx context.Context, names []string) ([]model.Tag, error)
+	ReplaceBookTags(ctx context.Context, bookID uint, tagIDs []uint) error
+	DeleteUnused(ctx context.Context) error
+}
+
+type tagRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{name: "Tag(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *tagRepository) WithinTX(tx *gorm.DB) TagRepository {
+	return &tagRepository{name: "Tag(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *tagRepository) FindByName(ctx context.Context, name string) (*model.TagWithBooksCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var tag model.TagWithBooksCount
+	err := r.buildTagWithBooksCountQuery(ctx).
+		Where("tags.name = ?", name).
+		First(&tag).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &tag, nil
+}
+
+// ListPopular returns tags used by the most books first, ties are broken by name
+func (r *tagRepository) ListPopular(ctx context.Context, count uint) ([]model.TagWithBooksCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var tags []model.TagWithBooksCount
+	err := r.db.WithContext(ctx).
+		Model(&model.Tag{}).
+		Select("tags.*, COUNT(book_tags.book_id) AS books_count").
+		Joins("INNER JOIN book_tags ON book_tags.tag_id = tags.id").
+		Joins("INNER JOIN books ON book_tags.book_id = books.id AND books.deleted_at IS NULL").
+		Group("tags.id").
+		Order("books_count DESC, tags.name ASC").
+		Limit(int(count)).
+		Scan(&tags).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return tags, nil
+}
+
+func (r *tagRepository) ListByBookID(ctx context.Context, bookID uint) ([]model.TagWithBooksCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var tags []model.TagWithBooksCount
+	err := r.buildTagWithBooksCountQuery(ctx).
+		Where("tags.id IN (SELECT tag_id FROM book_tags WHERE book_id = ?)", bookID).
+		Order("tags.name").
+		Scan(&tags).Error
+	if err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return tags, nil
+}
+
+// CreateMissing creates tags which don't exist yet and returns all tags with the given names
+func (r *tagRepository) CreateMissing(ctx context.Context, names []string) ([]model.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if len(names) == 0 {
+		return []model.Tag{}, nil
+	}
+
+	db := r.db.WithContext(ctx)
+
+	tags := make([]model.Tag, len(names))
+	for i, name := range names {
+		tags[i] = model.Tag{Name: name}
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+
+	var existingTags []model.Tag
+	if err := db.Where("name IN ?", names).Order("name").Find(&existingTags).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return existingTags, nil
+}
+
+func (r *tagRepository) ReplaceBookTags(ctx context.Context, bookID uint, tagIDs []uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	db := r.db.WithContext(ctx)
+	if err := db.Where("book_id = ?", bookID).Delete(&model.BookTag{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	bookTags := make([]model.BookTag, len(tagIDs))
+	for i, tagID := range tagIDs {
+		bookTags[i] = model.BookTag{BookID: bookID, TagID: tagID}
+	}
+	if err := db.Omit("Book", "Tag").Create(&bookTags).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// DeleteUnused removes tags which aren't attached to any book, so typos don't pile up
+func (r *tagRepository) DeleteUnused(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.WithContext(ctx).
+		Where("NOT EXISTS (SELECT 1 FROM book_tags WHERE book_tags.tag_id = tags.id)").
+		Delete(&model.Tag{}).Error
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *tagRepository) buildTagWithBooksCountQuery(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&model.Tag{}).
+		Select(`tags.*, (
+			SELECT COUNT(*) FROM book_tags
+			INNER JOIN books ON book_tags.book_id = books.id AND books.deleted_at IS NULL
+			WHERE book_tags.tag_id = tags.id
+		) AS books_count`)
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/repository/postgres/model"
+)
+
+func TagWithBooksCountModelToDomain(tagModel *model.TagWithBooksCount) domain.Tag {
+	return domain.Tag{
+		ID:         tagModel.ID,
+		Name:       tagModel.Name,
+		BooksCount: tagModel.BooksCount,
+	}
+}
+
+func TagWithBooksCountModelsToDomains(tagModels []model.TagWithBooksCount) []domain.Tag {
+	tagDomains := make([]domain.Tag, len(tagModels))
+	for i := range tagModels {
+		tagDomains[i] = TagWithBooksCountModelToDomain(&tagModels[i])
+	}
+	return tagDomains
+}
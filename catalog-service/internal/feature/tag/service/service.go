@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/repository/postgres/model"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/service/mapper/postgres"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+)
+
+type TagService interface {
+	GetTag(ctx context.Context, tagName string) (*domain.Tag, error)
+	GetPopularTags(ctx context.Context, count uint) ([]domain.Tag, error)
+	GetBookTags(ctx context.Context, bookID uint) ([]domain.Tag, error)
+	SetBookTags(ctx context.Context, bookID uint, tagNames []string) ([]domain.Tag, error)
+}
+
+type tagService struct {
+	logger                 *logging.Logger
+	postgresDB             *gorm.DB
+	bookTaggedWriter       *kafkaInfrastructure.OtelWriter
+	postgresBookRepository catalogPostgres.BookRepository
+	postgresTagRepository  postgres.TagRepository
+}
+
+func NewTagService(
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	bookTaggedWriter *kafkaInfrastructure.OtelWriter,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresTagRepository postgres.TagRepository) TagService {
+	return &tagService{
+		logger:                 logger,
+		postgresDB:             postgresDB,
+		bookTaggedWriter:       bookTaggedWriter,
+		postgresBookRepository: postgresBookRepository,
+		postgresTagRepository:  postgresTagRepository,
+	}
+}
+
+func (s *tagService) GetTag(ctx context.Context, tagName string) (*domain.Tag, error) {
+	tagModel, err := s.postgresTagRepository.FindByName(ctx, domain.NormalizeTagName(tagName))
+	if err != nil {
+		return nil, err
+	}
+
+	tagDomain := postgresMapper.TagWithBooksCountModelToDomain(tagModel)
+	return &tagDomain, nil
+}
+
+func (s *tagService) GetPopularTags(ctx context.Context, count uint) ([]domain.Tag, error) {
+	tagModels, err := s.postgresTagRepository.ListPopular(ctx, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.TagWithBooksCountModelsToDomains(tagModels), nil
+}
+
+func (s *tagService) GetBookTags(ctx context.Context, bookID uint) ([]domain.Tag, error) {
+	if _, err := s.postgresBookRepository.FindByID(ctx, bookID); err != nil {
+		return nil, err
+	}
+
+	tagModels, err := s.postgresTagRepository.ListByBookID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.TagWithBooksCountModelsToDomains(tagModels), nil
+}
+
+func (s *tagService) SetBookTags(ctx context.Context, bookID uint, tagNames []string) ([]domain.Tag, error) {
+	normalizedTagNames, err := normalizeTagNames(tagNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		bookWithAuthorModel *catalogModel.BookWithAuthor
+		tagModels           []model.TagWithBooksCount
+		addedTagNames       []string
+	)
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresTagRepositoryTX := s.postgresTagRepository.WithinTX(tx)
+
+		var err error
+		bookWithAuthorModel, err = s.postgresBookRepository.WithinTX(tx).FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+
+		previousTagModels, err := postgresTagRepositoryTX.ListByBookID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		for _, normalizedTagName := range normalizedTagNames {
+			if !slices.ContainsFunc(previousTagModels, func(previousTagModel model.TagWithBooksCount) bool {
+				return previousTagModel.Name == normalizedTagName
+			}) {
+				addedTagNames = append(addedTagNames, normalizedTagName)
+			}
+		}
+
+		createdTagModels, err := postgresTagRepositoryTX.CreateMissing(txCtx, normalizedTagNames)
+		if err != nil {
+			return err
+		}
+
+		tagIDs := make([]uint, len(createdTagModels))
+		for i := range createdTagModels {
+			tagIDs[i] = createdTagModels[i].ID
+		}
+		if err := postgresTagRepositoryTX.ReplaceBookTags(txCtx, bookID, tagIDs); err != nil {
+			return err
+		}
+		if err := postgresTagRepositoryTX.DeleteUnused(txCtx); err != nil {
+			return err
+		}
+
+		tagModels, err = postgresTagRepositoryTX.ListByBookID(txCtx, bookID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addedTagNames) > 0 {
+		s.writeBookTaggedEvent(ctx, bookWithAuthorModel, addedTagNames)
+	}
+	return postgresMapper.TagWithBooksCountModelsToDomains(tagModels), nil
+}
+
+func (s *tagService) writeBookTaggedEvent(ctx context.Context, bookWithAuthorModel *catalogModel.BookWithAuthor, addedTagNames []string) {
+	bookTaggedEvent, err := json.Marshal(event.BookTagged{
+		ID:             bookWithAuthorModel.ID,
+		AuthorID:       bookWithAuthorModel.AuthorID,
+		AuthorFullname: bookWithAuthorModel.AuthorFullname,
+		Title:          bookWithAuthorModel.Title,
+		Year:           bookWithAuthorModel.Year,
+		Category:       bookWithAuthorModel.Category,
+		Tags:           addedTagNames,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Book tagged event marshal error", logging.Error(err))
+		return
+	}
+
+	kafkaCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := s.bookTaggedWriter.WriteMessages(kafkaCtx, kafka.Message{Value: bookTaggedEvent}); err != nil {
+		s.logger.Error(ctx, "Book tagged event write error", logging.Error(err))
+	}
+}
+
+func normalizeTagNames(tagNames []string) ([]string, error) {
+	normalizedTagNames := make([]string, 0, len(tagNames))
+	for _, tagName := range tagNames {
+		normalizedTagName := domain.NormalizeTagName(tagName)
+		if normalizedTagName == "" {
+			return nil, errs.NewBadRequestError("Tag must contain at least one letter or digit")
+		}
+		if !slices.Contains(normalizedTagNames, normalizedTagName) {
+			normalizedTagNames = append(normalizedTagNames, normalizedTagName)
+		}
+	}
+	return normalizedTagNames, nil
+}
@@ -0,0 +1,11 @@
+package dto
+
+type Tag struct {
+	ID         uint   `json:"id"`
+	Name       string `json:"name"`
+	BooksCount uint   `json:"booksCount"`
+}
+
+type SetBookTagsRequest struct {
+	Tags []string `json:"tags" binding:"required,max=20,dive,required,max=50"`
+}
@@ -0,0 +1,189 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/transport/http/dto"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler interface {
+	GetTag(c *gin.Context)
+	GetPopularTags(c *gin.Context)
+	GetBookTags(c *gin.Context)
+	SetBookTags(c *gin.Context)
+}
+
+type tagHandler struct {
+	config     *config.Config
+	logger     *logging.Logger
+	tagService service.TagService
+}
+
+func NewTagHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	tagService service.TagService,
+) TagHandler {
+	return &tagHandler{
+		config:     config,
+		logger:     logger,
+		tagService: tagService,
+	}
+}
+
+// GetTag godoc
+//
+//	@Summary		Get a tag
+//	@Description	Returns a tag with the number of tagged books. The name is normalized the same way as on tagging
+//	@Tags			tag
+//	@Param			tagName	path	string	true	"Tag name"
+//	@Produce		json
+//	@Success		200	{object}	dto.Tag
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/tags/{tagName} [get]
+func (h *tagHandler) GetTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tagName := c.Param("tagName")
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetTag")
+	defer span.End()
+
+	tagDomain, err := h.tagService.GetTag(ctx, tagName)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get tag error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TagDomainToDTO(tagDomain))
+}
+
+// GetPopularTags godoc
+//
+//	@Summary		Get popular tags
+//	@Description	Returns tags used by the most books with their counts, suitable for a tag cloud
+//	@Tags			tag
+//	@Param			count	query	int	false	"Number of tags (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Success		200	{array}		dto.Tag
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/tags/popular [get]
+func (h *tagHandler) GetPopularTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.GetPopularTags
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetPopularTags")
+	defer span.End()
+
+	tagDomains, err := h.tagService.GetPopularTags(ctx, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get popular tags error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TagDomainsToDTOs(tagDomains))
+}
+
+// GetBookTags godoc
+//
+//	@Summary		Get book tags
+//	@Description	Returns tags of a book ordered by name
+//	@Tags			tag
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Success		200	{array}		dto.Tag
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/tags [get]
+func (h *tagHandler) GetBookTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetBookTags")
+	defer span.End()
+
+	tagDomains, err := h.tagService.GetBookTags(ctx, uint(bookID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get book tags error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TagDomainsToDTOs(tagDomains))
+}
+
+// SetBookTags godoc
+//
+//	@Summary		Set book tags
+//	@Description	Replaces tags of a book. Tags are lowercased and their words are joined with dashes, unknown tags are created
+//	@Tags			tag
+//	@Param			bookID	path	uint					true	"Book ID"
+//	@Param			tags	body	dto.SetBookTagsRequest	true	"Book tags, an empty list removes all tags"
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Tag
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/books/{bookID}/tags [put]
+func (h *tagHandler) SetBookTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var setBookTagsRequestDTO dto.SetBookTagsRequest
+	if err := c.ShouldBindJSON(&setBookTagsRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SetBookTags")
+	defer span.End()
+
+	tagDomains, err := h.tagService.SetBookTags(ctx, uint(bookID), setBookTagsRequestDTO.Tags)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Set book tags error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TagDomainsToDTOs(tagDomains))
+}
@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/transport/http/dto"
+)
+
+func TagDomainToDTO(tagDomain *domain.Tag) dto.Tag {
+	return dto.Tag{
+		ID:         tagDomain.ID,
+		Name:       tagDomain.Name,
+		BooksCount: tagDomain.BooksCount,
+	}
+}
+
+func TagDomainsToDTOs(tagDomains []domain.Tag) []dto.Tag {
+	tagDTOs := make([]dto.Tag, len(tagDomains))
+	for i := range tagDomains {
+		tagDTOs[i] = TagDomainToDTO(&tagDomains[i])
+	}
+	return tagDTOs
+}
@@ -0,0 +1,5 @@
+package query
+
+type GetPopularTags struct {
+	Count uint `form:"count,default=20" binding:"min=1,max=100"`
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts tag routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, tagHandler TagHandler) {
+	tagGroup := r.Group(sharedRoute.CATALOG + route.TAGS)
+	{
+		tagGroup.GET(sharedRoute.POPULAR, tagHandler.GetPopularTags)
+		tagGroup.GET("/:tagName", tagHandler.GetTag)
+	}
+
+	bookTagGroup := r.Group(sharedRoute.CATALOG + sharedRoute.BOOKS + "/:bookID" + route.TAGS)
+	{
+		bookTagGroup.GET("", tagHandler.GetBookTags)
+		bookTagGroup.PUT("", tagHandler.SetBookTags)
+	}
+}
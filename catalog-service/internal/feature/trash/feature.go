@@ -0,0 +1,47 @@
+package trash
+
+import (
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	catalogRedis "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	downloadPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/service"
+	httpTransport "github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/transport/http"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// Register wires the trash of deleted books and authors into the HTTP router of the catalog feature.
+// The returned job purges expired items and has to be run by the container
+func Register(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	redisClient *redis.Client,
+	blobStorage blob.Storage,
+	httpRouter *gin.Engine,
+	bookChangedWriter *kafkaInfrastructure.BookChangedWriter,
+) job.Job {
+	redisBookRepository := catalogRedis.NewBookRepository(redisClient)
+	postgresAuthorRepository := catalogPostgres.NewAuthorRepository(postgresDB)
+	postgresBookRepository := catalogPostgres.NewBookRepository(postgresDB)
+	postgresBookContributorRepository := catalogPostgres.NewBookContributorRepository(postgresDB)
+	postgresRevisionRepository := catalogPostgres.NewRevisionRepository(postgresDB)
+	postgresBookFileRepository := downloadPostgres.NewBookFileRepository(postgresDB)
+
+	trashService := service.NewTrashService(
+		logger, postgresDB, blobStorage, config.TrashRetentionPeriod, bookChangedWriter,
+		redisBookRepository, postgresAuthorRepository, postgresBookRepository, postgresBookContributorRepository,
+		postgresRevisionRepository, postgresBookFileRepository,
+	)
+
+	httpTrashHandler := httpTransport.NewTrashHandler(config, logger, trashService)
+	httpTransport.RegisterRoutes(httpRouter, httpTrashHandler)
+
+	return job.NewJob(config, logger, trashService)
+}
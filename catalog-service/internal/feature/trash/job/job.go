@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+)
+
+// Job periodically purges books and authors which stayed in the trash longer than the retention period
+type Job interface {
+	Run(ctx context.Context)
+}
+
+type job struct {
+	config       *config.Config
+	logger       *logging.Logger
+	trashService service.TrashService
+}
+
+func NewJob(config *config.Config, logger *logging.Logger, trashService service.TrashService) Job {
+	return &job{
+		config:       config,
+		logger:       logger,
+		trashService: trashService,
+	}
+}
+
+// Run blocks until ctx is cancelled. The first pass starts right away to catch up after a restart
+func (j *job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.config.TrashPurgeJobInterval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *job) runOnce(ctx context.Context) {
+	ctx, span := tracing.Span(ctx, j.config.ServiceName, "job.TrashPurge")
+	defer span.End()
+
+	if err := j.trashService.PurgeExpired(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		tracing.Error(span, err)
+		j.logger.Error(ctx, "Purge expired trash error", logging.Error(err))
+	}
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+)
+
+func DeletedBookWithAuthorModelsToDomains(deletedBookWithAuthorModels []model.DeletedBookWithAuthor, retentionPeriod time.Duration) []domain.TrashedBook {
+	trashedBookDomains := make([]domain.TrashedBook, len(deletedBookWithAuthorModels))
+	for i := range deletedBookWithAuthorModels {
+		trashedBookDomains[i] = domain.TrashedBook{
+			Book:      catalogMapper.BookWithAuthorModelToDomain(&deletedBookWithAuthorModels[i].BookWithAuthor),
+			DeletedAt: deletedBookWithAuthorModels[i].DeletedAt,
+			PurgeAt:   deletedBookWithAuthorModels[i].DeletedAt.Add(retentionPeriod),
+		}
+	}
+	return trashedBookDomains
+}
+
+func DeletedAuthorModelsToDomains(authorModels []model.Author, retentionPeriod time.Duration) []domain.TrashedAuthor {
+	trashedAuthorDomains := make([]domain.TrashedAuthor, len(authorModels))
+	for i := range authorModels {
+		deletedAt := authorModels[i].DeletedAt.Time
+		trashedAuthorDomains[i] = domain.TrashedAuthor{
+			Author:    catalogMapper.AuthorModelToDomain(&authorModels[i]),
+			DeletedAt: deletedAt,
+			PurgeAt:   deletedAt.Add(retentionPeriod),
+		}
+	}
+	return trashedAuthorDomains
+}
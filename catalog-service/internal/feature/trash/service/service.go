@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	catalogRedis "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/redis"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/service/mapper/postgres"
+	downloadPostgres "github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres"
+	postgresMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/service/mapper/postgres"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
+	"gorm.io/gorm"
+)
+
+const PURGE_BATCH_SIZE = 100
+
+type TrashService interface {
+	ListTrashedBooks(ctx context.Context, page, count uint) ([]domain.TrashedBook, error)
+	ListTrashedAuthors(ctx context.Context, page, count uint) ([]domain.TrashedAuthor, error)
+	RestoreBook(ctx context.Context, bookID, userID uint) (*domain.Book, error)
+	RestoreAuthor(ctx context.Context, authorID, userID uint) (*domain.Author, error)
+	PurgeExpired(ctx context.Context) error
+}
+
+type trashService struct {
+	logger                            *logging.Logger
+	postgresDB                        *gorm.DB
+	blobStorage                       blob.Storage
+	retentionPeriod                   time.Duration
+	bookChangedWriter                 *kafkaInfrastructure.BookChangedWriter
+	redisBookRepository               catalogRedis.BookRepository
+	postgresAuthorRepository          catalogPostgres.AuthorRepository
+	postgresBookRepository            catalogPostgres.BookRepository
+	postgresBookContributorRepository catalogPostgres.BookContributorRepository
+	postgresRevisionRepository        catalogPostgres.RevisionRepository
+	postgresBookFileRepository        downloadPostgres.BookFileRepository
+}
+
+func NewTrashService(
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	blobStorage blob.Storage,
+	retentionPeriod time.Duration,
+	bookChangedWriter *kafkaInfrastructure.BookChangedWriter,
+	redisBookRepository catalogRedis.BookRepository,
+	postgresAuthorRepository catalogPostgres.AuthorRepository,
+	postgresBookRepository catalogPostgres.BookRepository,
+	postgresBookContributorRepository catalogPostgres.BookContributorRepository,
+	postgresRevisionRepository catalogPostgres.RevisionRepository,
+	postgresBookFileRepository downloadPostgres.BookFileRepository,
+) TrashService {
+	return &trashService{
+		logger:                            logger,
+		postgresDB:                        postgresDB,
+		blobStorage:                       blobStorage,
+		retentionPeriod:                   retentionPeriod,
+		bookChangedWriter:                 bookChangedWriter,
+		redisBookRepository:               redisBookRepository,
+		postgresAuthorRepository:          postgresAuthorRepository,
+		postgresBookRepository:            postgresBookRepository,
+		postgresBookContributorRepository: postgresBookContributorRepository,
+		postgresRevisionRepository:        postgresRevisionRepository,
+		postgresBookFileRepository:        postgresBookFileRepository,
+	}
+}
+
+func (s *trashService) ListTrashedBooks(ctx context.Context, page, count uint) ([]domain.TrashedBook, error) {
+	deletedBookWithAuthorModels, err := s.postgresBookRepository.ListDeleted(ctx, page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.DeletedBookWithAuthorModelsToDomains(deletedBookWithAuthorModels, s.retentionPeriod), nil
+}
+
+func (s *trashService) ListTrashedAuthors(ctx context.Context, page, count uint) ([]domain.TrashedAuthor, error) {
+	authorModels, err := s.postgresAuthorRepository.ListDeleted(ctx, page, count)
+	if err != nil {
+		return nil, err
+	}
+	return postgresMapper.DeletedAuthorModelsToDomains(authorModels, s.retentionPeriod), nil
+}
+
+// RestoreBook brings the book back to the catalog, its primary author has to be restored first
+func (s *trashService) RestoreBook(ctx context.Context, bookID, userID uint) (*domain.Book, error) {
+	deletedBookWithAuthorModel, err := s.postgresBookRepository.FindDeletedByID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.postgresAuthorRepository.FindByID(ctx, deletedBookWithAuthorModel.AuthorID); err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.NewBadRequestError("The author of the book is in the trash, restore the author first")
+		}
+		return nil, err
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var bookWithAuthorModel *model.BookWithAuthor
+	err = s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		if err := postgresBookRepositoryTX.Restore(txCtx, bookID); err != nil {
+			return restoreBookConflictError(err)
+		}
+
+		var err error
+		bookWithAuthorModel, err = postgresBookRepositoryTX.FindByID(txCtx, bookID)
+		if err != nil {
+			return err
+		}
+		return s.recordBookRestore(txCtx, tx, &deletedBookWithAuthorModel.BookWithAuthor, bookWithAuthorModel, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.bookChangedWriter.Write(ctx, event.BookChangeRestored, []uint{bookID})
+
+	bookDomain := catalogMapper.BookWithAuthorModelToDomain(bookWithAuthorModel)
+	return &bookDomain, nil
+}
+
+// RestoreAuthor brings the author back together with the books trashed along with them
+func (s *trashService) RestoreAuthor(ctx context.Context, authorID, userID uint) (*domain.Author, error) {
+	authorModel, err := s.postgresAuthorRepository.FindDeletedByID(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var (
+		restoredAuthorModel *model.Author
+		restoredBookIDs     []uint
+		contributedBookIDs  []uint
+	)
+	err = s.postgresDB.WithContext(txCtx).Transaction(func(tx *gorm.DB) error {
+		postgresAuthorRepositoryTX := s.postgresAuthorRepository.WithinTX(tx)
+		postgresBookRepositoryTX := s.postgresBookRepository.WithinTX(tx)
+
+		deletedBookWithAuthorModels, err := postgresBookRepositoryTX.ListDeletedByAuthorID(txCtx, authorID)
+		if err != nil {
+			return err
+		}
+
+		if err := postgresAuthorRepositoryTX.Restore(txCtx, authorID); err != nil {
+			return err
+		}
+		restoredBookIDs, err = postgresBookRepositoryTX.RestoreByAuthorID(txCtx, authorID, authorModel.DeletedAt.Time)
+		if err != nil {
+			return restoreBookConflictError(err)
+		}
+
+		restoredAuthorModel, err = postgresAuthorRepositoryTX.FindByIDWithAliases(txCtx, authorID)
+		if err != nil {
+			return err
+		}
+		revisionModel, err := catalogMapper.NewAuthorRevisionModel(authorID, domain.RevisionActionRestore, userID, authorModel, restoredAuthorModel)
+		if err != nil {
+			return err
+		}
+		if err := s.postgresRevisionRepository.WithinTX(tx).Create(txCtx, revisionModel); err != nil {
+			return err
+		}
+
+		for i := range deletedBookWithAuthorModels {
+			if !slices.Contains(restoredBookIDs, deletedBookWithAuthorModels[i].ID) {
+				continue
+			}
+			bookWithAuthorModel, err := postgresBookRepositoryTX.FindByID(txCtx, deletedBookWithAuthorModels[i].ID)
+			if err != nil {
+				return err
+			}
+			if err := s.recordBookRestore(txCtx, tx, &deletedBookWithAuthorModels[i].BookWithAuthor, bookWithAuthorModel, userID); err != nil {
+				return err
+			}
+		}
+
+		contributedBookIDs, err = s.postgresBookContributorRepository.WithinTX(tx).ListBookIDsByAuthorID(txCtx, authorID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Books where the author is only a contributor were cached without them
+	s.bookChangedWriter.Write(ctx, event.BookChangeUpdated, contributedBookIDs)
+	if len(restoredBookIDs) > 0 {
+		s.bookChangedWriter.Write(ctx, event.BookChangeRestored, restoredBookIDs)
+	}
+
+	authorDomain := catalogMapper.AuthorModelToDomain(restoredAuthorModel)
+	return &authorDomain, nil
+}
+
+// recordBookRestore runs within the transaction of the restore, so the book history never misses it
+func (s *trashService) recordBookRestore(ctx context.Context, tx *gorm.DB, deletedBookWithAuthorModel, bookWithAuthorModel *model.BookWithAuthor, userID uint) error {
+	revisionModel, err := catalogMapper.NewBookRevisionModel(bookWithAuthorModel.ID, domain.RevisionActionRestore, userID, deletedBookWithAuthorModel, bookWithAuthorModel)
+	if err != nil {
+		return err
+	}
+	return s.postgresRevisionRepository.WithinTX(tx).Create(ctx, revisionModel)
+}
+
+// PurgeExpired deletes for good books and authors which stayed in the trash longer than the retention period
+func (s *trashService) PurgeExpired(ctx context.Context) error {
+	before := time.Now().Add(-s.retentionPeriod)
+
+	for {
+		bookModels, err := s.postgresBookRepository.ListDeletedBefore(ctx, before, PURGE_BATCH_SIZE)
+		if err != nil {
+			return err
+		}
+		for i := range bookModels {
+			blobKeys := s.listBookBlobKeys(ctx, bookModels[i].ID, bookModels[i].CoverKey)
+			if err := s.postgresBookRepository.Purge(ctx, bookModels[i].ID); err != nil {
+				return err
+			}
+			s.deleteBookLeftovers(ctx, bookModels[i].ID, blobKeys)
+		}
+		if len(bookModels) < PURGE_BATCH_SIZE {
+			break
+		}
+	}
+
+	for {
+		authorModels, err := s.postgresAuthorRepository.ListDeletedBefore(ctx, before, PURGE_BATCH_SIZE)
+		if err != nil {
+			return err
+		}
+		for i := range authorModels {
+			if err := s.purgeAuthor(ctx, authorModels[i].ID); err != nil {
+				return err
+			}
+		}
+		if len(authorModels) < PURGE_BATCH_SIZE {
+			break
+		}
+	}
+	return nil
+}
+
+// purgeAuthor cascades to the author's books, even the ones trashed later than the author
+func (s *trashService) purgeAuthor(ctx context.Context, authorID uint) error {
+	deletedBookWithAuthorModels, err := s.postgresBookRepository.ListDeletedByAuthorID(ctx, authorID)
+	if err != nil {
+		return err
+	}
+
+	blobKeys := make([][]string, len(deletedBookWithAuthorModels))
+	for i := range deletedBookWithAuthorModels {
+		blobKeys[i] = s.listBookBlobKeys(ctx, deletedBookWithAuthorModels[i].ID, deletedBookWithAuthorModels[i].CoverKey)
+	}
+
+	if err := s.postgresAuthorRepository.Purge(ctx, authorID); err != nil {
+		return err
+	}
+	for i := range deletedBookWithAuthorModels {
+		s.deleteBookLeftovers(ctx, deletedBookWithAuthorModels[i].ID, blobKeys[i])
+	}
+	return nil
+}
+
+// listBookBlobKeys has to run before the purge, book file rows are removed together with the book
+func (s *trashService) listBookBlobKeys(ctx context.Context, bookID uint, coverKey string) []string {
+	blobKeys := make([]string, 0)
+	if coverKey != "" {
+		blobKeys = append(blobKeys, coverKey)
+	}
+
+	bookFileModels, err := s.postgresBookFileRepository.ListByBookID(ctx, bookID)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip delete book files", logging.Int("bookID", int(bookID)), logging.Error(err))
+	}
+	for i := range bookFileModels {
+		blobKeys = append(blobKeys, bookFileModels[i].FileKey)
+	}
+	return blobKeys
+}
+
+// deleteBookLeftovers only logs failures, the book is already gone and nothing references these anymore
+func (s *trashService) deleteBookLeftovers(ctx context.Context, bookID uint, blobKeys []string) {
+	for _, blobKey := range blobKeys {
+		if err := s.blobStorage.Delete(ctx, blobKey); err != nil {
+			s.logger.Warn(ctx, "Skip delete book blob", logging.String("blobKey", blobKey), logging.Error(err))
+		}
+	}
+
+	if err := s.redisBookRepository.DeleteViews(ctx, bookID); err != nil {
+		s.logger.Warn(ctx, "Skip delete book views", logging.Int("bookID", int(bookID)), logging.Error(err))
+	}
+}
+
+// restoreBookConflictError explains unique violations of restored books,
+// another book could take the same author and title or ISBN while they were in the trash
+func restoreBookConflictError(err error) error {
+	if errs.IsAlreadyExists(err) {
+		return errs.NewError(errs.CodeAlreadyExists, "Another book with the same author and title or ISBN exists, change or trash it first").WithCause(err)
+	}
+	return err
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/dto"
+)
+
+type TrashedBook struct {
+	Book      dto.Book  `json:"book"`
+	DeletedAt time.Time `json:"deletedAt"`
+	PurgeAt   time.Time `json:"purgeAt"`
+}
+
+type TrashedAuthor struct {
+	Author    dto.Author `json:"author"`
+	DeletedAt time.Time  `json:"deletedAt"`
+	PurgeAt   time.Time  `json:"purgeAt"`
+}
@@ -0,0 +1,209 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/service"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/transport/http/query"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+type TrashHandler interface {
+	ListTrashedBooks(c *gin.Context)
+	ListTrashedAuthors(c *gin.Context)
+	RestoreBook(c *gin.Context)
+	RestoreAuthor(c *gin.Context)
+}
+
+type trashHandler struct {
+	config       *config.Config
+	logger       *logging.Logger
+	trashService service.TrashService
+}
+
+func NewTrashHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	trashService service.TrashService,
+) TrashHandler {
+	return &trashHandler{
+		config:       config,
+		logger:       logger,
+		trashService: trashService,
+	}
+}
+
+// ListTrashedBooks godoc
+//
+//	@Summary		List trashed books
+//	@Description	Returns paginated list of deleted books, the most recently deleted first, with the time each of them is purged at
+//	@Tags			trash
+//	@Param			page	query	int	false	"Page number (min=1, default=1)"
+//	@Param			count	query	int	false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.TrashedBook
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/trash/books [get]
+func (h *trashHandler) ListTrashedBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.ListTrash
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ListTrashedBooks")
+	defer span.End()
+
+	trashedBookDomains, err := h.trashService.ListTrashedBooks(ctx, query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "List trashed books error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TrashedBookDomainsToDTOs(trashedBookDomains))
+}
+
+// ListTrashedAuthors godoc
+//
+//	@Summary		List trashed authors
+//	@Description	Returns paginated list of deleted authors, the most recently deleted first, with the time each of them is purged at
+//	@Tags			trash
+//	@Param			page	query	int	false	"Page number (min=1, default=1)"
+//	@Param			count	query	int	false	"Number of items per page (min=1, max=100, default=20)"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.TrashedAuthor
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/trash/authors [get]
+func (h *trashHandler) ListTrashedAuthors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.ListTrash
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ListTrashedAuthors")
+	defer span.End()
+
+	trashedAuthorDomains, err := h.trashService.ListTrashedAuthors(ctx, query.Page, query.Count)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "List trashed authors error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TrashedAuthorDomainsToDTOs(trashedAuthorDomains))
+}
+
+// RestoreBook godoc
+//
+//	@Summary		Restore a trashed book
+//	@Description	Brings a deleted book back to the catalog. Books of a trashed author can be restored only after the author
+//	@Tags			trash
+//	@Param			bookID	path	uint	true	"Book ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Book
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/trash/books/{bookID}/restore [post]
+func (h *trashHandler) RestoreBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bookIDString := c.Param("bookID")
+	bookID, err := strconv.ParseUint(bookIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.RestoreBook")
+	defer span.End()
+
+	bookDomain, err := h.trashService.RestoreBook(ctx, uint(bookID), uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Restore book error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, catalogMapper.BookDomainToDTO(bookDomain))
+}
+
+// RestoreAuthor godoc
+//
+//	@Summary		Restore a trashed author
+//	@Description	Brings a deleted author back together with the books which were trashed along with them
+//	@Tags			trash
+//	@Param			authorID	path	uint	true	"Author ID"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.Author
+//	@Failure		400 {object}	dto.Error "Bad request"
+//	@Failure		401 {object}	dto.Error "The token is missing, invalid or expired"
+//	@Failure		403 {object}	dto.Error "The token is valid, but lacks permission"
+//	@Failure		404 {object}	dto.Error "Entity not found"
+//	@Failure		500	{object}	dto.Error "Internal server error"
+//	@Router			/catalog/trash/authors/{authorID}/restore [post]
+func (h *trashHandler) RestoreAuthor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	authorIDString := c.Param("authorID")
+	authorID, err := strconv.ParseUint(authorIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.RestoreAuthor")
+	defer span.End()
+
+	authorDomain, err := h.trashService.RestoreAuthor(ctx, uint(authorID), uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Restore author error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, catalogMapper.AuthorDomainToDTO(authorDomain))
+}
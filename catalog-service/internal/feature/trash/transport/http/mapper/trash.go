@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	catalogMapper "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/transport/http/dto"
+)
+
+func TrashedBookDomainsToDTOs(trashedBookDomains []domain.TrashedBook) []dto.TrashedBook {
+	trashedBookDTOs := make([]dto.TrashedBook, len(trashedBookDomains))
+	for i := range trashedBookDomains {
+		trashedBookDTOs[i] = dto.TrashedBook{
+			Book:      catalogMapper.BookDomainToDTO(&trashedBookDomains[i].Book),
+			DeletedAt: trashedBookDomains[i].DeletedAt,
+			PurgeAt:   trashedBookDomains[i].PurgeAt,
+		}
+	}
+	return trashedBookDTOs
+}
+
+func TrashedAuthorDomainsToDTOs(trashedAuthorDomains []domain.TrashedAuthor) []dto.TrashedAuthor {
+	trashedAuthorDTOs := make([]dto.TrashedAuthor, len(trashedAuthorDomains))
+	for i := range trashedAuthorDomains {
+		trashedAuthorDTOs[i] = dto.TrashedAuthor{
+			Author:    catalogMapper.AuthorDomainToDTO(&trashedAuthorDomains[i].Author),
+			DeletedAt: trashedAuthorDomains[i].DeletedAt,
+			PurgeAt:   trashedAuthorDomains[i].PurgeAt,
+		}
+	}
+	return trashedAuthorDTOs
+}
@@ -0,0 +1,18 @@
+package http
+
+import (
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/http/route"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes mounts trash routes on the router created by the catalog feature
+func RegisterRoutes(r *gin.Engine, trashHandler TrashHandler) {
+	trashGroup := r.Group(sharedRoute.CATALOG + route.TRASH)
+	{
+		trashGroup.GET(sharedRoute.BOOKS, trashHandler.ListTrashedBooks)
+		trashGroup.GET(sharedRoute.AUTHORS, trashHandler.ListTrashedAuthors)
+		trashGroup.POST(sharedRoute.BOOKS+"/:bookID"+route.RESTORE, trashHandler.RestoreBook)
+		trashGroup.POST(sharedRoute.AUTHORS+"/:authorID"+route.RESTORE, trashHandler.RestoreAuthor)
+	}
+}
@@ -10,24 +10,53 @@ import (
 	"time"
 
 	sharedKafka "github.com/Yarik7610/library-backend-common/broker/kafka"
+	"github.com/Yarik7610/library-backend-common/microservice"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics"
+	analyticsJob "github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog"
+	catalogKafka "github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/transport/kafka"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/download"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/export"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer"
+	importJob "github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending"
+	lendingJob "github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation"
+	similarityJob "github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/job"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/review"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash"
+	trashJob "github.com/Yarik7610/library-backend/catalog-service/internal/feature/trash/job"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/tracing"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/blob"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/postgres"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/storage/redis"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/grpc/client/subscription"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 )
 
 type Container struct {
-	Config          *config.Config
-	Logger          *logging.Logger
-	httpServer      *http.Server
-	gRPCServer      *grpc.Server
-	stopOnce        sync.Once
-	shutdownTracing func(context.Context) error
+	Config                           *config.Config
+	Logger                           *logging.Logger
+	httpServer                       *http.Server
+	gRPCServer                       *grpc.Server
+	gRPCSubscriptionMicroserviceConn *grpc.ClientConn
+	bookChangedConsumer              catalogKafka.BookChangedConsumer
+	lendingJob                       lendingJob.Job
+	importJob                        importJob.Job
+	trashJob                         trashJob.Job
+	analyticsJob                     analyticsJob.Job
+	similarityJob                    similarityJob.Job
+	jobsCtx                          context.Context
+	cancelJobs                       context.CancelFunc
+	stopOnce                         sync.Once
+	shutdownTracing                  func(context.Context) error
 }
 
 func NewContainer() *Container {
@@ -53,24 +82,96 @@ func NewContainer() *Container {
 		logger.Fatal(context.Background(), "Redis connect error", logging.Error(err))
 	}
 
+	blobStorage, err := blob.NewLocalStorage(config.BlobStorageDir)
+	if err != nil {
+		logger.Fatal(context.Background(), "Blob storage init error", logging.Error(err))
+	}
+
+	subscriptionMicroserviceClient, gRPCSubscriptionMicroserviceConn, err := subscription.NewClient()
+	if err != nil {
+		logger.Fatal(context.Background(),
+			"gRPC subscription microservice client connect error",
+			logging.String("gRPC server address", microservice.SUBSCRIPTIONS_GRPC_ADDRESS),
+			logging.Error(err),
+		)
+	}
+
 	bookAddedWriter := kafka.NewOtelWriter(config, sharedKafka.BOOK_ADDED_TOPIC)
+	loanOverdueWriter := kafka.NewOtelWriter(config, kafka.LOAN_OVERDUE_TOPIC)
+	categoryRenamedWriter := kafka.NewOtelWriter(config, kafka.CATEGORY_RENAMED_TOPIC)
+	bookTaggedWriter := kafka.NewOtelWriter(config, kafka.BOOK_TAGGED_TOPIC)
+	bookChangedWriter := kafka.NewBookChangedWriter(config, logger)
 
-	catalogFeature, err := catalog.NewFeature(config, logger, postgresDB, redisClient, bookAddedWriter)
+	catalogFeature, err := catalog.NewFeature(config, logger, postgresDB, redisClient, blobStorage, bookAddedWriter, categoryRenamedWriter, bookChangedWriter)
 	if err != nil {
 		logger.Fatal(context.Background(), "Catalog feature init error", logging.Error(err))
 	}
 
+	annotation.Register(config, logger, postgresDB, catalogFeature.HTTPRouter)
+	review.Register(config, logger, postgresDB, catalogFeature.HTTPRouter, bookChangedWriter)
+	tag.Register(config, logger, postgresDB, catalogFeature.HTTPRouter, bookTaggedWriter)
+	shelf.Register(config, logger, postgresDB, catalogFeature.HTTPRouter)
+	lendingJob := lending.Register(config, logger, postgresDB, catalogFeature.HTTPRouter, loanOverdueWriter)
+	importJob := importer.Register(config, logger, postgresDB, blobStorage, catalogFeature.HTTPRouter, catalogFeature.CatalogService)
+	export.Register(config, logger, postgresDB, catalogFeature.HTTPRouter, catalogFeature.CatalogService)
+	download.Register(config, logger, postgresDB, blobStorage, catalogFeature.HTTPRouter)
+	trashJob := trash.Register(config, logger, postgresDB, redisClient, blobStorage, catalogFeature.HTTPRouter, bookChangedWriter)
+	analyticsJob := analytics.Register(config, logger, postgresDB, redisClient, catalogFeature.HTTPRouter)
+	similarityJob := recommendation.Register(config, logger, postgresDB, redisClient, catalogFeature.HTTPRouter, subscriptionMicroserviceClient)
+
+	// Cancelled by Stop, so jobs finish together with the servers
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+
 	return &Container{
-		Config:          config,
-		Logger:          logger,
-		httpServer:      catalogFeature.HTTPServer,
-		gRPCServer:      catalogFeature.GRPCServer,
-		shutdownTracing: shutdownTracing,
+		Config:                           config,
+		Logger:                           logger,
+		httpServer:                       catalogFeature.HTTPServer,
+		gRPCServer:                       catalogFeature.GRPCServer,
+		gRPCSubscriptionMicroserviceConn: gRPCSubscriptionMicroserviceConn,
+		bookChangedConsumer:              catalogFeature.BookChangedConsumer,
+		lendingJob:                       lendingJob,
+		importJob:                        importJob,
+		trashJob:                         trashJob,
+		analyticsJob:                     analyticsJob,
+		similarityJob:                    similarityJob,
+		jobsCtx:                          jobsCtx,
+		cancelJobs:                       cancelJobs,
+		shutdownTracing:                  shutdownTracing,
 	}
 }
 
 func (c *Container) Start() error {
-	group, ctx := errgroup.WithContext(context.Background())
+	group, ctx := errgroup.WithContext(c.jobsCtx)
+
+	group.Go(func() error {
+		c.bookChangedConsumer.Run(ctx)
+		return nil
+	})
+
+	group.Go(func() error {
+		c.lendingJob.Run(ctx)
+		return nil
+	})
+
+	group.Go(func() error {
+		c.importJob.Run(ctx)
+		return nil
+	})
+
+	group.Go(func() error {
+		c.trashJob.Run(ctx)
+		return nil
+	})
+
+	group.Go(func() error {
+		c.analyticsJob.Run(ctx)
+		return nil
+	})
+
+	group.Go(func() error {
+		c.similarityJob.Run(ctx)
+		return nil
+	})
 
 	group.Go(func() error {
 		err := c.httpServer.ListenAndServe()
@@ -107,6 +208,8 @@ func (c *Container) Stop(ctx context.Context) error {
 	var stopErr error
 
 	c.stopOnce.Do(func() {
+		c.cancelJobs()
+
 		if err := c.httpServer.Shutdown(ctx); err != nil {
 			stopErr = err
 			return
@@ -114,6 +217,11 @@ func (c *Container) Stop(ctx context.Context) error {
 
 		c.gRPCServer.GracefulStop()
 
+		if err := c.gRPCSubscriptionMicroserviceConn.Close(); err != nil {
+			stopErr = err
+			return
+		}
+
 		if err := c.shutdownTracing(ctx); err != nil {
 			stopErr = err
 			return
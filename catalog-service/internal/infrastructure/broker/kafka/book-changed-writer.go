@@ -0,0 +1,38 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/observability/logging"
+	"github.com/segmentio/kafka-go"
+)
+
+// BookChangedWriter is shared by every feature which changes books,
+// so none of them has to know which caches depend on a book
+type BookChangedWriter struct {
+	logger *logging.Logger
+	writer *OtelWriter
+}
+
+func NewBookChangedWriter(config *config.Config, logger *logging.Logger) *BookChangedWriter {
+	return &BookChangedWriter{logger: logger, writer: NewOtelWriter(config, BOOK_CHANGED_TOPIC)}
+}
+
+// Write only logs failures, stale cache entries expire on their own anyway
+func (w *BookChangedWriter) Write(ctx context.Context, change string, bookIDs []uint) {
+	bookChangedEvent, err := json.Marshal(event.BookChanged{Change: change, BookIDs: bookIDs})
+	if err != nil {
+		w.logger.Error(ctx, "Book changed event marshal error", logging.Error(err))
+		return
+	}
+
+	kafkaCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := w.writer.WriteMessages(kafkaCtx, kafka.Message{Value: bookChangedEvent}); err != nil {
+		w.logger.Error(ctx, "Book changed event write error", logging.Error(err))
+	}
+}
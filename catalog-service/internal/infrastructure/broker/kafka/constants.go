@@ -0,0 +1,10 @@
+package kafka
+
+// Topics which are specific to catalog-service and aren't shared through library-backend-common
+const (
+	LOAN_OVERDUE_TOPIC             = "loan.overdue"
+	CATEGORY_RENAMED_TOPIC         = "category.renamed"
+	BOOK_TAGGED_TOPIC              = "book.tagged"
+	BOOK_CHANGED_TOPIC             = "book.changed"
+	BOOK_CHANGED_CONSUMER_GROUP_ID = "book-changed-consumer-group-id"
+)
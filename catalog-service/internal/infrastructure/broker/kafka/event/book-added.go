@@ -0,0 +1,17 @@
+package event
+
+import sharedEvent "github.com/Yarik7610/library-backend-common/broker/kafka/event"
+
+// BookAdded extends the shared event with all book contributors.
+// Consumers that only know the shared event keep reading the primary author fields
+type BookAdded struct {
+	sharedEvent.BookAdded
+	Contributors []BookContributor `json:"contributors"`
+}
+
+type BookContributor struct {
+	AuthorID       uint   `json:"authorId"`
+	AuthorFullname string `json:"authorFullname"`
+	Role           string `json:"role"`
+	Position       uint   `json:"position"`
+}
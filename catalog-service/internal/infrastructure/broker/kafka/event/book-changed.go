@@ -0,0 +1,16 @@
+package event
+
+const (
+	BookChangeUpdated  = "updated"
+	BookChangeTrashed  = "trashed"
+	BookChangeRestored = "restored"
+	// Categories were created, updated or deleted, only the book categories list is affected
+	BookChangeCategories = "categories"
+)
+
+// BookChanged is consumed by catalog-service itself to invalidate book caches in one place.
+// Book IDs can be empty, when only book lists are affected
+type BookChanged struct {
+	Change  string `json:"change"`
+	BookIDs []uint `json:"bookIds"`
+}
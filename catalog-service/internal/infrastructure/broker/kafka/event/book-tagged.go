@@ -0,0 +1,12 @@
+package event
+
+// BookTagged lists only the tags which were just added to the book, so tag subscribers hear about it once
+type BookTagged struct {
+	ID             uint     `json:"id"`
+	AuthorID       uint     `json:"authorId"`
+	AuthorFullname string   `json:"authorFullname"`
+	Title          string   `json:"title"`
+	Year           int      `json:"year"`
+	Category       string   `json:"category"`
+	Tags           []string `json:"tags"`
+}
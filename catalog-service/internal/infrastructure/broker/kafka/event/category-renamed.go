@@ -0,0 +1,8 @@
+package event
+
+type CategoryRenamed struct {
+	ID      uint   `json:"id"`
+	Slug    string `json:"slug"`
+	OldName string `json:"oldName"`
+	NewName string `json:"newName"`
+}
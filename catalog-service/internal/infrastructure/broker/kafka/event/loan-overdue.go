@@ -0,0 +1,13 @@
+package event
+
+import "time"
+
+type LoanOverdue struct {
+	ID             uint      `json:"id"`
+	UserID         uint      `json:"userId"`
+	BookID         uint      `json:"bookId"`
+	Title          string    `json:"title"`
+	AuthorFullname string    `json:"authorFullname"`
+	BorrowedAt     time.Time `json:"borrowedAt"`
+	DueAt          time.Time `json:"dueAt"`
+}
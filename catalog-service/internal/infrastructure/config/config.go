@@ -1,19 +1,34 @@
 package config
 
 import (
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Env                      string `env:"ENV"`
-	ServiceName              string `env:"SERVICE_NAME"`
-	HTTPServerPort           string `env:"HTTP_SERVER_PORT"`
-	GRPCServerPort           string `env:"GRPC_SERVER_PORT"`
-	PostgresURL              string `env:"POSTGRES_URL"`
-	RedisHost                string `env:"REDIS_HOST"`
-	RedisPort                string `env:"REDIS_PORT"`
-	JWTSecret                string `env:"JWT_SECRET"`
-	OTelExporterOTLPEndpoint string `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	Env                      string        `env:"ENV"`
+	ServiceName              string        `env:"SERVICE_NAME"`
+	HTTPServerPort           string        `env:"HTTP_SERVER_PORT"`
+	GRPCServerPort           string        `env:"GRPC_SERVER_PORT"`
+	PostgresURL              string        `env:"POSTGRES_URL"`
+	RedisHost                string        `env:"REDIS_HOST"`
+	RedisPort                string        `env:"REDIS_PORT"`
+	BlobStorageDir           string        `env:"BLOB_STORAGE_DIR" env-default:"/var/lib/catalog-service/blobs"`
+	JWTSecret                string        `env:"JWT_SECRET"`
+	LendingDefaultCopies     uint          `env:"LENDING_DEFAULT_COPIES" env-default:"1"`
+	LendingLoanPeriod        time.Duration `env:"LENDING_LOAN_PERIOD" env-default:"336h"`
+	LendingMaxRenewals       uint          `env:"LENDING_MAX_RENEWALS" env-default:"2"`
+	LendingMaxActiveLoans    uint          `env:"LENDING_MAX_ACTIVE_LOANS" env-default:"5"`
+	LendingHoldPickupPeriod  time.Duration `env:"LENDING_HOLD_PICKUP_PERIOD" env-default:"72h"`
+	LendingJobInterval       time.Duration `env:"LENDING_JOB_INTERVAL" env-default:"1h"`
+	ImportJobInterval        time.Duration `env:"IMPORT_JOB_INTERVAL" env-default:"30s"`
+	PDFFontFile              string        `env:"PDF_FONT_FILE"`
+	TrashRetentionPeriod     time.Duration `env:"TRASH_RETENTION_PERIOD" env-default:"720h"`
+	TrashPurgeJobInterval    time.Duration `env:"TRASH_PURGE_JOB_INTERVAL" env-default:"1h"`
+	ViewRollupJobInterval    time.Duration `env:"VIEW_ROLLUP_JOB_INTERVAL" env-default:"15m"`
+	SimilarityJobInterval    time.Duration `env:"SIMILARITY_JOB_INTERVAL" env-default:"6h"`
+	OTelExporterOTLPEndpoint string        `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
 }
 
 func Parse() (*Config, error) {
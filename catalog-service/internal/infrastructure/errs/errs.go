@@ -2,6 +2,7 @@ package errs
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -52,3 +53,13 @@ func NewBadRequestError(message string) *Error {
 func NewInternalServerError() *Error {
 	return NewError(CodeInternal, "Internal server error")
 }
+
+func IsNotFound(err error) bool {
+	var infrastructureError *Error
+	return errors.As(err, &infrastructureError) && infrastructureError.Code == CodeNotFound
+}
+
+func IsAlreadyExists(err error) bool {
+	var infrastructureError *Error
+	return errors.As(err, &infrastructureError) && infrastructureError.Code == CodeAlreadyExists
+}
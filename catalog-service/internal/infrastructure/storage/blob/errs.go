@@ -0,0 +1,14 @@
+package blob
+
+import (
+	"errors"
+
+	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/errs"
+)
+
+func NewError(err error, entityName string) *errs.Error {
+	if errors.Is(err, ErrNotFound) {
+		return errs.NewEntityNotFoundError(entityName)
+	}
+	return errs.NewInternalServerError().WithCause(err)
+}
@@ -1,7 +1,17 @@
 package postgres
 
 import (
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
+	analyticsModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/analytics/repository/postgres/model"
+	annotationModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/annotation/repository/postgres/model"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
+	downloadModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/download/repository/postgres/model"
+	importerModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/importer/repository/postgres/model"
+	lendingModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/lending/repository/postgres/model"
+	recommendationModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/recommendation/repository/postgres/model"
+	reviewModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/review/repository/postgres/model"
+	shelfModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/shelf/repository/postgres/model"
+	tagModel "github.com/Yarik7610/library-backend/catalog-service/internal/feature/tag/repository/postgres/model"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/config"
 	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
 	"gorm.io/driver/postgres"
@@ -14,14 +24,110 @@ func Connect(config *config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	err = db.AutoMigrate(&model.Author{}, &model.Book{}, &model.Page{})
+	err = db.AutoMigrate(
+		&model.Author{}, &model.AuthorAlias{}, &model.Category{}, &model.Book{}, &model.BookContributor{}, &model.Page{}, &model.ReadingProgress{}, &model.UserBookView{}, &model.Revision{},
+		&annotationModel.Annotation{},
+		&reviewModel.Review{},
+		&tagModel.Tag{}, &tagModel.BookTag{},
+		&shelfModel.Shelf{}, &shelfModel.ShelfBook{},
+		&lendingModel.Inventory{}, &lendingModel.Loan{}, &lendingModel.Hold{},
+		&importerModel.ImportJob{}, &importerModel.ImportRowError{},
+		&downloadModel.BookFile{},
+		&analyticsModel.BookViews{}, &analyticsModel.BookDailyViews{},
+		&recommendationModel.BookSimilarity{},
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := dropTrashedBookUniqueIndexes(db); err != nil {
+		return nil, err
+	}
+	if err := migrateBookAuthors(db); err != nil {
+		return nil, err
+	}
+	if err := migrateBookCategories(db); err != nil {
+		return nil, err
+	}
+	if err := createSearchIndexes(db); err != nil {
+		return nil, err
+	}
+
 	if err := db.Use(otelgorm.NewPlugin()); err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
+
+// dropTrashedBookUniqueIndexes removes book unique indexes which also covered trashed books,
+// AutoMigrate has already created their partial replacements
+func dropTrashedBookUniqueIndexes(db *gorm.DB) error {
+	statements := []string{
+		"DROP INDEX IF EXISTS author_id_title_index",
+		"DROP INDEX IF EXISTS idx_books_isbn",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateBookAuthors moves books created before contributors existed onto the join table,
+// books.author_id stays as the primary author
+func migrateBookAuthors(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO book_contributors (book_id, author_id, role, position)
+		SELECT b.id, b.author_id, ?, 0
+		FROM books b
+		WHERE NOT EXISTS (SELECT 1 FROM book_contributors bc WHERE bc.book_id = b.id)
+	`, domain.ContributorRoleAuthor).Error
+}
+
+// migrateBookCategories creates managed categories out of free-text book categories
+// and links books to them. Slugs are built the same way as in the catalog service
+func migrateBookCategories(db *gorm.DB) error {
+	const slugSQL = `TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(b.category, '[^a-zA-Z0-9]+', '-', 'g')))`
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			INSERT INTO categories (slug, name, description, created_at, updated_at)
+			SELECT DISTINCT ON (slug) slug, name, '', NOW(), NOW()
+			FROM (
+				SELECT ` + slugSQL + ` AS slug, b.category AS name
+				FROM books b
+				WHERE b.category_id IS NULL
+			) book_categories
+			WHERE slug <> ''
+			ORDER BY slug, name
+			ON CONFLICT (slug) DO NOTHING
+		`).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			UPDATE books b SET category_id = c.id
+			FROM categories c
+			WHERE b.category_id IS NULL AND c.slug = ` + slugSQL).Error
+	})
+}
+
+// createSearchIndexes adds trigram indexes, which serve both substring searches (ILIKE '%...%')
+// and typo-tolerant suggestions. GORM tags can't declare operator classes, so they are created here
+func createSearchIndexes(db *gorm.DB) error {
+	statements := []string{
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		"CREATE INDEX IF NOT EXISTS books_title_trgm_index ON books USING GIN (title gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS authors_fullname_trgm_index ON authors USING GIN (fullname gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS author_aliases_name_trgm_index ON author_aliases USING GIN (name gin_trgm_ops)",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,13 +3,15 @@ package seed
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/Yarik7610/library-backend/catalog-service/internal/domain"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres"
 	"github.com/Yarik7610/library-backend/catalog-service/internal/feature/catalog/repository/postgres/model"
 )
 
-func Books(bookRepository postgres.BookRepository, pageRepository postgres.PageRepository, authorRepository postgres.AuthorRepository) error {
+func Books(bookRepository postgres.BookRepository, pageRepository postgres.PageRepository, authorRepository postgres.AuthorRepository, categoryRepository postgres.CategoryRepository) error {
 	ctx := context.Background()
 
 	bookCount, err := bookRepository.Count(ctx)
@@ -21,14 +23,22 @@ func Books(bookRepository postgres.BookRepository, pageRepository postgres.PageR
 		return nil
 	}
 
-	return seedBooks(ctx, bookRepository, pageRepository, authorRepository)
+	return seedBooks(ctx, bookRepository, pageRepository, authorRepository, categoryRepository)
 }
 
-func seedBooks(ctx context.Context, bookRepository postgres.BookRepository, pageRepository postgres.PageRepository, authorRepository postgres.AuthorRepository) error {
+func seedBooks(ctx context.Context, bookRepository postgres.BookRepository, pageRepository postgres.PageRepository, authorRepository postgres.AuthorRepository, categoryRepository postgres.CategoryRepository) error {
 	const booksCount = 100
 	const bookPagesCount = 5
 	const workersCount = 10
-	categories := []string{"Fantasy", "Mystery", "Romance", "Sci-Fi", "Thriller", "Horror", "Adventure", "Historical", "Biography", "Non-Fiction"}
+	categoryNames := []string{"Fantasy", "Mystery", "Romance", "Sci-Fi", "Thriller", "Horror", "Adventure", "Historical", "Biography", "Non-Fiction"}
+
+	categories := make([]model.Category, len(categoryNames))
+	for i := range categoryNames {
+		categories[i] = model.Category{Slug: strings.ToLower(categoryNames[i]), Name: categoryNames[i]}
+		if err := categoryRepository.Create(ctx, &categories[i]); err != nil {
+			return err
+		}
+	}
 
 	var wg sync.WaitGroup
 	errors := make(chan error, booksCount)
@@ -52,10 +62,14 @@ func seedBooks(ctx context.Context, bookRepository postgres.BookRepository, page
 			}
 
 			book := model.Book{
-				AuthorID: author.ID,
-				Title:    fmt.Sprintf("Book %d", i+1),
-				Year:     1900 + (i % 125),
-				Category: categories[i%len(categories)],
+				AuthorID:   author.ID,
+				Title:      fmt.Sprintf("Book %d", i+1),
+				Year:       1900 + (i % 125),
+				CategoryID: &categories[i%len(categories)].ID,
+				Category:   categories[i%len(categories)].Name,
+				Contributors: []model.BookContributor{
+					{AuthorID: author.ID, Role: domain.ContributorRoleAuthor},
+				},
 			}
 			if err := bookRepository.Create(ctx, &book); err != nil {
 				errors <- err
@@ -0,0 +1,45 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend-common/microservice"
+	grpcInfrastructure "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/grpc"
+	userSubscriptionPB "github.com/Yarik7610/library-backend/catalog-service/internal/infrastructure/transport/grpc/microservice/usersubscription"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Client interface {
+	GetUserSubscribedBookCategories(ctx context.Context, userID uint) ([]string, error)
+}
+
+type client struct {
+	gRPCUserSubscriptionClient userSubscriptionPB.UserSubscriptionServiceClient
+}
+
+func NewClient() (Client, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(
+		microservice.SUBSCRIPTIONS_GRPC_ADDRESS,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &client{gRPCUserSubscriptionClient: userSubscriptionPB.NewUserSubscriptionServiceClient(conn)}, conn, nil
+}
+
+func (c *client) GetUserSubscribedBookCategories(ctx context.Context, userID uint) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+
+	resp, err := c.gRPCUserSubscriptionClient.GetUserSubscribedBookCategories(ctx, &userSubscriptionPB.GetUserSubscribedBookCategoriesRequest{UserId: uint64(userID)})
+	if err != nil {
+		return nil, grpcInfrastructure.ToInfrastctureError(err)
+	}
+	return resp.GetBookCategories(), nil
+}
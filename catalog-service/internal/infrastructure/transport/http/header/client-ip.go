@@ -0,0 +1,4 @@
+package header
+
+// CLIENT_IP is set by the gateway from the connection address, api-gateway declares the same header
+const CLIENT_IP = "X-Client-IP"
@@ -0,0 +1,21 @@
+package header
+
+import (
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+)
+
+const DID_YOU_MEAN = "X-Did-You-Mean"
+
+// SetDidYouMean passes corrected search parameters as a query string, so clients can repeat the search with it as is
+func SetDidYouMean(ctx *gin.Context, authorName, title string) {
+	query := url.Values{}
+	if authorName != "" {
+		query.Set("author", authorName)
+	}
+	if title != "" {
+		query.Set("title", title)
+	}
+	ctx.Header(DID_YOU_MEAN, query.Encode())
+}
@@ -0,0 +1,15 @@
+package header
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetFingerprint tells anonymous clients apart by their address and browser, the raw values aren't stored.
+// The address comes from CLIENT_IP set by the gateway, forwarded headers of clients aren't trusted
+func GetFingerprint(ctx *gin.Context) string {
+	fingerprint := sha256.Sum256([]byte(ctx.ClientIP() + "|" + ctx.GetHeader("User-Agent") + "|" + ctx.GetHeader("Accept-Language")))
+	return hex.EncodeToString(fingerprint[:16])
+}
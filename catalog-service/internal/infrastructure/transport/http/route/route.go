@@ -0,0 +1,38 @@
+package route
+
+// Routes which are specific to catalog-service and aren't shared through library-backend-common
+const (
+	READING      = "/reading"
+	CONTRIBUTORS = "/contributors"
+	METADATA     = "/metadata"
+	COVER        = "/cover"
+	IMPORTS      = "/imports"
+	ANNOTATIONS  = "/annotations"
+	REVIEWS      = "/reviews"
+	VISIBILITY   = "/visibility"
+	TAGS         = "/tags"
+	EXPORT       = "/export"
+	OPDS         = "/opds"
+	DOWNLOAD     = "/download"
+	TRASH        = "/trash"
+	RESTORE      = "/restore"
+	HISTORY      = "/history"
+	REVERT       = "/revert"
+	TRENDING     = "/trending"
+	ANALYTICS    = "/analytics"
+
+	SIMILAR         = "/similar"
+	RECOMMENDATIONS = "/recommendations"
+	SUGGEST         = "/suggest"
+
+	SHELVES = "/shelves"
+	SHARED  = "/shared"
+	ORDER   = "/order"
+
+	AVAILABILITY = "/availability"
+	INVENTORY    = "/inventory"
+	LOANS        = "/loans"
+	RETURN       = "/return"
+	RENEWAL      = "/renewal"
+	HOLDS        = "/holds"
+)
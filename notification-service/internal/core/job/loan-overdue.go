@@ -0,0 +1,8 @@
+package job
+
+import "github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka/event"
+
+type LoanOverdue struct {
+	OverdueLoan *event.LoanOverdue
+	Email       string
+}
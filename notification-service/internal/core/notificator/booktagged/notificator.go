@@ -0,0 +1,153 @@
+package booktagged
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Yarik7610/library-backend/notification-service/internal/core/job"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/email"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/email/template"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/observability/tracing"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/transport/grpc/client/subscription"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/workerpool"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type Notificator interface {
+	Run(ctx context.Context)
+	Stop(ctx context.Context) error
+}
+
+type notificator struct {
+	logger                         *logging.Logger
+	bookTaggedReader               *kafkaInfrastructure.OtelReader
+	emailSender                    email.Sender
+	subscriptionMicroserviceClient subscription.Client
+}
+
+func NewNotificator(
+	logger *logging.Logger,
+	bookTaggedReader *kafkaInfrastructure.OtelReader,
+	emailSender email.Sender,
+	subscriptionMicroserviceClient subscription.Client,
+) Notificator {
+	return &notificator{
+		logger:                         logger,
+		bookTaggedReader:               bookTaggedReader,
+		emailSender:                    emailSender,
+		subscriptionMicroserviceClient: subscriptionMicroserviceClient,
+	}
+}
+
+func (n *notificator) Stop(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
+func (n *notificator) Run(ctx context.Context) {
+	defer n.bookTaggedReader.Close()
+
+	const (
+		WORKERS_COUNT = 20
+		JOBS_MAX_SIZE = 100
+	)
+
+	workerPool := n.startWorkerPool(ctx, WORKERS_COUNT, JOBS_MAX_SIZE)
+	defer workerPool.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		message, spanCtx, span, err := n.bookTaggedReader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			n.logger.Error(ctx, "Tagged book message fetch error", logging.Error(err))
+			continue
+		}
+
+		if err := n.processMessage(spanCtx, workerPool, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			n.logger.Error(spanCtx, "Tagged book message process error", logging.Error(err))
+			continue
+		}
+
+		if err := n.bookTaggedReader.CommitMessages(spanCtx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			n.logger.Error(spanCtx, "Tagged book message commit error", logging.Any("message", message), logging.Error(err))
+		}
+		span.End()
+	}
+}
+
+func (n *notificator) startWorkerPool(ctx context.Context, workersCount, jobsMaxSize int) workerpool.Pool[job.BookTagged] {
+	workerPool := workerpool.New[job.BookTagged](workersCount, jobsMaxSize)
+
+	workerPool.Run(func(job job.BookTagged) {
+		body := template.ParseBookTaggedTemplate(job.TaggedBook)
+
+		if err := n.emailSender.Send(body, []string{job.Email}); err != nil {
+			n.logger.Error(ctx, "Tagged book send mail error",
+				logging.String("email", job.Email),
+				logging.Error(err))
+		}
+	})
+
+	return workerPool
+}
+
+func (n *notificator) processMessage(ctx context.Context, workerPool workerpool.Pool[job.BookTagged], message kafka.Message) error {
+	taggedBook, err := n.parseEvent(message.Value)
+	if err != nil {
+		return err
+	}
+
+	// To not inherit kafka's cancelled context already, for cases to process old messages on microservice reboot
+	gRPCCallCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	emails, err := n.subscriptionMicroserviceClient.GetBookTagsSubscribedUserEmails(gRPCCallCtx, taggedBook.Tags)
+	if err != nil {
+		return err
+	}
+
+	jobs := n.buildJobs(taggedBook, emails)
+	workerPool.Feed(jobs)
+	return nil
+}
+
+func (n *notificator) parseEvent(data []byte) (*event.BookTagged, error) {
+	var taggedBook event.BookTagged
+	if err := json.Unmarshal(data, &taggedBook); err != nil {
+		return nil, err
+	}
+	return &taggedBook, nil
+}
+
+func (n *notificator) buildJobs(book *event.BookTagged, emails []string) []job.BookTagged {
+	jobs := make([]job.BookTagged, 0, len(emails))
+	for _, email := range emails {
+		jobs = append(jobs, job.BookTagged{Email: email, TaggedBook: book})
+	}
+	return jobs
+}
@@ -0,0 +1,154 @@
+package loanoverdue
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Yarik7610/library-backend/notification-service/internal/core/job"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/email"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/email/template"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/observability/tracing"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/transport/grpc/client/user"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/workerpool"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type Notificator interface {
+	Run(ctx context.Context)
+	Stop(ctx context.Context) error
+}
+
+type notificator struct {
+	logger                 *logging.Logger
+	loanOverdueReader      *kafkaInfrastructure.OtelReader
+	emailSender            email.Sender
+	userMicroserviceClient user.Client
+}
+
+func NewNotificator(
+	logger *logging.Logger,
+	loanOverdueReader *kafkaInfrastructure.OtelReader,
+	emailSender email.Sender,
+	userMicroserviceClient user.Client,
+) Notificator {
+	return &notificator{
+		logger:                 logger,
+		loanOverdueReader:      loanOverdueReader,
+		emailSender:            emailSender,
+		userMicroserviceClient: userMicroserviceClient,
+	}
+}
+
+func (n *notificator) Stop(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
+func (n *notificator) Run(ctx context.Context) {
+	defer n.loanOverdueReader.Close()
+
+	// Every event is addressed to a single reader, so a small pool is enough
+	const (
+		WORKERS_COUNT = 5
+		JOBS_MAX_SIZE = 50
+	)
+
+	workerPool := n.startWorkerPool(ctx, WORKERS_COUNT, JOBS_MAX_SIZE)
+	defer workerPool.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		message, spanCtx, span, err := n.loanOverdueReader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			n.logger.Error(ctx, "Overdue loan message fetch error", logging.Error(err))
+			continue
+		}
+
+		if err := n.processMessage(spanCtx, workerPool, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			n.logger.Error(spanCtx, "Overdue loan message process error", logging.Error(err))
+			continue
+		}
+
+		if err := n.loanOverdueReader.CommitMessages(spanCtx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			n.logger.Error(spanCtx, "Overdue loan message commit error", logging.Any("message", message), logging.Error(err))
+		}
+		span.End()
+	}
+}
+
+func (n *notificator) startWorkerPool(ctx context.Context, workersCount, jobsMaxSize int) workerpool.Pool[job.LoanOverdue] {
+	workerPool := workerpool.New[job.LoanOverdue](workersCount, jobsMaxSize)
+
+	workerPool.Run(func(job job.LoanOverdue) {
+		body := template.ParseLoanOverdueTemplate(job.OverdueLoan)
+
+		if err := n.emailSender.Send(body, []string{job.Email}); err != nil {
+			n.logger.Error(ctx, "Overdue loan send mail error",
+				logging.String("email", job.Email),
+				logging.Error(err))
+		}
+	})
+
+	return workerPool
+}
+
+func (n *notificator) processMessage(ctx context.Context, workerPool workerpool.Pool[job.LoanOverdue], message kafka.Message) error {
+	overdueLoan, err := n.parseEvent(message.Value)
+	if err != nil {
+		return err
+	}
+
+	// To not inherit kafka's cancelled context already, for cases to process old messages on microservice reboot
+	gRPCCallCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	emails, err := n.userMicroserviceClient.GetEmailsByUserIDs(gRPCCallCtx, []uint{overdueLoan.UserID})
+	if err != nil {
+		return err
+	}
+
+	jobs := n.buildJobs(overdueLoan, emails)
+	workerPool.Feed(jobs)
+	return nil
+}
+
+func (n *notificator) parseEvent(data []byte) (*event.LoanOverdue, error) {
+	var overdueLoan event.LoanOverdue
+	if err := json.Unmarshal(data, &overdueLoan); err != nil {
+		return nil, err
+	}
+	return &overdueLoan, nil
+}
+
+func (n *notificator) buildJobs(overdueLoan *event.LoanOverdue, emails []string) []job.LoanOverdue {
+	jobs := make([]job.LoanOverdue, 0, len(emails))
+	for _, email := range emails {
+		jobs = append(jobs, job.LoanOverdue{Email: email, OverdueLoan: overdueLoan})
+	}
+	return jobs
+}
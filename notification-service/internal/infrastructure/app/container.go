@@ -14,20 +14,26 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/Yarik7610/library-backend/notification-service/internal/core/notificator/bookadded"
+	"github.com/Yarik7610/library-backend/notification-service/internal/core/notificator/booktagged"
+	"github.com/Yarik7610/library-backend/notification-service/internal/core/notificator/loanoverdue"
 	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka"
 	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/email"
 	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/observability/tracing"
 	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/transport/grpc/client/subscription"
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/transport/grpc/client/user"
 )
 
 type Container struct {
 	Config                           *config.Config
 	Logger                           *logging.Logger
 	bookAddedNotificator             bookadded.Notificator
+	bookTaggedNotificator            booktagged.Notificator
+	loanOverdueNotificator           loanoverdue.Notificator
 	httpServer                       *http.Server
 	gRPCSubscriptionMicroserviceConn *grpc.ClientConn
+	gRPCUserMicroserviceConn         *grpc.ClientConn
 	stopOnce                         sync.Once
 	shutdownTracing                  func(context.Context) error
 }
@@ -54,12 +60,33 @@ func NewContainer() *Container {
 		)
 	}
 
+	userMicroserviceClient, gRPCUserMicroserviceConn, err := user.NewClient()
+	if err != nil {
+		logger.Fatal(context.Background(),
+			"gRPC user microservice client connect error",
+			logging.String("gRPC server address", microservice.USER_GRPC_ADDRESS),
+			logging.Error(err),
+		)
+	}
+
 	bookAddedReader := kafka.NewOtelReader(config, sharedKafka.BOOK_ADDED_TOPIC, sharedKafka.BOOK_ADDED_CONSUMER_GROUP_ID)
 	bookAddedEmailSender := email.NewSender(config.Mail, config.MailPassword)
 	bookAddedEmailSender.WithSubject("Book category subscription notification")
 
 	bookAddedNotificator := bookadded.NewNotificator(logger, bookAddedReader, bookAddedEmailSender, subscriptionMicroserviceClient)
 
+	bookTaggedReader := kafka.NewOtelReader(config, kafka.BOOK_TAGGED_TOPIC, kafka.BOOK_TAGGED_CONSUMER_GROUP_ID)
+	bookTaggedEmailSender := email.NewSender(config.Mail, config.MailPassword)
+	bookTaggedEmailSender.WithSubject("Book tag subscription notification")
+
+	bookTaggedNotificator := booktagged.NewNotificator(logger, bookTaggedReader, bookTaggedEmailSender, subscriptionMicroserviceClient)
+
+	loanOverdueReader := kafka.NewOtelReader(config, kafka.LOAN_OVERDUE_TOPIC, kafka.LOAN_OVERDUE_CONSUMER_GROUP_ID)
+	loanOverdueEmailSender := email.NewSender(config.Mail, config.MailPassword)
+	loanOverdueEmailSender.WithSubject("Overdue book loan")
+
+	loanOverdueNotificator := loanoverdue.NewNotificator(logger, loanOverdueReader, loanOverdueEmailSender, userMicroserviceClient)
+
 	httpServer, err := newHTTPServer(config)
 	if err != nil {
 		logger.Fatal(context.Background(), "HTTP server init error", logging.Error(err))
@@ -69,8 +96,11 @@ func NewContainer() *Container {
 		Config:                           config,
 		Logger:                           logger,
 		bookAddedNotificator:             bookAddedNotificator,
+		bookTaggedNotificator:            bookTaggedNotificator,
+		loanOverdueNotificator:           loanOverdueNotificator,
 		httpServer:                       httpServer,
 		gRPCSubscriptionMicroserviceConn: gRPCSubscriptionMicroserviceConn,
+		gRPCUserMicroserviceConn:         gRPCUserMicroserviceConn,
 		shutdownTracing:                  shutdownTracing,
 	}
 }
@@ -83,6 +113,16 @@ func (c *Container) Start() error {
 		return nil
 	})
 
+	group.Go(func() error {
+		c.bookTaggedNotificator.Run(ctx)
+		return nil
+	})
+
+	group.Go(func() error {
+		c.loanOverdueNotificator.Run(ctx)
+		return nil
+	})
+
 	group.Go(func() error {
 		err := c.httpServer.ListenAndServe()
 		if errors.Is(err, http.ErrServerClosed) {
@@ -124,11 +164,26 @@ func (c *Container) Stop(ctx context.Context) error {
 			return
 		}
 
+		if err := c.bookTaggedNotificator.Stop(ctx); err != nil {
+			stopErr = err
+			return
+		}
+
+		if err := c.loanOverdueNotificator.Stop(ctx); err != nil {
+			stopErr = err
+			return
+		}
+
 		if err := c.gRPCSubscriptionMicroserviceConn.Close(); err != nil {
 			stopErr = err
 			return
 		}
 
+		if err := c.gRPCUserMicroserviceConn.Close(); err != nil {
+			stopErr = err
+			return
+		}
+
 		if err := c.shutdownTracing(ctx); err != nil {
 			stopErr = err
 			return
@@ -0,0 +1,9 @@
+package kafka
+
+// Topics which are specific to library-backend and aren't shared through library-backend-common
+const (
+	LOAN_OVERDUE_TOPIC             = "loan.overdue"
+	LOAN_OVERDUE_CONSUMER_GROUP_ID = "loan-overdue-consumer-group-id"
+	BOOK_TAGGED_TOPIC              = "book.tagged"
+	BOOK_TAGGED_CONSUMER_GROUP_ID  = "book-tagged-consumer-group-id"
+)
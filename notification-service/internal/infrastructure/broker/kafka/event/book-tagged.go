@@ -0,0 +1,12 @@
+package event
+
+// BookTagged mirrors the event produced by catalog-service, tags contain only the newly added ones
+type BookTagged struct {
+	ID             uint     `json:"id"`
+	AuthorID       uint     `json:"authorId"`
+	AuthorFullname string   `json:"authorFullname"`
+	Title          string   `json:"title"`
+	Year           int      `json:"year"`
+	Category       string   `json:"category"`
+	Tags           []string `json:"tags"`
+}
@@ -0,0 +1,33 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/notification-service/pkg/utils"
+)
+
+func ParseBookTaggedTemplate(taggedBook *event.BookTagged) string {
+	return fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html>
+			<body style="font-family: Arial, sans-serif; line-height:1.5; color:#333;">
+				<h2>🏷️ New book in your tags!</h2>
+				<p>A book was tagged with <b>%s</b>:</p>
+				<ul>
+					<li><b>📖 Title:</b> %s</li>
+					<li><b>👤 Author:</b> %s</li>
+					<li><b>📂 Category:</b> %s</li>
+					<li><b>📅 Year:</b> %d</li>
+				</ul>
+				<p>Enjoy reading!</p>
+			</body>
+		</html>`,
+		strings.Join(taggedBook.Tags, ", "),
+		taggedBook.Title,
+		taggedBook.AuthorFullname,
+		utils.Capitalize(taggedBook.Category),
+		taggedBook.Year,
+	)
+}
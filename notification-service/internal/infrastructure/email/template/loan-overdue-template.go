@@ -0,0 +1,30 @@
+package template
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/broker/kafka/event"
+)
+
+func ParseLoanOverdueTemplate(overdueLoan *event.LoanOverdue) string {
+	return fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html>
+			<body style="font-family: Arial, sans-serif; line-height:1.5; color:#333;">
+				<h2>⏰ Your loan is overdue</h2>
+				<p>The book you borrowed had to be returned by <b>%s</b>:</p>
+				<ul>
+					<li><b>📖 Title:</b> %s</li>
+					<li><b>👤 Author:</b> %s</li>
+					<li><b>📅 Borrowed:</b> %s</li>
+				</ul>
+				<p>Please return it as soon as possible, other readers may be waiting for it.</p>
+			</body>
+		</html>`,
+		overdueLoan.DueAt.Format(time.DateOnly),
+		overdueLoan.Title,
+		overdueLoan.AuthorFullname,
+		overdueLoan.BorrowedAt.Format(time.DateOnly),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/Yarik7610/library-backend-common/transport/grpc/microservice/subscription"
+	userSubscriptionPB "github.com/Yarik7610/library-backend/notification-service/internal/infrastructure/transport/grpc/microservice/usersubscription"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -13,10 +14,12 @@ import (
 
 type Client interface {
 	GetBookCategorySubscribedUserEmails(ctx context.Context, bookCategory string) ([]string, error)
+	GetBookTagsSubscribedUserEmails(ctx context.Context, bookTags []string) ([]string, error)
 }
 
 type client struct {
-	gRPCClient pb.SubscriptionServiceClient
+	gRPCClient                 pb.SubscriptionServiceClient
+	gRPCUserSubscriptionClient userSubscriptionPB.UserSubscriptionServiceClient
 }
 
 func NewClient() (Client, *grpc.ClientConn, error) {
@@ -29,7 +32,10 @@ func NewClient() (Client, *grpc.ClientConn, error) {
 		return nil, nil, err
 	}
 
-	return &client{gRPCClient: pb.NewSubscriptionServiceClient(conn)}, conn, nil
+	return &client{
+		gRPCClient:                 pb.NewSubscriptionServiceClient(conn),
+		gRPCUserSubscriptionClient: userSubscriptionPB.NewUserSubscriptionServiceClient(conn),
+	}, conn, nil
 }
 
 func (c *client) GetBookCategorySubscribedUserEmails(ctx context.Context, bookCategory string) ([]string, error) {
@@ -40,3 +46,12 @@ func (c *client) GetBookCategorySubscribedUserEmails(ctx context.Context, bookCa
 	}
 	return resp.GetEmails(), nil
 }
+
+func (c *client) GetBookTagsSubscribedUserEmails(ctx context.Context, bookTags []string) ([]string, error) {
+	// Timeout is set outside for kafka's correct work
+	resp, err := c.gRPCUserSubscriptionClient.GetBookTagsSubscribedUserEmails(ctx, &userSubscriptionPB.GetBookTagsSubscribedUserEmailsRequest{BookTags: bookTags})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetEmails(), nil
+}
@@ -0,0 +1,7 @@
+package domain
+
+type UserBookTag struct {
+	ID      uint
+	UserID  uint
+	BookTag string
+}
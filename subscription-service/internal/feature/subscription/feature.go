@@ -8,19 +8,24 @@ import (
 	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/service"
 	grpcTransport "github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/grpc"
 	httpTransport "github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/http"
+	kafkaTransport "github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/kafka"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/broker/kafka"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/metrics"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/client/catalog"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/client/user"
+	userSubscriptionPB "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/microservice/usersubscription"
+	httpCatalog "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http/client/catalog"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
 
 type Feature struct {
-	HTTPServer *http.Server
-	GRPCServer *grpc.Server
+	HTTPServer              *http.Server
+	GRPCServer              *grpc.Server
+	CategoryRenamedConsumer kafkaTransport.CategoryRenamedConsumer
 }
 
 func NewFeature(
@@ -28,11 +33,16 @@ func NewFeature(
 	logger *logging.Logger,
 	postgresDB *gorm.DB,
 	catalogMicroserviceClient catalog.Client,
+	catalogMicroserviceHTTPClient httpCatalog.Client,
 	userMicroserviceClient user.Client,
 ) (*Feature, error) {
 	userBookCategorySubscriptionRepository := postgres.NewUserBookCategorySubscriptionRepository(postgresDB)
+	userBookTagSubscriptionRepository := postgres.NewUserBookTagSubscriptionRepository(postgresDB)
 
-	subscriptionService := service.NewSubscriptionService(userBookCategorySubscriptionRepository, catalogMicroserviceClient, userMicroserviceClient)
+	subscriptionService := service.NewSubscriptionService(
+		userBookCategorySubscriptionRepository, userBookTagSubscriptionRepository,
+		catalogMicroserviceClient, catalogMicroserviceHTTPClient, userMicroserviceClient,
+	)
 
 	metricsHandler, err := metrics.Init()
 	if err != nil {
@@ -40,6 +50,7 @@ func NewFeature(
 	}
 	httpSubscriptionHandler := httpTransport.NewSubscriptionHandler(config, logger, subscriptionService)
 	grpcSubscriptionHandler := grpcTransport.NewSubscriptionHandler(config, logger, subscriptionService)
+	grpcUserSubscriptionHandler := grpcTransport.NewUserSubscriptionHandler(config, logger, subscriptionService)
 
 	httpRouter := httpTransport.NewRouter(config, metricsHandler, httpSubscriptionHandler)
 	httpServer := &http.Server{
@@ -49,6 +60,14 @@ func NewFeature(
 
 	gRPCServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	pb.RegisterSubscriptionServiceServer(gRPCServer, grpcSubscriptionHandler)
+	userSubscriptionPB.RegisterUserSubscriptionServiceServer(gRPCServer, grpcUserSubscriptionHandler)
 
-	return &Feature{HTTPServer: httpServer, GRPCServer: gRPCServer}, nil
+	categoryRenamedReader := kafka.NewOtelReader(config, kafka.CATEGORY_RENAMED_TOPIC, kafka.CATEGORY_RENAMED_CONSUMER_GROUP_ID)
+	categoryRenamedConsumer := kafkaTransport.NewCategoryRenamedConsumer(logger, categoryRenamedReader, subscriptionService)
+
+	return &Feature{
+		HTTPServer:              httpServer,
+		GRPCServer:              gRPCServer,
+		CategoryRenamedConsumer: categoryRenamedConsumer,
+	}, nil
 }
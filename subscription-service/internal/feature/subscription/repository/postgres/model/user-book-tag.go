@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type UserBookTag struct {
+	ID        uint   `gorm:"primarykey"`
+	UserID    uint   `gorm:"index;uniqueIndex:user_id_book_tag_index"`
+	BookTag   string `gorm:"index;uniqueIndex:user_id_book_tag_index"`
+	CreatedAt time.Time
+}
@@ -17,6 +17,7 @@ type UserBookCategorySubscriptionRepository interface {
 	GetUserSubscribedBookCategories(ctx context.Context, userID uint) ([]string, error)
 	Create(ctx context.Context, userBookCategory *model.UserBookCategory) error
 	Delete(ctx context.Context, userID uint, bookCategory string) error
+	RenameBookCategory(ctx context.Context, oldBookCategory, newBookCategory string) error
 }
 
 type bookCategorySubscriptionRepository struct {
@@ -101,3 +102,32 @@ func (r *bookCategorySubscriptionRepository) Delete(ctx context.Context, userID
 	}
 	return nil
 }
+
+// RenameBookCategory moves subscriptions to the new category name,
+// users already subscribed to both names keep a single subscription
+func (r *bookCategorySubscriptionRepository) RenameBookCategory(ctx context.Context, oldBookCategory, newBookCategory string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	oldBookCategory = strings.ToLower(oldBookCategory)
+	newBookCategory = strings.ToLower(newBookCategory)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Where("book_category = ?", oldBookCategory).
+			Where("user_id IN (?)", tx.Model(&model.UserBookCategory{}).Select("user_id").Where("book_category = ?", newBookCategory)).
+			Delete(&model.UserBookCategory{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Model(&model.UserBookCategory{}).
+			Where("book_category = ?", oldBookCategory).
+			Update("book_category", newBookCategory).Error
+	})
+	if err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/repository/postgres/model"
+
+	postgresInfrastructure "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type UserBookTagSubscriptionRepository interface {
+	GetSubscriptionUserIDs(ctx context.Context, bookTags []string) ([]uint, error)
+	GetUserSubscribedBookTags(ctx context.Context, userID uint) ([]string, error)
+	Create(ctx context.Context, userBookTag *model.UserBookTag) error
+	Delete(ctx context.Context, userID uint, bookTag string) error
+}
+
+type bookTagSubscriptionRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewUserBookTagSubscriptionRepository(db *gorm.DB) UserBookTagSubscriptionRepository {
+	return &bookTagSubscriptionRepository{
+		name:    "Book tag subscription(s)",
+		timeout: 1 * time.Second,
+		db:      db,
+	}
+}
+
+func (r *bookTagSubscriptionRepository) GetSubscriptionUserIDs(ctx context.Context, bookTags []string) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	lowerBookTags := make([]string, len(bookTags))
+	for i := range bookTags {
+		lowerBookTags[i] = strings.ToLower(bookTags[i])
+	}
+
+	var userIDs []uint
+	if err := r.db.WithContext(ctx).
+		Model(&model.UserBookTag{}).
+		Distinct("user_id").
+		Order("user_id ASC").
+		Where("book_tag IN ?", lowerBookTags).
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return userIDs, nil
+}
+
+func (r *bookTagSubscriptionRepository) GetUserSubscribedBookTags(ctx context.Context, userID uint) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var subscribedTags []string
+	if err := r.db.WithContext(ctx).
+		Model(&model.UserBookTag{}).
+		Order("created_at DESC").
+		Where("user_id = ?", userID).
+		Pluck("book_tag", &subscribedTags).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return subscribedTags, nil
+}
+
+func (r *bookTagSubscriptionRepository) Create(ctx context.Context, userBookTag *model.UserBookTag) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	userBookTag.BookTag = strings.ToLower(userBookTag.BookTag)
+
+	if err := r.db.WithContext(ctx).Create(userBookTag).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *bookTagSubscriptionRepository) Delete(ctx context.Context, userID uint, bookTag string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("book_tag = ?", strings.ToLower(bookTag)).
+		Delete(&model.UserBookTag{})
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
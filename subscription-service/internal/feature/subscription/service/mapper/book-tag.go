@@ -0,0 +1,14 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/subscription-service/internal/domain"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/repository/postgres/model"
+)
+
+func UserBookTagToDomain(userBookTagModel *model.UserBookTag) domain.UserBookTag {
+	return domain.UserBookTag{
+		ID:      userBookTagModel.ID,
+		UserID:  userBookTagModel.UserID,
+		BookTag: userBookTagModel.BookTag,
+	}
+}
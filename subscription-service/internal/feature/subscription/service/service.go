@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Yarik7610/library-backend/subscription-service/internal/domain"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/repository/postgres"
@@ -11,6 +12,7 @@ import (
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/errs"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/client/catalog"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/client/user"
+	httpCatalog "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http/client/catalog"
 )
 
 type SubscriptionService interface {
@@ -18,22 +20,33 @@ type SubscriptionService interface {
 	GetUserSubscribedBookCategories(ctx context.Context, userID uint) ([]string, error)
 	SubscribeToBookCategory(ctx context.Context, userID uint, bookCategory string) (*domain.UserBookCategory, error)
 	UnsubscribeFromBookCategory(ctx context.Context, userID uint, bookCategory string) error
+	RenameBookCategory(ctx context.Context, oldBookCategory, newBookCategory string) error
+	GetBookTagsSubscribedUserEmails(ctx context.Context, bookTags []string) ([]string, error)
+	GetUserSubscribedBookTags(ctx context.Context, userID uint) ([]string, error)
+	SubscribeToBookTag(ctx context.Context, userID uint, bookTag string) (*domain.UserBookTag, error)
+	UnsubscribeFromBookTag(ctx context.Context, userID uint, bookTag string) error
 }
 
 type subscriptionService struct {
 	userBookCategorySubscriptionRepository postgres.UserBookCategorySubscriptionRepository
+	userBookTagSubscriptionRepository      postgres.UserBookTagSubscriptionRepository
 	catalogMicroserviceClient              catalog.Client
+	catalogMicroserviceHTTPClient          httpCatalog.Client
 	userMicroserviceClient                 user.Client
 }
 
 func NewSubscriptionService(
 	userBookCategorySubscriptionRepository postgres.UserBookCategorySubscriptionRepository,
+	userBookTagSubscriptionRepository postgres.UserBookTagSubscriptionRepository,
 	catalogMicroserviceClient catalog.Client,
+	catalogMicroserviceHTTPClient httpCatalog.Client,
 	userMicroserviceClient user.Client,
 ) SubscriptionService {
 	return &subscriptionService{
 		userBookCategorySubscriptionRepository: userBookCategorySubscriptionRepository,
+		userBookTagSubscriptionRepository:      userBookTagSubscriptionRepository,
 		catalogMicroserviceClient:              catalogMicroserviceClient,
+		catalogMicroserviceHTTPClient:          catalogMicroserviceHTTPClient,
 		userMicroserviceClient:                 userMicroserviceClient,
 	}
 }
@@ -91,3 +104,59 @@ func (s *subscriptionService) UnsubscribeFromBookCategory(ctx context.Context, u
 
 	return s.userBookCategorySubscriptionRepository.Delete(ctx, userID, bookCategory)
 }
+
+func (s *subscriptionService) RenameBookCategory(ctx context.Context, oldBookCategory, newBookCategory string) error {
+	if strings.EqualFold(oldBookCategory, newBookCategory) {
+		return nil
+	}
+	return s.userBookCategorySubscriptionRepository.RenameBookCategory(ctx, oldBookCategory, newBookCategory)
+}
+
+func (s *subscriptionService) GetBookTagsSubscribedUserEmails(ctx context.Context, bookTags []string) ([]string, error) {
+	if len(bookTags) == 0 {
+		return []string{}, nil
+	}
+
+	userIDs, err := s.userBookTagSubscriptionRepository.GetSubscriptionUserIDs(ctx, bookTags)
+	if err != nil {
+		return nil, err
+	}
+
+	emails, err := s.userMicroserviceClient.GetEmailsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+func (s *subscriptionService) GetUserSubscribedBookTags(ctx context.Context, userID uint) ([]string, error) {
+	subscribedUserBookTags, err := s.userBookTagSubscriptionRepository.GetUserSubscribedBookTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return subscribedUserBookTags, nil
+}
+
+func (s *subscriptionService) SubscribeToBookTag(ctx context.Context, userID uint, bookTag string) (*domain.UserBookTag, error) {
+	// Catalog normalizes tag names, so "Young Adult" subscribes to "young-adult"
+	tag, err := s.catalogMicroserviceHTTPClient.GetTag(ctx, bookTag)
+	if err != nil {
+		return nil, err
+	}
+
+	userBookTagModel := model.UserBookTag{
+		UserID:  userID,
+		BookTag: tag.Name,
+	}
+	if err := s.userBookTagSubscriptionRepository.Create(ctx, &userBookTagModel); err != nil {
+		return nil, err
+	}
+
+	userBookTagDomain := mapper.UserBookTagToDomain(&userBookTagModel)
+	return &userBookTagDomain, nil
+}
+
+// UnsubscribeFromBookTag doesn't ask catalog-service, because the tag could be removed from all books already
+func (s *subscriptionService) UnsubscribeFromBookTag(ctx context.Context, userID uint, bookTag string) error {
+	return s.userBookTagSubscriptionRepository.Delete(ctx, userID, bookTag)
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/service"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/tracing"
+	grpcInfrastructure "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc"
+	pb "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/microservice/usersubscription"
+)
+
+// UserSubscriptionHandler serves the methods of proto/user-subscription.proto,
+// which aren't shared through library-backend-common
+type UserSubscriptionHandler struct {
+	pb.UnimplementedUserSubscriptionServiceServer
+	config              *config.Config
+	logger              *logging.Logger
+	subscriptionService service.SubscriptionService
+}
+
+func NewUserSubscriptionHandler(
+	config *config.Config,
+	logger *logging.Logger,
+	subscriptionService service.SubscriptionService) *UserSubscriptionHandler {
+	return &UserSubscriptionHandler{
+		config:              config,
+		logger:              logger,
+		subscriptionService: subscriptionService,
+	}
+}
+
+func (h *UserSubscriptionHandler) GetBookTagsSubscribedUserEmails(
+	ctx context.Context,
+	req *pb.GetBookTagsSubscribedUserEmailsRequest,
+) (*pb.GetBookTagsSubscribedUserEmailsResponse, error) {
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetBookTagsSubscribedUserEmails")
+	defer span.End()
+
+	emails, err := h.subscriptionService.GetBookTagsSubscribedUserEmails(ctx, req.GetBookTags())
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get book tags subscribed user emails error", logging.Error(err))
+		return nil, grpcInfrastructure.NewError(err)
+	}
+
+	return &pb.GetBookTagsSubscribedUserEmailsResponse{Emails: emails}, nil
+}
+
+func (h *UserSubscriptionHandler) GetUserSubscribedBookCategories(
+	ctx context.Context,
+	req *pb.GetUserSubscribedBookCategoriesRequest,
+) (*pb.GetUserSubscribedBookCategoriesResponse, error) {
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetUserSubscribedBookCategories")
+	defer span.End()
+
+	bookCategories, err := h.subscriptionService.GetUserSubscribedBookCategories(ctx, uint(req.GetUserId()))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get user subscribed book categories error", logging.Error(err))
+		return nil, grpcInfrastructure.NewError(err)
+	}
+
+	return &pb.GetUserSubscribedBookCategoriesResponse{BookCategories: bookCategories}, nil
+}
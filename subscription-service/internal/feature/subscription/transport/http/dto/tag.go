@@ -0,0 +1,11 @@
+package dto
+
+type UserBookTag struct {
+	ID      uint   `json:"id"`
+	UserID  uint   `json:"userId"`
+	BookTag string `json:"bookTag"`
+}
+
+type SubscribeToBookTagRequest struct {
+	BookTag string `json:"bookTag" binding:"required,max=50"`
+}
@@ -19,6 +19,9 @@ type SubscriptionHandler interface {
 	GetUserSubscribedBookCategories(c *gin.Context)
 	SubscribeToBookCategory(c *gin.Context)
 	UnsubscribeFromBookCategory(c *gin.Context)
+	GetUserSubscribedBookTags(c *gin.Context)
+	SubscribeToBookTag(c *gin.Context)
+	UnsubscribeFromBookTag(c *gin.Context)
 }
 
 type subscriptionHandler struct {
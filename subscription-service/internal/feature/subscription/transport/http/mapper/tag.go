@@ -0,0 +1,14 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/subscription-service/internal/domain"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/http/dto"
+)
+
+func UserBookTagDomainToDTO(userBookTagDomain *domain.UserBookTag) dto.UserBookTag {
+	return dto.UserBookTag{
+		ID:      userBookTagDomain.ID,
+		UserID:  userBookTagDomain.UserID,
+		BookTag: userBookTagDomain.BookTag,
+	}
+}
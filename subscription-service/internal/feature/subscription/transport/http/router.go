@@ -3,9 +3,10 @@ package http
 import (
 	"net/http"
 
-	"github.com/Yarik7610/library-backend-common/transport/http/route"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/subscription-service/docs"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http/route"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -17,23 +18,30 @@ func NewRouter(config *config.Config, metricsHandler http.Handler, subscriptionH
 
 	r.Use(otelgin.Middleware(config.ServiceName,
 		otelgin.WithGinFilter(func(c *gin.Context) bool {
-			return c.FullPath() != route.METRICS
+			return c.FullPath() != sharedRoute.METRICS
 		}),
 	))
 
-	r.GET(route.METRICS, gin.WrapH(metricsHandler))
+	r.GET(sharedRoute.METRICS, gin.WrapH(metricsHandler))
 
 	docs.SwaggerInfo.BasePath = "/"
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	subscriptionGroup := r.Group(route.SUBSCRIPTIONS)
+	subscriptionGroup := r.Group(sharedRoute.SUBSCRIPTIONS)
 	{
-		bookCategoryGroup := subscriptionGroup.Group(route.BOOKS + route.CATEGORIES)
+		bookCategoryGroup := subscriptionGroup.Group(sharedRoute.BOOKS + sharedRoute.CATEGORIES)
 		{
 			bookCategoryGroup.GET("", subscriptionHandler.GetUserSubscribedBookCategories)
 			bookCategoryGroup.POST("", subscriptionHandler.SubscribeToBookCategory)
 			bookCategoryGroup.DELETE("/:categoryName", subscriptionHandler.UnsubscribeFromBookCategory)
 		}
+
+		bookTagGroup := subscriptionGroup.Group(sharedRoute.BOOKS + route.TAGS)
+		{
+			bookTagGroup.GET("", subscriptionHandler.GetUserSubscribedBookTags)
+			bookTagGroup.POST("", subscriptionHandler.SubscribeToBookTag)
+			bookTagGroup.DELETE("/:tagName", subscriptionHandler.UnsubscribeFromBookTag)
+		}
 	}
 
 	return r
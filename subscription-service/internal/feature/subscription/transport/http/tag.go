@@ -0,0 +1,132 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/http/dto"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// GetUserSubscribedBookTags godoc
+//
+//	@Summary		Get book tags the current user is subscribed to
+//	@Description	Returns a list of book tags for the user, newest subscriptions first
+//	@Tags			subscription
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		string
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/subscriptions/books/tags [get]
+func (h *subscriptionHandler) GetUserSubscribedBookTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetUserSubscribedBookTags")
+	defer span.End()
+
+	userSubscribedBookTags, err := h.subscriptionService.GetUserSubscribedBookTags(ctx, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get user subscribed book tags error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, userSubscribedBookTags)
+}
+
+// SubscribeToBookTag godoc
+//
+//	@Summary		Subscribe current user to a book tag
+//	@Description	Adds the book tag to the user's book tag subscriptions. The tag must exist in the catalog, its name is normalized by the catalog
+//	@Tags			subscription
+//	@Param			tag	body	dto.SubscribeToBookTagRequest	true	"Book tag to subscribe"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.UserBookTag
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/subscriptions/books/tags [post]
+func (h *subscriptionHandler) SubscribeToBookTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var subscribeToBookTagDTO dto.SubscribeToBookTagRequest
+	if err := c.ShouldBindJSON(&subscribeToBookTagDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SubscribeToBookTag")
+	defer span.End()
+
+	userBookTagDomain, err := h.subscriptionService.SubscribeToBookTag(ctx, uint(userID), subscribeToBookTagDTO.BookTag)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Subscribe to book tag error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.UserBookTagDomainToDTO(userBookTagDomain))
+}
+
+// UnsubscribeFromBookTag godoc
+//
+//	@Summary		Unsubscribe current user from a book tag
+//	@Description	Removes the book tag from the user's subscriptions
+//	@Tags			subscription
+//	@Param			tagName	path	string	true	"Tag name to unsubscribe"
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204	"No content"
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/subscriptions/books/tags/{tagName} [delete]
+func (h *subscriptionHandler) UnsubscribeFromBookTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	bookTag := c.Param("tagName")
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.UnsubscribeFromBookTag")
+	defer span.End()
+
+	if err := h.subscriptionService.UnsubscribeFromBookTag(ctx, uint(userID), bookTag); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Unsubscribe from book tag error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/service"
+	kafkaInfrastructure "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/broker/kafka"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/broker/kafka/event"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/tracing"
+	"github.com/segmentio/kafka-go"
+)
+
+// CategoryRenamedConsumer keeps subscriptions in sync with category renames made in catalog-service
+type CategoryRenamedConsumer interface {
+	Run(ctx context.Context)
+}
+
+type categoryRenamedConsumer struct {
+	logger                *logging.Logger
+	categoryRenamedReader *kafkaInfrastructure.OtelReader
+	subscriptionService   service.SubscriptionService
+}
+
+func NewCategoryRenamedConsumer(
+	logger *logging.Logger,
+	categoryRenamedReader *kafkaInfrastructure.OtelReader,
+	subscriptionService service.SubscriptionService,
+) CategoryRenamedConsumer {
+	return &categoryRenamedConsumer{
+		logger:                logger,
+		categoryRenamedReader: categoryRenamedReader,
+		subscriptionService:   subscriptionService,
+	}
+}
+
+func (c *categoryRenamedConsumer) Run(ctx context.Context) {
+	defer c.categoryRenamedReader.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		message, spanCtx, span, err := c.categoryRenamedReader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error(ctx, "Category renamed message fetch error", logging.Error(err))
+			continue
+		}
+
+		if err := c.processMessage(spanCtx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			c.logger.Error(spanCtx, "Category renamed message process error", logging.Error(err))
+			span.End()
+			continue
+		}
+
+		if err := c.categoryRenamedReader.CommitMessages(spanCtx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			tracing.Error(span, err)
+			c.logger.Error(spanCtx, "Category renamed message commit error", logging.Any("message", message), logging.Error(err))
+		}
+		span.End()
+	}
+}
+
+func (c *categoryRenamedConsumer) processMessage(ctx context.Context, message kafka.Message) error {
+	var categoryRenamed event.CategoryRenamed
+	if err := json.Unmarshal(message.Value, &categoryRenamed); err != nil {
+		return err
+	}
+
+	// To not inherit kafka's cancelled context already, for cases to process old messages on microservice reboot
+	renameCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	return c.subscriptionService.RenameBookCategory(renameCtx, categoryRenamed.OldName, categoryRenamed.NewName)
+}
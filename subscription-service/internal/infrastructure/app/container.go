@@ -11,12 +11,14 @@ import (
 
 	"github.com/Yarik7610/library-backend-common/microservice"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription"
+	kafkaTransport "github.com/Yarik7610/library-backend/subscription-service/internal/feature/subscription/transport/kafka"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/observability/tracing"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/storage/postgres"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/client/catalog"
 	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/grpc/client/user"
+	httpCatalog "github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http/client/catalog"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 )
@@ -26,6 +28,7 @@ type Container struct {
 	Logger                      *logging.Logger
 	httpServer                  *http.Server
 	gRPCServer                  *grpc.Server
+	categoryRenamedConsumer     kafkaTransport.CategoryRenamedConsumer
 	gRPCUserMicroserviceConn    *grpc.ClientConn
 	gRPCCatalogMicroserviceConn *grpc.ClientConn
 	stopOnce                    sync.Once
@@ -67,9 +70,11 @@ func NewContainer() *Container {
 		)
 	}
 
+	catalogMicroserviceHTTPClient := httpCatalog.NewClient()
+
 	subscriptionFeature, err := subscription.NewFeature(
 		config, logger, postgresDB,
-		catalogMicroserviceClient, userMicroserviceClient,
+		catalogMicroserviceClient, catalogMicroserviceHTTPClient, userMicroserviceClient,
 	)
 	if err != nil {
 		logger.Fatal(context.Background(), "Subscription feature init error", logging.Error(err))
@@ -80,6 +85,7 @@ func NewContainer() *Container {
 		Logger:                      logger,
 		httpServer:                  subscriptionFeature.HTTPServer,
 		gRPCServer:                  subscriptionFeature.GRPCServer,
+		categoryRenamedConsumer:     subscriptionFeature.CategoryRenamedConsumer,
 		gRPCUserMicroserviceConn:    gRPCUserMicroserviceConn,
 		gRPCCatalogMicroserviceConn: gRPCCatalogMicroserviceConn,
 		shutdownTracing:             shutdownTracing,
@@ -105,6 +111,11 @@ func (c *Container) Start() error {
 		return c.gRPCServer.Serve(listener)
 	})
 
+	group.Go(func() error {
+		c.categoryRenamedConsumer.Run(ctx)
+		return nil
+	})
+
 	// Context is canceled the first time a function passed to Goroutine returns a non-nil error
 	// or the first time Wait returns,
 	// whichever occurs first.
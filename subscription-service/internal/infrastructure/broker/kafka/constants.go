@@ -0,0 +1,7 @@
+package kafka
+
+// Topics which are specific to library-backend and aren't shared through library-backend-common
+const (
+	CATEGORY_RENAMED_TOPIC             = "category.renamed"
+	CATEGORY_RENAMED_CONSUMER_GROUP_ID = "category-renamed-consumer-group-id"
+)
@@ -13,6 +13,10 @@ func String(key, val string) slog.Attr {
 	return slog.String(key, val)
 }
 
+func Any(key string, value any) slog.Attr {
+	return slog.Any(key, value)
+}
+
 func Int(key string, value int) slog.Attr {
 	return slog.Int(key, value)
 }
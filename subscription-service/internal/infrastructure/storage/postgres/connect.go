@@ -15,7 +15,7 @@ func Connect(config *config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	if err = db.AutoMigrate(&model.UserBookCategory{}); err != nil {
+	if err = db.AutoMigrate(&model.UserBookCategory{}, &model.UserBookTag{}); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Yarik7610/library-backend-common/microservice"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/subscription-service/internal/infrastructure/transport/http/route"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type Tag struct {
+	ID         uint   `json:"id"`
+	Name       string `json:"name"`
+	BooksCount uint   `json:"booksCount"`
+}
+
+// Client calls public catalog-service HTTP API for things which the gRPC API doesn't expose
+type Client interface {
+	GetTag(ctx context.Context, tagName string) (*Tag, error)
+}
+
+type client struct {
+	catalogServiceURL string
+	httpClient        *http.Client
+}
+
+func NewClient() Client {
+	return &client{
+		catalogServiceURL: microservice.CATALOG_HTTP_ADDRESS,
+		httpClient:        &http.Client{Timeout: 3 * time.Second},
+	}
+}
+
+// GetTag returns the tag with the name normalized by catalog-service
+func (c *client) GetTag(ctx context.Context, tagName string) (*Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+
+	tagURL := c.catalogServiceURL + sharedRoute.CATALOG + route.TAGS + "/" + url.PathEscape(tagName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tagURL, nil)
+	if err != nil {
+		return nil, errs.NewInternalServerError().WithCause(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errs.NewInternalServerError().WithCause(err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var tag Tag
+		if err := json.NewDecoder(resp.Body).Decode(&tag); err != nil {
+			return nil, errs.NewInternalServerError().WithCause(err)
+		}
+		return &tag, nil
+	case http.StatusNotFound:
+		return nil, errs.NewEntityNotFoundError("Book tag")
+	default:
+		return nil, errs.NewInternalServerError().WithCause(fmt.Errorf("unexpected status %d from tag lookup", resp.StatusCode))
+	}
+}
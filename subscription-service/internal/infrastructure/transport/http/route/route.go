@@ -0,0 +1,6 @@
+package route
+
+// Routes which are specific to subscription-service and aren't shared through library-backend-common
+const (
+	TAGS = "/tags"
+)
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+type Session struct {
+	ID         uint
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	IsCurrent  bool
+}
@@ -1,5 +1,14 @@
 package domain
 
+const (
+	ChallengePasswordChange      = "password_change"
+	ChallengeTwoFactor           = "two_factor"
+	ChallengeTwoFactorEnrollment = "two_factor_enrollment"
+)
+
 type Token struct {
-	AccessToken string
+	AccessToken    string
+	Challenge      string
+	ChallengeToken string
+	RecoveryCodes  []string
 }
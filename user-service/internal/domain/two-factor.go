@@ -0,0 +1,6 @@
+package domain
+
+type TwoFactorEnrollment struct {
+	Secret string
+	URI    string
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/metrics"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/oidc"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/password"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres/seed"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -24,12 +26,42 @@ type Feature struct {
 
 func NewFeature(config *config.Config, logger *logging.Logger, postgresDB *gorm.DB) (*Feature, error) {
 	userRepository := postgres.NewUserRepository(postgresDB)
+	externalIdentityRepository := postgres.NewExternalIdentityRepository(postgresDB)
+	oidcAuthorizationRepository := postgres.NewOIDCAuthorizationRepository(postgresDB)
+	recoveryCodeRepository := postgres.NewRecoveryCodeRepository(postgresDB)
+	setupTokenRepository := postgres.NewSetupTokenRepository(postgresDB)
+	sessionRepository := postgres.NewSessionRepository(postgresDB)
+	challengeRepository := postgres.NewChallengeRepository(postgresDB)
 
-	if err := seed.Admin(config, userRepository); err != nil {
+	passwordHasher, err := password.NewHasher(config)
+	if err != nil {
+		return nil, err
+	}
+	passwordPolicy, err := password.NewPolicy(config)
+	if err != nil {
 		return nil, err
 	}
 
-	userService := service.NewUserService(config, userRepository)
+	if err := seed.Admin(config, logger, passwordHasher, userRepository, setupTokenRepository); err != nil {
+		return nil, err
+	}
+
+	oidcProvider := oidc.NewProvider(config)
+
+	userService := service.NewUserService(
+		config,
+		logger,
+		postgresDB,
+		passwordHasher,
+		passwordPolicy,
+		oidcProvider,
+		userRepository,
+		externalIdentityRepository,
+		oidcAuthorizationRepository,
+		recoveryCodeRepository,
+		setupTokenRepository,
+		sessionRepository,
+		challengeRepository)
 
 	metricsHandler, err := metrics.Init()
 	if err != nil {
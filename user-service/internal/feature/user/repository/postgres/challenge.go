@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type ChallengeRepository interface {
+	Create(ctx context.Context, challenge *model.Challenge) error
+	Exists(ctx context.Context, challengeID string) (bool, error)
+	Consume(ctx context.Context, challengeID string) (bool, error)
+	DeleteByUserID(ctx context.Context, userID uint) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type challengeRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewChallengeRepository(db *gorm.DB) ChallengeRepository {
+	return &challengeRepository{name: "Challenge(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *challengeRepository) Create(ctx context.Context, challenge *model.Challenge) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("User").Create(challenge).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *challengeRepository) Exists(ctx context.Context, challengeID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Challenge{}).Where("id = ?", challengeID).Count(&count).Error; err != nil {
+		return false, postgresInfrastructure.NewError(err, r.name)
+	}
+	return count > 0, nil
+}
+
+// Consume deletes the challenge and reports whether it existed, so a challenge token can be redeemed only once
+func (r *challengeRepository) Consume(ctx context.Context, challengeID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).Where("id = ?", challengeID).Delete(&model.Challenge{})
+	if result.Error != nil {
+		return false, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *challengeRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.Challenge{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *challengeRepository) DeleteExpired(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&model.Challenge{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
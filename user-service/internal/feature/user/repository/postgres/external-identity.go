@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type ExternalIdentityRepository interface {
+	WithinTX(tx *gorm.DB) ExternalIdentityRepository
+	Create(ctx context.Context, externalIdentity *model.ExternalIdentity) error
+	FindByIssuerAndSubject(ctx context.Context, issuer, subject string) (*model.ExternalIdentity, error)
+}
+
+type externalIdentityRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewExternalIdentityRepository(db *gorm.DB) ExternalIdentityRepository {
+	return &externalIdentityRepository{name: "External identity(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *externalIdentityRepository) WithinTX(tx *gorm.DB) ExternalIdentityRepository {
+	return &externalIdentityRepository{name: "External identity(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *externalIdentityRepository) Create(ctx context.Context, externalIdentity *model.ExternalIdentity) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("User").Create(externalIdentity).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *externalIdentityRepository) FindByIssuerAndSubject(ctx context.Context, issuer, subject string) (*model.ExternalIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var externalIdentity model.ExternalIdentity
+	if err := r.db.WithContext(ctx).Where("issuer = ? AND subject = ?", issuer, subject).First(&externalIdentity).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &externalIdentity, nil
+}
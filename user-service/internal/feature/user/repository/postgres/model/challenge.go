@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Challenge is a pending sign in step, its ID is the jti of the challenge token
+type Challenge struct {
+	ID        string `gorm:"primarykey"`
+	UserID    uint   `gorm:"not null;index"`
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	User      User `gorm:"constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type ExternalIdentity struct {
+	ID        uint   `gorm:"primarykey"`
+	UserID    uint   `gorm:"not null;index"`
+	Issuer    string `gorm:"not null;uniqueIndex:issuer_subject_index"`
+	Subject   string `gorm:"not null;uniqueIndex:issuer_subject_index"`
+	Email     string
+	CreatedAt time.Time
+	User      User `gorm:"constraint:OnDelete:CASCADE"`
+}
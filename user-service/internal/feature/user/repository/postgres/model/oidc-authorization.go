@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type OIDCAuthorization struct {
+	State        string `gorm:"primarykey"`
+	CodeVerifier string `gorm:"not null"`
+	Nonce        string `gorm:"not null"`
+	LinkUserID   *uint
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type RecoveryCode struct {
+	ID         uint   `gorm:"primarykey"`
+	UserID     uint   `gorm:"not null;index"`
+	HashedCode string `gorm:"not null"`
+	UsedAt     *time.Time
+	CreatedAt  time.Time
+	User       User `gorm:"constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type Session struct {
+	ID         uint `gorm:"primarykey"`
+	UserID     uint `gorm:"not null;index"`
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	User       User `gorm:"constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,9 @@
+package model
+
+import "time"
+
+type SetupToken struct {
+	ID          uint   `gorm:"primarykey"`
+	HashedToken string `gorm:"not null;unique"`
+	CreatedAt   time.Time
+}
@@ -3,10 +3,16 @@ package model
 import "time"
 
 type User struct {
-	ID             uint `gorm:"primarykey"`
-	Name           string
-	Email          string `gorm:"unique"`
-	HashedPassword string `gorm:"column:password"`
-	IsAdmin        bool
-	CreatedAt      time.Time
+	ID                 uint `gorm:"primarykey"`
+	Name               string
+	Email              string `gorm:"unique"`
+	HashedPassword     string `gorm:"column:password"`
+	IsAdmin            bool
+	MustChangePassword bool
+	TOTPSecret         string `gorm:"column:totp_secret"`
+	TOTPEnabled        bool   `gorm:"column:totp_enabled"`
+	TOTPLastStep       int64  `gorm:"column:totp_last_step"`
+	TwoFactorFailures  int
+	TwoFactorLockedAt  *time.Time
+	CreatedAt          time.Time
 }
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OIDCAuthorizationRepository interface {
+	Create(ctx context.Context, oidcAuthorization *model.OIDCAuthorization) error
+	Consume(ctx context.Context, state string) (*model.OIDCAuthorization, error)
+	DeleteExpired(ctx context.Context) error
+}
+
+type oidcAuthorizationRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewOIDCAuthorizationRepository(db *gorm.DB) OIDCAuthorizationRepository {
+	return &oidcAuthorizationRepository{name: "OIDC authorization(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *oidcAuthorizationRepository) Create(ctx context.Context, oidcAuthorization *model.OIDCAuthorization) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Create(oidcAuthorization).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// Consume deletes the authorization and returns it, so every state can be redeemed only once
+func (r *oidcAuthorizationRepository) Consume(ctx context.Context, state string) (*model.OIDCAuthorization, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var oidcAuthorizations []model.OIDCAuthorization
+	result := r.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("state = ?", state).
+		Delete(&oidcAuthorizations)
+	if result.Error != nil {
+		return nil, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if len(oidcAuthorizations) == 0 {
+		return nil, postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return &oidcAuthorizations[0], nil
+}
+
+func (r *oidcAuthorizationRepository) DeleteExpired(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&model.OIDCAuthorization{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
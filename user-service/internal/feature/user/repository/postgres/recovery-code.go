@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type RecoveryCodeRepository interface {
+	WithinTX(tx *gorm.DB) RecoveryCodeRepository
+	Replace(ctx context.Context, userID uint, hashedCodes []string) error
+	DeleteByUserID(ctx context.Context, userID uint) error
+	Use(ctx context.Context, userID uint, hashedCode string) (bool, error)
+}
+
+type recoveryCodeRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewRecoveryCodeRepository(db *gorm.DB) RecoveryCodeRepository {
+	return &recoveryCodeRepository{name: "Recovery code(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *recoveryCodeRepository) WithinTX(tx *gorm.DB) RecoveryCodeRepository {
+	return &recoveryCodeRepository{name: "Recovery code(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *recoveryCodeRepository) Replace(ctx context.Context, userID uint, hashedCodes []string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+
+	recoveryCodes := make([]model.RecoveryCode, len(hashedCodes))
+	for i, hashedCode := range hashedCodes {
+		recoveryCodes[i] = model.RecoveryCode{UserID: userID, HashedCode: hashedCode}
+	}
+	if err := r.db.WithContext(ctx).Omit("User").Create(&recoveryCodes).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *recoveryCodeRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// Use marks an unused code as used and reports whether such code existed
+func (r *recoveryCodeRepository) Use(ctx context.Context, userID uint, hashedCode string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND hashed_code = ? AND used_at IS NULL", userID, hashedCode).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *model.Session) error
+	FindActiveByID(ctx context.Context, sessionID uint) (*model.Session, error)
+	GetActiveByUserID(ctx context.Context, userID uint) ([]model.Session, error)
+	UpdateActivity(ctx context.Context, sessionID uint, ip string, lastSeenAt time.Time) error
+	Revoke(ctx context.Context, userID, sessionID uint) error
+}
+
+type sessionRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) SessionRepository {
+	return &sessionRepository{name: "Session(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session *model.Session) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Omit("User").Create(session).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *sessionRepository) FindActiveByID(ctx context.Context, sessionID uint) (*model.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var session model.Session
+	if err := r.db.WithContext(ctx).
+		Where("id = ? AND revoked_at IS NULL AND expires_at > ?", sessionID, time.Now()).
+		First(&session).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return &session, nil
+}
+
+func (r *sessionRepository) GetActiveByUserID(ctx context.Context, userID uint) ([]model.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var sessions []model.Session
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, postgresInfrastructure.NewError(err, r.name)
+	}
+	return sessions, nil
+}
+
+func (r *sessionRepository) UpdateActivity(ctx context.Context, sessionID uint, ip string, lastSeenAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.Session{}).
+		Where("id = ?", sessionID).
+		Updates(map[string]any{"ip": ip, "last_seen_at": lastSeenAt}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+func (r *sessionRepository) Revoke(ctx context.Context, userID, sessionID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.Session{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if result.RowsAffected == 0 {
+		return postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return nil
+}
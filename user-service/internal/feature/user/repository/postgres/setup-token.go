@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
+	"gorm.io/gorm"
+)
+
+type SetupTokenRepository interface {
+	WithinTX(tx *gorm.DB) SetupTokenRepository
+	Replace(ctx context.Context, hashedToken string) error
+	Consume(ctx context.Context, hashedToken string) (bool, error)
+	Exists(ctx context.Context) (bool, error)
+	DeleteAll(ctx context.Context) error
+}
+
+type setupTokenRepository struct {
+	name    string
+	timeout time.Duration
+	db      *gorm.DB
+}
+
+func NewSetupTokenRepository(db *gorm.DB) SetupTokenRepository {
+	return &setupTokenRepository{name: "Setup token(s)", timeout: 1 * time.Second, db: db}
+}
+
+func (r *setupTokenRepository) WithinTX(tx *gorm.DB) SetupTokenRepository {
+	return &setupTokenRepository{name: "Setup token(s)", timeout: 1 * time.Second, db: tx}
+}
+
+func (r *setupTokenRepository) Replace(ctx context.Context, hashedToken string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("1 = 1").Delete(&model.SetupToken{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	if err := r.db.WithContext(ctx).Create(&model.SetupToken{HashedToken: hashedToken}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// Consume deletes the token and reports whether it existed, so a token can be used only once
+func (r *setupTokenRepository) Consume(ctx context.Context, hashedToken string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).Where("hashed_token = ?", hashedToken).Delete(&model.SetupToken{})
+	if result.Error != nil {
+		return false, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Exists reports whether a setup token is still waiting to be consumed
+func (r *setupTokenRepository) Exists(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.SetupToken{}).Count(&count).Error; err != nil {
+		return false, postgresInfrastructure.NewError(err, r.name)
+	}
+	return count > 0, nil
+}
+
+func (r *setupTokenRepository) DeleteAll(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Where("1 = 1").Delete(&model.SetupToken{}).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
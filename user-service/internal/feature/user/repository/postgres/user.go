@@ -8,14 +8,20 @@ import (
 
 	postgresInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/storage/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserRepository interface {
+	WithinTX(tx *gorm.DB) UserRepository
 	Create(ctx context.Context, user *model.User) error
+	Update(ctx context.Context, user *model.User) error
+	AdvanceTOTPStep(ctx context.Context, userID uint, step int64) (bool, error)
+	RecordTwoFactorFailure(ctx context.Context, userID uint, maxFailures int) (bool, error)
+	ResetTwoFactorFailures(ctx context.Context, userID uint) error
 	FindByID(ctx context.Context, userID uint) (*model.User, error)
 	FindByEmail(cxt context.Context, email string) (*model.User, error)
 	GetEmailsByUserIDs(ctx context.Context, userIDs []uint) ([]string, error)
-	Count(ctx context.Context) (int64, error)
+	CountAdmins(ctx context.Context) (int64, error)
 }
 
 type userRepository struct {
@@ -28,6 +34,10 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{name: "User(s)", timeout: 1 * time.Second, db: db}
 }
 
+func (r *userRepository) WithinTX(tx *gorm.DB) UserRepository {
+	return &userRepository{name: "User(s)", timeout: 1 * time.Second, db: tx}
+}
+
 func (r *userRepository) Create(ctx context.Context, user *model.User) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -38,6 +48,67 @@ func (r *userRepository) Create(ctx context.Context, user *model.User) error {
 	return nil
 }
 
+func (r *userRepository) Update(ctx context.Context, user *model.User) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Save(user).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
+// AdvanceTOTPStep moves the last used TOTP step forward and reports whether it was behind,
+// so concurrent sign ins with the same code can't both pass
+func (r *userRepository) AdvanceTOTPStep(ctx context.Context, userID uint, step int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ? AND totp_last_step < ?", userID, step).
+		Update("totp_last_step", step)
+	if result.Error != nil {
+		return false, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RecordTwoFactorFailure counts a wrong two-factor code and reports whether it reached maxFailures.
+// Reaching it starts a lockout from now and the count starts over, both in one statement,
+// so concurrent guesses can't slip past the limit
+func (r *userRepository) RecordTwoFactorFailure(ctx context.Context, userID uint, maxFailures int) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var users []model.User
+	result := r.db.WithContext(ctx).
+		Model(&users).
+		Clauses(clause.Returning{}).
+		Where("id = ?", userID).
+		Updates(map[string]any{
+			"two_factor_failures":  gorm.Expr("CASE WHEN two_factor_failures + 1 >= ? THEN 0 ELSE two_factor_failures + 1 END", maxFailures),
+			"two_factor_locked_at": gorm.Expr("CASE WHEN two_factor_failures + 1 >= ? THEN NOW() ELSE two_factor_locked_at END", maxFailures),
+		})
+	if result.Error != nil {
+		return false, postgresInfrastructure.NewError(result.Error, r.name)
+	}
+	if len(users) == 0 {
+		return false, postgresInfrastructure.NewError(gorm.ErrRecordNotFound, r.name)
+	}
+	return users[0].TwoFactorFailures == 0, nil
+}
+
+func (r *userRepository) ResetTwoFactorFailures(ctx context.Context, userID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", userID).Update("two_factor_failures", 0).Error; err != nil {
+		return postgresInfrastructure.NewError(err, r.name)
+	}
+	return nil
+}
+
 func (r *userRepository) FindByID(ctx context.Context, userID uint) (*model.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -71,12 +142,12 @@ func (r *userRepository) GetEmailsByUserIDs(ctx context.Context, userIDs []uint)
 	return emails, nil
 }
 
-func (r *userRepository) Count(ctx context.Context) (int64, error) {
+func (r *userRepository) CountAdmins(ctx context.Context) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&model.User{}).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&model.User{}).Where("is_admin = ?", true).Count(&count).Error; err != nil {
 		return 0, postgresInfrastructure.NewError(err, r.name)
 	}
 	return count, nil
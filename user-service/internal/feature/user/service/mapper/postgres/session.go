@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+)
+
+func SessionModelToDomain(sessionModel *model.Session, currentSessionID uint) domain.Session {
+	return domain.Session{
+		ID:         sessionModel.ID,
+		UserAgent:  sessionModel.UserAgent,
+		IP:         sessionModel.IP,
+		CreatedAt:  sessionModel.CreatedAt,
+		LastSeenAt: sessionModel.LastSeenAt,
+		ExpiresAt:  sessionModel.ExpiresAt,
+		IsCurrent:  sessionModel.ID == currentSessionID,
+	}
+}
+
+func SessionModelsToDomains(sessionModels []model.Session, currentSessionID uint) []domain.Session {
+	sessionDomains := make([]domain.Session, len(sessionModels))
+	for i := range sessionModels {
+		sessionDomains[i] = SessionModelToDomain(&sessionModels[i], currentSessionID)
+	}
+	return sessionDomains
+}
@@ -3,7 +3,6 @@ package mapper
 import (
 	"github.com/Yarik7610/library-backend/user-service/internal/domain"
 	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
-	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/password"
 )
 
 func UserModelToDomain(userModel *model.User) domain.User {
@@ -15,17 +14,12 @@ func UserModelToDomain(userModel *model.User) domain.User {
 	}
 }
 
-func UserDomainToModel(userDomain *domain.User) (model.User, error) {
-	hashedPassword, err := password.GenerateHash(userDomain.RawPassword)
-	if err != nil {
-		return model.User{}, err
-	}
-
+func UserDomainToModel(userDomain *domain.User, hashedPassword string) model.User {
 	return model.User{
 		ID:             userDomain.ID,
 		Name:           userDomain.Name,
 		Email:          userDomain.Email,
 		IsAdmin:        userDomain.IsAdmin,
 		HashedPassword: hashedPassword,
-	}, nil
+	}
 }
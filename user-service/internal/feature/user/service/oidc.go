@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/oidc"
+	"gorm.io/gorm"
+)
+
+func (s *userService) GetOIDCAuthorizationURL(ctx context.Context) (string, error) {
+	return s.newOIDCAuthorizationURL(ctx, nil)
+}
+
+// GetOIDCLinkURL starts the same flow for a signed in user, its callback links the external identity to that user
+func (s *userService) GetOIDCLinkURL(ctx context.Context, userID uint) (string, error) {
+	if _, err := s.userRepository.FindByID(ctx, userID); err != nil {
+		return "", err
+	}
+	return s.newOIDCAuthorizationURL(ctx, &userID)
+}
+
+func (s *userService) newOIDCAuthorizationURL(ctx context.Context, linkUserID *uint) (string, error) {
+	state, err := oidc.NewRandomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := oidc.NewRandomString()
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := oidc.NewRandomString()
+	if err != nil {
+		return "", err
+	}
+
+	authorizationURL, err := s.oidcProvider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		if errors.Is(err, oidc.ErrNotConfigured) {
+			return "", newOIDCError(err)
+		}
+		return "", errs.NewInternalServerError().WithCause(err)
+	}
+
+	if err := s.oidcAuthorizationRepository.DeleteExpired(ctx); err != nil {
+		return "", err
+	}
+
+	oidcAuthorizationModel := model.OIDCAuthorization{
+		State:        state,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		LinkUserID:   linkUserID,
+		ExpiresAt:    time.Now().Add(10 * time.Minute),
+	}
+	if err := s.oidcAuthorizationRepository.Create(ctx, &oidcAuthorizationModel); err != nil {
+		return "", err
+	}
+
+	return authorizationURL, nil
+}
+
+func (s *userService) SignInWithOIDC(ctx context.Context, code, state string, clientDomain *domain.Client) (*domain.Token, error) {
+	oidcAuthorizationModel, err := s.oidcAuthorizationRepository.Consume(ctx, state)
+	if err != nil {
+		var infrastructureError *errs.Error
+		if errors.As(err, &infrastructureError) && infrastructureError.Code == errs.CodeNotFound {
+			return nil, errs.NewBadRequestError("Invalid or expired OIDC state")
+		}
+		return nil, err
+	}
+	if time.Now().After(oidcAuthorizationModel.ExpiresAt) {
+		return nil, errs.NewBadRequestError("Invalid or expired OIDC state")
+	}
+
+	claims, err := s.oidcProvider.Exchange(ctx, code, oidcAuthorizationModel.CodeVerifier, oidcAuthorizationModel.Nonce)
+	if err != nil {
+		return nil, newOIDCError(err)
+	}
+
+	var userModel *model.User
+	if oidcAuthorizationModel.LinkUserID != nil {
+		userModel, err = s.linkOIDCUser(ctx, *oidcAuthorizationModel.LinkUserID, claims)
+	} else {
+		userModel, err = s.findOrCreateOIDCUser(ctx, claims)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueToken(ctx, userModel, clientDomain)
+}
+
+// findOrCreateOIDCUser resolves the external identity to a local user, unknown identities get a newly created user.
+// They are never linked to an existing user by email, whoever controls the email at the identity provider
+// would take the account over. Existing users link identities from their session instead
+func (s *userService) findOrCreateOIDCUser(ctx context.Context, claims *oidc.IDTokenClaims) (*model.User, error) {
+	issuer := s.oidcProvider.Issuer()
+
+	externalIdentityModel, err := s.externalIdentityRepository.FindByIssuerAndSubject(ctx, issuer, claims.Subject)
+	if err == nil {
+		return s.userRepository.FindByID(ctx, externalIdentityModel.UserID)
+	}
+	var infrastructureError *errs.Error
+	if !errors.As(err, &infrastructureError) || infrastructureError.Code != errs.CodeNotFound {
+		return nil, err
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, errs.NewBadRequestError("Identity provider didn't return a verified email")
+	}
+
+	var userModel *model.User
+	err = s.postgresDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userRepositoryTX := s.userRepository.WithinTX(tx)
+		externalIdentityRepositoryTX := s.externalIdentityRepository.WithinTX(tx)
+
+		_, err := userRepositoryTX.FindByEmail(ctx, claims.Email)
+		if err == nil {
+			return errs.NewError(errs.CodeAlreadyExists, "User with this email already exists, sign in and link the identity provider to it first")
+		}
+		if !errors.As(err, &infrastructureError) || infrastructureError.Code != errs.CodeNotFound {
+			return err
+		}
+
+		if err := s.ensureSetupCompleted(ctx); err != nil {
+			return err
+		}
+
+		userModel = &model.User{Name: oidcUserName(claims), Email: claims.Email}
+		if err := userRepositoryTX.Create(ctx, userModel); err != nil {
+			return err
+		}
+
+		return externalIdentityRepositoryTX.Create(ctx, &model.ExternalIdentity{
+			UserID:  userModel.ID,
+			Issuer:  issuer,
+			Subject: claims.Subject,
+			Email:   claims.Email,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userModel, nil
+}
+
+// linkOIDCUser links the external identity to the user who started the flow from their session.
+// Identities already linked to another user are refused
+func (s *userService) linkOIDCUser(ctx context.Context, userID uint, claims *oidc.IDTokenClaims) (*model.User, error) {
+	issuer := s.oidcProvider.Issuer()
+
+	externalIdentityModel, err := s.externalIdentityRepository.FindByIssuerAndSubject(ctx, issuer, claims.Subject)
+	if err == nil {
+		if externalIdentityModel.UserID != userID {
+			return nil, errs.NewError(errs.CodeAlreadyExists, "Identity is already linked to another user")
+		}
+		return s.userRepository.FindByID(ctx, userID)
+	}
+	var infrastructureError *errs.Error
+	if !errors.As(err, &infrastructureError) || infrastructureError.Code != errs.CodeNotFound {
+		return nil, err
+	}
+
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.externalIdentityRepository.Create(ctx, &model.ExternalIdentity{
+		UserID:  userModel.ID,
+		Issuer:  issuer,
+		Subject: claims.Subject,
+		Email:   claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userModel, nil
+}
+
+func oidcUserName(claims *oidc.IDTokenClaims) string {
+	if claims.Name != "" {
+		return claims.Name
+	}
+	if claims.PreferredUsername != "" {
+		return claims.PreferredUsername
+	}
+	name, _, _ := strings.Cut(claims.Email, "@")
+	return name
+}
+
+func newOIDCError(err error) error {
+	if errors.Is(err, oidc.ErrNotConfigured) {
+		return errs.NewBadRequestError("OIDC sign in is not configured").WithCause(err)
+	}
+	return errs.NewBadRequestError("OIDC sign in failed").WithCause(err)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+)
+
+func (s *userService) ChangePassword(ctx context.Context, userID uint, currentPassword, newPassword string) error {
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !s.passwordHasher.CompareHashAndRaw(userModel.HashedPassword, currentPassword) {
+		return errs.NewBadRequestError("Wrong current password")
+	}
+
+	return s.setPassword(ctx, userModel, newPassword)
+}
+
+// ChangePasswordByChallenge finishes the forced password change at sign in and continues the sign in flow
+func (s *userService) ChangePasswordByChallenge(ctx context.Context, challengeToken, newPassword string, clientDomain *domain.Client) (*domain.Token, error) {
+	userID, _, challengeID, err := s.verifyChallenge(ctx, challengeToken, domain.ChallengePasswordChange)
+	if err != nil {
+		return nil, err
+	}
+
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userModel.MustChangePassword {
+		return nil, errs.NewBadRequestError("Password change isn't required")
+	}
+
+	if err := s.setPassword(ctx, userModel, newPassword); err != nil {
+		return nil, err
+	}
+	if err := s.consumeChallenge(ctx, challengeID); err != nil {
+		return nil, err
+	}
+	return s.issueToken(ctx, userModel, clientDomain)
+}
+
+func (s *userService) setPassword(ctx context.Context, userModel *model.User, newPassword string) error {
+	if err := s.passwordPolicy.Validate(newPassword, userModel.Email); err != nil {
+		return err
+	}
+
+	if s.passwordHasher.CompareHashAndRaw(userModel.HashedPassword, newPassword) {
+		return errs.NewBadRequestError("New password must differ from the current one")
+	}
+
+	hashedPassword, err := s.passwordHasher.GenerateHash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	userModel.HashedPassword = hashedPassword
+	userModel.MustChangePassword = false
+	return s.userRepository.Update(ctx, userModel)
+}
@@ -5,35 +5,99 @@ import (
 
 	"github.com/Yarik7610/library-backend/user-service/internal/domain"
 	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
 	mapper "github.com/Yarik7610/library-backend/user-service/internal/feature/user/service/mapper/postgres"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
-	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/jwt"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/oidc"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/password"
+	"gorm.io/gorm"
 )
 
 type UserService interface {
 	SignUp(ctx context.Context, userDomain *domain.User) error
-	SignIn(ctx context.Context, userDomain *domain.User) (*domain.Token, error)
+	SignIn(ctx context.Context, userDomain *domain.User, clientDomain *domain.Client) (*domain.Token, error)
 	GetMe(ctx context.Context, userID uint) (*domain.User, error)
 	GetEmailsByUserIDs(ctx context.Context, userIDs []uint) ([]string, error)
+	GetOIDCAuthorizationURL(ctx context.Context) (string, error)
+	GetOIDCLinkURL(ctx context.Context, userID uint) (string, error)
+	SignInWithOIDC(ctx context.Context, code, state string, clientDomain *domain.Client) (*domain.Token, error)
+	VerifyTwoFactor(ctx context.Context, challengeToken, code string, clientDomain *domain.Client) (*domain.Token, error)
+	StartTwoFactorEnrollment(ctx context.Context, userID uint) (*domain.TwoFactorEnrollment, error)
+	StartTwoFactorEnrollmentByChallenge(ctx context.Context, challengeToken string) (*domain.TwoFactorEnrollment, error)
+	ConfirmTwoFactorEnrollment(ctx context.Context, userID uint, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, userID uint, code string) error
+	ChangePassword(ctx context.Context, userID uint, currentPassword, newPassword string) error
+	ChangePasswordByChallenge(ctx context.Context, challengeToken, newPassword string, clientDomain *domain.Client) (*domain.Token, error)
+	Setup(ctx context.Context, setupToken string, userDomain *domain.User) error
+	GetSessions(ctx context.Context, userID, currentSessionID uint) ([]domain.Session, error)
+	DeleteSession(ctx context.Context, userID, sessionID uint) error
+	TouchSession(ctx context.Context, sessionID uint, ip string) error
 }
 
 type userService struct {
-	config         *config.Config
-	userRepository postgres.UserRepository
+	config                      *config.Config
+	logger                      *logging.Logger
+	postgresDB                  *gorm.DB
+	passwordHasher              *password.Hasher
+	passwordPolicy              *password.Policy
+	oidcProvider                *oidc.Provider
+	userRepository              postgres.UserRepository
+	externalIdentityRepository  postgres.ExternalIdentityRepository
+	oidcAuthorizationRepository postgres.OIDCAuthorizationRepository
+	recoveryCodeRepository      postgres.RecoveryCodeRepository
+	setupTokenRepository        postgres.SetupTokenRepository
+	sessionRepository           postgres.SessionRepository
+	challengeRepository         postgres.ChallengeRepository
 }
 
-func NewUserService(config *config.Config, userRepository postgres.UserRepository) UserService {
-	return &userService{config: config, userRepository: userRepository}
+func NewUserService(
+	config *config.Config,
+	logger *logging.Logger,
+	postgresDB *gorm.DB,
+	passwordHasher *password.Hasher,
+	passwordPolicy *password.Policy,
+	oidcProvider *oidc.Provider,
+	userRepository postgres.UserRepository,
+	externalIdentityRepository postgres.ExternalIdentityRepository,
+	oidcAuthorizationRepository postgres.OIDCAuthorizationRepository,
+	recoveryCodeRepository postgres.RecoveryCodeRepository,
+	setupTokenRepository postgres.SetupTokenRepository,
+	sessionRepository postgres.SessionRepository,
+	challengeRepository postgres.ChallengeRepository) UserService {
+	return &userService{
+		config:                      config,
+		logger:                      logger,
+		postgresDB:                  postgresDB,
+		passwordHasher:              passwordHasher,
+		passwordPolicy:              passwordPolicy,
+		oidcProvider:                oidcProvider,
+		userRepository:              userRepository,
+		externalIdentityRepository:  externalIdentityRepository,
+		oidcAuthorizationRepository: oidcAuthorizationRepository,
+		recoveryCodeRepository:      recoveryCodeRepository,
+		setupTokenRepository:        setupTokenRepository,
+		sessionRepository:           sessionRepository,
+		challengeRepository:         challengeRepository,
+	}
 }
 
 func (s *userService) SignUp(ctx context.Context, userDomain *domain.User) error {
-	userModel, err := mapper.UserDomainToModel(userDomain)
+	if err := s.ensureSetupCompleted(ctx); err != nil {
+		return err
+	}
+
+	if err := s.passwordPolicy.Validate(userDomain.RawPassword, userDomain.Email); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.passwordHasher.GenerateHash(userDomain.RawPassword)
 	if err != nil {
 		return err
 	}
 
+	userModel := mapper.UserDomainToModel(userDomain, hashedPassword)
 	if err = s.userRepository.Create(ctx, &userModel); err != nil {
 		return err
 	}
@@ -43,21 +107,21 @@ func (s *userService) SignUp(ctx context.Context, userDomain *domain.User) error
 	return nil
 }
 
-func (s *userService) SignIn(ctx context.Context, userDomain *domain.User) (*domain.Token, error) {
+func (s *userService) SignIn(ctx context.Context, userDomain *domain.User, clientDomain *domain.Client) (*domain.Token, error) {
 	foundUser, err := s.userRepository.FindByEmail(ctx, userDomain.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	if !password.CompareHashAndRaw(foundUser.HashedPassword, userDomain.RawPassword) {
+	if !s.passwordHasher.CompareHashAndRaw(foundUser.HashedPassword, userDomain.RawPassword) {
 		return nil, errs.NewBadRequestError("Wrong email or password")
 	}
 
-	accessToken, err := jwt.Create(s.config, foundUser.ID, foundUser.IsAdmin)
-	if err != nil {
-		return nil, err
+	if s.passwordHasher.NeedsRehash(foundUser.HashedPassword) {
+		s.rehashPassword(ctx, foundUser, userDomain.RawPassword)
 	}
-	return &domain.Token{AccessToken: accessToken}, nil
+
+	return s.issueToken(ctx, foundUser, clientDomain)
 }
 
 func (s *userService) GetMe(ctx context.Context, userID uint) (*domain.User, error) {
@@ -80,3 +144,18 @@ func (s *userService) GetEmailsByUserIDs(ctx context.Context, userIDs []uint) ([
 	}
 	return emails, nil
 }
+
+// rehashPassword upgrades a hash made with outdated algorithm or parameters while the raw password is known.
+// Failures are only logged, because the old hash is still valid
+func (s *userService) rehashPassword(ctx context.Context, userModel *model.User, rawPassword string) {
+	hashedPassword, err := s.passwordHasher.GenerateHash(rawPassword)
+	if err != nil {
+		s.logger.Warn(ctx, "Skip password rehash", logging.Int("userID", int(userModel.ID)), logging.Error(err))
+		return
+	}
+
+	userModel.HashedPassword = hashedPassword
+	if err := s.userRepository.Update(ctx, userModel); err != nil {
+		s.logger.Warn(ctx, "Skip password rehash", logging.Int("userID", int(userModel.ID)), logging.Error(err))
+	}
+}
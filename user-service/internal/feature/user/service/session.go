@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	mapper "github.com/Yarik7610/library-backend/user-service/internal/feature/user/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/jwt"
+)
+
+const (
+	maxUserAgentLength         = 512
+	sessionActivityGranularity = time.Minute
+)
+
+// createAccessToken starts a new session, so the token can be listed and revoked later
+func (s *userService) createAccessToken(ctx context.Context, userModel *model.User, clientDomain *domain.Client) (*domain.Token, error) {
+	now := time.Now()
+
+	userAgent := clientDomain.UserAgent
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	sessionModel := model.Session{
+		UserID:     userModel.ID,
+		UserAgent:  userAgent,
+		IP:         clientDomain.IP,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(time.Second * time.Duration(s.config.JWTExpirationSeconds)),
+	}
+	if err := s.sessionRepository.Create(ctx, &sessionModel); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := jwt.Create(s.config, userModel.ID, userModel.IsAdmin, sessionModel.ID, sessionModel.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Token{AccessToken: accessToken}, nil
+}
+
+func (s *userService) GetSessions(ctx context.Context, userID, currentSessionID uint) ([]domain.Session, error) {
+	sessionModels, err := s.sessionRepository.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.SessionModelsToDomains(sessionModels, currentSessionID), nil
+}
+
+func (s *userService) DeleteSession(ctx context.Context, userID, sessionID uint) error {
+	return s.sessionRepository.Revoke(ctx, userID, sessionID)
+}
+
+// TouchSession fails with not found error for revoked or expired sessions.
+// Last seen data is written at most once per sessionActivityGranularity to keep the gateway check cheap
+func (s *userService) TouchSession(ctx context.Context, sessionID uint, ip string) error {
+	sessionModel, err := s.sessionRepository.FindActiveByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if now.Sub(sessionModel.LastSeenAt) < sessionActivityGranularity && sessionModel.IP == ip {
+		return nil
+	}
+	return s.sessionRepository.UpdateActivity(ctx, sessionID, ip, now)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	mapper "github.com/Yarik7610/library-backend/user-service/internal/feature/user/service/mapper/postgres"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/password"
+	"gorm.io/gorm"
+)
+
+// Setup creates the first admin using the one-time token logged by seed.Admin
+func (s *userService) Setup(ctx context.Context, setupToken string, userDomain *domain.User) error {
+	userDomain.IsAdmin = true
+
+	if err := s.passwordPolicy.Validate(userDomain.RawPassword, userDomain.Email); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.passwordHasher.GenerateHash(userDomain.RawPassword)
+	if err != nil {
+		return err
+	}
+
+	userModel := mapper.UserDomainToModel(userDomain, hashedPassword)
+
+	err = s.postgresDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userRepositoryTX := s.userRepository.WithinTX(tx)
+
+		consumed, err := s.setupTokenRepository.WithinTX(tx).Consume(ctx, password.HashToken(setupToken))
+		if err != nil {
+			return err
+		}
+		if !consumed {
+			return errs.NewBadRequestError("Invalid or already used setup token")
+		}
+
+		adminsCount, err := userRepositoryTX.CountAdmins(ctx)
+		if err != nil {
+			return err
+		}
+		if adminsCount != 0 {
+			return errs.NewBadRequestError("Setup is already completed")
+		}
+
+		return userRepositoryTX.Create(ctx, &userModel)
+	})
+	if err != nil {
+		return err
+	}
+
+	userDomain.ID = userModel.ID
+	return nil
+}
+
+// ensureSetupCompleted closes sign up while the setup token is pending, so the first user is always the admin
+func (s *userService) ensureSetupCompleted(ctx context.Context) error {
+	pending, err := s.setupTokenRepository.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if pending {
+		return errs.NewBadRequestError("Sign up is closed until the admin completes setup")
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/jwt"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/totp"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// Wrong codes allowed before two-factor verification is locked, a TOTP code is one of a million
+	MAX_TWO_FACTOR_FAILURES = 5
+	TWO_FACTOR_LOCKOUT      = 15 * time.Minute
+)
+
+// issueToken returns an access token for a user who passed the first sign in step,
+// or a challenge token when the user still has to change the password or pass (or enroll into) two-factor authentication
+func (s *userService) issueToken(ctx context.Context, userModel *model.User, clientDomain *domain.Client) (*domain.Token, error) {
+	if userModel.MustChangePassword {
+		return s.createChallenge(ctx, userModel.ID, domain.ChallengePasswordChange)
+	}
+	if userModel.TOTPEnabled {
+		return s.createChallenge(ctx, userModel.ID, domain.ChallengeTwoFactor)
+	}
+	if s.config.RequireAdminTwoFactor && userModel.IsAdmin {
+		return s.createChallenge(ctx, userModel.ID, domain.ChallengeTwoFactorEnrollment)
+	}
+	return s.createAccessToken(ctx, userModel, clientDomain)
+}
+
+// createChallenge stores the challenge next to signing it, so its token is accepted only while the challenge is pending
+func (s *userService) createChallenge(ctx context.Context, userID uint, challenge string) (*domain.Token, error) {
+	if err := s.challengeRepository.DeleteExpired(ctx); err != nil {
+		return nil, err
+	}
+
+	challengeModel := model.Challenge{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(jwt.CHALLENGE_EXPIRATION),
+	}
+	if err := s.challengeRepository.Create(ctx, &challengeModel); err != nil {
+		return nil, err
+	}
+
+	challengeToken, err := jwt.CreateChallenge(s.config, challengeModel.ID, userID, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Token{Challenge: challenge, ChallengeToken: challengeToken}, nil
+}
+
+// verifyChallenge returns the user ID, the challenge and the challenge ID of a pending challenge token
+func (s *userService) verifyChallenge(ctx context.Context, challengeToken string, challenges ...string) (uint, string, string, error) {
+	for _, challenge := range challenges {
+		userID, challengeID, err := jwt.VerifyChallenge(s.config, challengeToken, challenge)
+		if err != nil {
+			continue
+		}
+
+		pending, err := s.challengeRepository.Exists(ctx, challengeID)
+		if err != nil {
+			return 0, "", "", err
+		}
+		if !pending {
+			break
+		}
+		return userID, challenge, challengeID, nil
+	}
+	return 0, "", "", errs.NewBadRequestError("Invalid or expired challenge token")
+}
+
+// consumeChallenge redeems the challenge once its step is passed, so the token can't be replayed
+func (s *userService) consumeChallenge(ctx context.Context, challengeID string) error {
+	consumed, err := s.challengeRepository.Consume(ctx, challengeID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return errs.NewBadRequestError("Invalid or expired challenge token")
+	}
+	return nil
+}
+
+func (s *userService) VerifyTwoFactor(ctx context.Context, challengeToken, code string, clientDomain *domain.Client) (*domain.Token, error) {
+	userID, challenge, challengeID, err := s.verifyChallenge(ctx, challengeToken, domain.ChallengeTwoFactor, domain.ChallengeTwoFactorEnrollment)
+	if err != nil {
+		return nil, err
+	}
+
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if challenge == domain.ChallengeTwoFactorEnrollment && !userModel.TOTPEnabled {
+		recoveryCodes, err = s.confirmTwoFactorEnrollment(ctx, userModel, code)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := s.verifyTwoFactorCode(ctx, userModel, code); err != nil {
+		return nil, err
+	}
+
+	if err := s.consumeChallenge(ctx, challengeID); err != nil {
+		return nil, err
+	}
+
+	tokenDomain, err := s.createAccessToken(ctx, userModel, clientDomain)
+	if err != nil {
+		return nil, err
+	}
+	tokenDomain.RecoveryCodes = recoveryCodes
+	return tokenDomain, nil
+}
+
+func (s *userService) StartTwoFactorEnrollment(ctx context.Context, userID uint) (*domain.TwoFactorEnrollment, error) {
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel.TOTPEnabled {
+		return nil, errs.NewEntityAlreadyExistsError("Two-factor authentication")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	userModel.TOTPSecret = secret
+	if err := s.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorEnrollment{
+		Secret: secret,
+		URI:    totp.URI(s.config.TOTPIssuer, userModel.Email, secret),
+	}, nil
+}
+
+func (s *userService) StartTwoFactorEnrollmentByChallenge(ctx context.Context, challengeToken string) (*domain.TwoFactorEnrollment, error) {
+	userID, _, _, err := s.verifyChallenge(ctx, challengeToken, domain.ChallengeTwoFactorEnrollment)
+	if err != nil {
+		return nil, err
+	}
+	return s.StartTwoFactorEnrollment(ctx, userID)
+}
+
+func (s *userService) ConfirmTwoFactorEnrollment(ctx context.Context, userID uint, code string) ([]string, error) {
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel.TOTPEnabled {
+		return nil, errs.NewEntityAlreadyExistsError("Two-factor authentication")
+	}
+	return s.confirmTwoFactorEnrollment(ctx, userModel, code)
+}
+
+func (s *userService) DisableTwoFactor(ctx context.Context, userID uint, code string) error {
+	userModel, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !userModel.TOTPEnabled {
+		return errs.NewBadRequestError("Two-factor authentication isn't enabled")
+	}
+	if s.config.RequireAdminTwoFactor && userModel.IsAdmin {
+		return errs.NewBadRequestError("Two-factor authentication is required for admins")
+	}
+
+	if err := s.verifyTwoFactorCode(ctx, userModel, code); err != nil {
+		return err
+	}
+
+	return s.postgresDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userModel.TOTPSecret = ""
+		userModel.TOTPEnabled = false
+		userModel.TOTPLastStep = 0
+		if err := s.userRepository.WithinTX(tx).Update(ctx, userModel); err != nil {
+			return err
+		}
+		return s.recoveryCodeRepository.WithinTX(tx).DeleteByUserID(ctx, userModel.ID)
+	})
+}
+
+// confirmTwoFactorEnrollment enables two-factor authentication after the first valid code
+// and returns freshly generated recovery codes, which are shown to the user only once
+func (s *userService) confirmTwoFactorEnrollment(ctx context.Context, userModel *model.User, code string) ([]string, error) {
+	if userModel.TOTPSecret == "" {
+		return nil, errs.NewBadRequestError("Two-factor enrollment wasn't started")
+	}
+
+	step, ok := totp.Validate(userModel.TOTPSecret, code, time.Now())
+	if !ok {
+		return nil, errs.NewBadRequestError("Wrong two-factor code")
+	}
+
+	recoveryCodes := totp.GenerateRecoveryCodes()
+	hashedRecoveryCodes := make([]string, len(recoveryCodes))
+	for i, recoveryCode := range recoveryCodes {
+		hashedRecoveryCodes[i] = totp.HashRecoveryCode(recoveryCode)
+	}
+
+	err := s.postgresDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userModel.TOTPEnabled = true
+		userModel.TOTPLastStep = step
+		if err := s.userRepository.WithinTX(tx).Update(ctx, userModel); err != nil {
+			return err
+		}
+		return s.recoveryCodeRepository.WithinTX(tx).Replace(ctx, userModel.ID, hashedRecoveryCodes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// verifyTwoFactorCode accepts either a TOTP code, which can't be reused, or an unused recovery code.
+// Wrong codes are counted, too many of them lock the verification for a while
+func (s *userService) verifyTwoFactorCode(ctx context.Context, userModel *model.User, code string) error {
+	if userModel.TwoFactorLockedAt != nil && time.Since(*userModel.TwoFactorLockedAt) < TWO_FACTOR_LOCKOUT {
+		return errs.NewBadRequestError("Too many wrong two-factor codes, try again later")
+	}
+
+	if step, ok := totp.Validate(userModel.TOTPSecret, code, time.Now()); ok {
+		advanced, err := s.userRepository.AdvanceTOTPStep(ctx, userModel.ID, step)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			return errs.NewBadRequestError("Two-factor code was already used")
+		}
+		userModel.TOTPLastStep = step
+		return s.resetTwoFactorFailures(ctx, userModel)
+	}
+
+	used, err := s.recoveryCodeRepository.Use(ctx, userModel.ID, totp.HashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return s.failTwoFactor(ctx, userModel.ID)
+	}
+	return s.resetTwoFactorFailures(ctx, userModel)
+}
+
+// failTwoFactor counts a wrong code. The one reaching the limit also drops all pending challenges of the user,
+// so guessing further needs the password again and waiting out the lockout
+func (s *userService) failTwoFactor(ctx context.Context, userID uint) error {
+	locked, err := s.userRepository.RecordTwoFactorFailure(ctx, userID, MAX_TWO_FACTOR_FAILURES)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return errs.NewBadRequestError("Wrong two-factor code")
+	}
+
+	if err := s.challengeRepository.DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+	return errs.NewBadRequestError("Too many wrong two-factor codes, try again later")
+}
+
+func (s *userService) resetTwoFactorFailures(ctx context.Context, userModel *model.User) error {
+	if userModel.TwoFactorFailures == 0 {
+		return nil
+	}
+	if err := s.userRepository.ResetTwoFactorFailures(ctx, userModel.ID); err != nil {
+		return err
+	}
+	userModel.TwoFactorFailures = 0
+	return nil
+}
@@ -0,0 +1,5 @@
+package dto
+
+type OIDCAuthorization struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required"`
+}
+
+type ChangePasswordByChallengeRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	NewPassword    string `json:"newPassword" binding:"required"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type Session struct {
+	ID         uint      `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	IsCurrent  bool      `json:"isCurrent"`
+}
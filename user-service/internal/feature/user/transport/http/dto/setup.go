@@ -0,0 +1,8 @@
+package dto
+
+type SetupRequest struct {
+	SetupToken string `json:"setupToken" binding:"required"`
+	Name       string `json:"name" binding:"required"`
+	Email      string `json:"email" binding:"required,email"`
+	Password   string `json:"password" binding:"required"`
+}
@@ -1,5 +1,8 @@
 package dto
 
 type Token struct {
-	AccessToken string `json:"accessToken"`
+	AccessToken    string   `json:"accessToken,omitempty"`
+	Challenge      string   `json:"challenge,omitempty" enums:"password_change,two_factor,two_factor_enrollment"`
+	ChallengeToken string   `json:"challengeToken,omitempty"`
+	RecoveryCodes  []string `json:"recoveryCodes,omitempty"`
 }
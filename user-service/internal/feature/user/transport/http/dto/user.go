@@ -10,7 +10,7 @@ type User struct {
 type SignUpUserRequest struct {
 	Name     string `json:"name" binding:"required"`
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=5,max=30"`
+	Password string `json:"password" binding:"required"`
 }
 
 type SignInUserRequest struct {
@@ -20,6 +20,20 @@ type UserHandler interface {
 	SignUp(c *gin.Context)
 	SignIn(c *gin.Context)
 	GetMe(c *gin.Context)
+	OIDCLogin(c *gin.Context)
+	OIDCCallback(c *gin.Context)
+	OIDCLink(c *gin.Context)
+	VerifyTwoFactor(c *gin.Context)
+	StartTwoFactorEnrollmentByChallenge(c *gin.Context)
+	StartTwoFactorEnrollment(c *gin.Context)
+	ConfirmTwoFactorEnrollment(c *gin.Context)
+	DisableTwoFactor(c *gin.Context)
+	ChangePassword(c *gin.Context)
+	ChangePasswordByChallenge(c *gin.Context)
+	Setup(c *gin.Context)
+	GetSessions(c *gin.Context)
+	DeleteSession(c *gin.Context)
+	TouchSession(c *gin.Context)
 }
 
 type userHandler struct {
@@ -84,7 +98,7 @@ func (h *userHandler) SignUp(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user	body		dto.SignInUserRequest	true	"Sign in payload"
-//	@Success		200	{object}	dto.Token
+//	@Success		200	{object}	dto.Token "Access token, or challenge token when two-factor authentication is required"
 //	@Failure		400 {object} 	dto.Error "Bad request"
 //	@Failure		404 {object} 	dto.Error "Entity not found"
 //	@Failure		500	{object} 	dto.Error "Internal server error"
@@ -103,7 +117,7 @@ func (h *userHandler) SignIn(c *gin.Context) {
 	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SignIn")
 	defer span.End()
 
-	tokenDomain, err := h.userService.SignIn(ctx, &userDomain)
+	tokenDomain, err := h.userService.SignIn(ctx, &userDomain, mapper.GinContextToClientDomain(c))
 	if err != nil {
 		tracing.Error(span, err)
 		h.logger.Error(ctx, "Sign in error", logging.Error(err))
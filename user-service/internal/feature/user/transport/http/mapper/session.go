@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+func GinContextToClientDomain(c *gin.Context) *domain.Client {
+	return &domain.Client{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
+
+func SessionDomainToDTO(sessionDomain *domain.Session) dto.Session {
+	return dto.Session{
+		ID:         sessionDomain.ID,
+		UserAgent:  sessionDomain.UserAgent,
+		IP:         sessionDomain.IP,
+		CreatedAt:  sessionDomain.CreatedAt,
+		LastSeenAt: sessionDomain.LastSeenAt,
+		ExpiresAt:  sessionDomain.ExpiresAt,
+		IsCurrent:  sessionDomain.IsCurrent,
+	}
+}
+
+func SessionDomainsToDTOs(sessionDomains []domain.Session) []dto.Session {
+	sessionDTOs := make([]dto.Session, len(sessionDomains))
+	for i := range sessionDomains {
+		sessionDTOs[i] = SessionDomainToDTO(&sessionDomains[i])
+	}
+	return sessionDTOs
+}
@@ -6,5 +6,10 @@ import (
 )
 
 func TokenDomainToDTO(tokenDomain *domain.Token) dto.Token {
-	return dto.Token{AccessToken: tokenDomain.AccessToken}
+	return dto.Token{
+		AccessToken:    tokenDomain.AccessToken,
+		Challenge:      tokenDomain.Challenge,
+		ChallengeToken: tokenDomain.ChallengeToken,
+		RecoveryCodes:  tokenDomain.RecoveryCodes,
+	}
 }
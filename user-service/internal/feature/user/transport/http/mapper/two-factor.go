@@ -0,0 +1,17 @@
+package mapper
+
+import (
+	"github.com/Yarik7610/library-backend/user-service/internal/domain"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/dto"
+)
+
+func TwoFactorEnrollmentDomainToDTO(twoFactorEnrollmentDomain *domain.TwoFactorEnrollment) dto.TwoFactorEnrollment {
+	return dto.TwoFactorEnrollment{
+		Secret: twoFactorEnrollmentDomain.Secret,
+		URI:    twoFactorEnrollmentDomain.URI,
+	}
+}
+
+func RecoveryCodesToDTO(recoveryCodes []string) dto.RecoveryCodes {
+	return dto.RecoveryCodes{RecoveryCodes: recoveryCodes}
+}
@@ -28,3 +28,11 @@ func SignInUserRequestDTOToDomain(signInUserRequestDTO *dto.SignInUserRequest) d
 		RawPassword: signInUserRequestDTO.Password,
 	}
 }
+
+func SetupRequestDTOToDomain(setupRequestDTO *dto.SetupRequest) domain.User {
+	return domain.User{
+		Name:        setupRequestDTO.Name,
+		Email:       setupRequestDTO.Email,
+		RawPassword: setupRequestDTO.Password,
+	}
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/dto"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/query"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// OIDCLogin godoc
+//
+//	@Summary		Start OIDC sign in
+//	@Description	Redirects to the external identity provider using authorization code flow with PKCE
+//	@Tags			user
+//	@Success		302
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/oidc/login [get]
+func (h *userHandler) OIDCLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetOIDCAuthorizationURL")
+	defer span.End()
+
+	authorizationURL, err := h.userService.GetOIDCAuthorizationURL(ctx)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get OIDC authorization URL error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, authorizationURL)
+}
+
+// OIDCLink godoc
+//
+//	@Summary		Start linking an OIDC identity
+//	@Description	Returns the identity provider URL to open, its callback links the external identity to the authorized user. Identities are never linked to existing users by email
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.OIDCAuthorization
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/oidc/link [get]
+func (h *userHandler) OIDCLink(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetOIDCLinkURL")
+	defer span.End()
+
+	authorizationURL, err := h.userService.GetOIDCLinkURL(ctx, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get OIDC link URL error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.OIDCAuthorization{AuthorizationURL: authorizationURL})
+}
+
+// OIDCCallback godoc
+//
+//	@Summary		Finish OIDC sign in
+//	@Description	Exchanges the authorization code and returns an access token. Unknown identities get a new user, unless their email is taken by an existing one, which has to link them first
+//	@Tags			user
+//	@Param			code	query	string	true	"Authorization code"
+//	@Param			state	query	string	true	"State returned by the identity provider"
+//	@Produce		json
+//	@Success		200	{object}	dto.Token
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/oidc/callback [get]
+func (h *userHandler) OIDCCallback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query query.OIDCCallback
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if query.Error != "" {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError("Identity provider error: "+query.Error+" "+query.ErrorDescription))
+		return
+	}
+	if query.Code == "" {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError("Authorization code is required"))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.SignInWithOIDC")
+	defer span.End()
+
+	tokenDomain, err := h.userService.SignInWithOIDC(ctx, query.Code, query.State, mapper.GinContextToClientDomain(c))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Sign in with OIDC error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TokenDomainToDTO(tokenDomain))
+}
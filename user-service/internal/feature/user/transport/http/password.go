@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/dto"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// ChangePassword godoc
+//
+//	@Summary		Change password
+//	@Description	Changes the password of the authorized user
+//	@Tags			user
+//	@Accept			json
+//	@Security		BearerAuth
+//	@Param			payload	body		dto.ChangePasswordRequest	true	"Current and new passwords"
+//	@Success		204
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/password [put]
+func (h *userHandler) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var changePasswordRequestDTO dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&changePasswordRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ChangePassword")
+	defer span.End()
+
+	if err := h.userService.ChangePassword(ctx, uint(userID), changePasswordRequestDTO.CurrentPassword, changePasswordRequestDTO.NewPassword); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Change password error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// ChangePasswordByChallenge godoc
+//
+//	@Summary		Change password required at sign in
+//	@Description	Exchanges the password_change challenge token and a new password for an access token or the next challenge
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		dto.ChangePasswordByChallengeRequest	true	"Challenge token and new password"
+//	@Success		200	{object}	dto.Token
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/sign-in/password [post]
+func (h *userHandler) ChangePasswordByChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var changePasswordByChallengeRequestDTO dto.ChangePasswordByChallengeRequest
+	if err := c.ShouldBindJSON(&changePasswordByChallengeRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ChangePasswordByChallenge")
+	defer span.End()
+
+	tokenDomain, err := h.userService.ChangePasswordByChallenge(ctx, changePasswordByChallengeRequestDTO.ChallengeToken, changePasswordByChallengeRequestDTO.NewPassword, mapper.GinContextToClientDomain(c))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Change password by challenge error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TokenDomainToDTO(tokenDomain))
+}
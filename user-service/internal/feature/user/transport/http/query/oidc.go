@@ -0,0 +1,8 @@
+package query
+
+type OIDCCallback struct {
+	Code             string `form:"code"`
+	State            string `form:"state" binding:"required"`
+	Error            string `form:"error"`
+	ErrorDescription string `form:"error_description"`
+}
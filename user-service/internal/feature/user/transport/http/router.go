@@ -3,9 +3,10 @@ package http
 import (
 	"net/http"
 
-	"github.com/Yarik7610/library-backend-common/transport/http/route"
+	sharedRoute "github.com/Yarik7610/library-backend-common/transport/http/route"
 	"github.com/Yarik7610/library-backend/user-service/docs"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http/route"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -17,25 +18,44 @@ func NewRouter(config *config.Config, metricsHandler http.Handler, userHandler U
 
 	r.Use(otelgin.Middleware(config.ServiceName,
 		otelgin.WithGinFilter(func(c *gin.Context) bool {
-			return c.FullPath() != route.METRICS
+			return c.FullPath() != sharedRoute.METRICS
 		}),
 	))
 
-	r.GET(route.METRICS, gin.WrapH(metricsHandler))
+	r.GET(sharedRoute.METRICS, gin.WrapH(metricsHandler))
 
 	docs.SwaggerInfo.BasePath = "/"
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	userGroup := r.Group("")
 	{
-		userGroup.POST(route.SIGN_UP, userHandler.SignUp)
-		userGroup.POST(route.SIGN_IN, userHandler.SignIn)
+		userGroup.POST(sharedRoute.SIGN_UP, userHandler.SignUp)
+		userGroup.POST(sharedRoute.SIGN_IN, userHandler.SignIn)
+		userGroup.GET(route.OIDC+route.LOGIN, userHandler.OIDCLogin)
+		userGroup.GET(route.OIDC+route.CALLBACK, userHandler.OIDCCallback)
+		userGroup.POST(route.SETUP, userHandler.Setup)
+		userGroup.POST(sharedRoute.SIGN_IN+route.PASSWORD, userHandler.ChangePasswordByChallenge)
+		userGroup.POST(sharedRoute.SIGN_IN+route.TWO_FACTOR, userHandler.VerifyTwoFactor)
+		userGroup.POST(sharedRoute.SIGN_IN+route.TWO_FACTOR+route.ENROLLMENT, userHandler.StartTwoFactorEnrollmentByChallenge)
 
 		privateGroup := userGroup.Group("")
 		{
-			privateGroup.GET(route.ME, userHandler.GetMe)
+			privateGroup.GET(sharedRoute.ME, userHandler.GetMe)
+			privateGroup.PUT(sharedRoute.ME+route.PASSWORD, userHandler.ChangePassword)
+			privateGroup.GET(sharedRoute.ME+route.OIDC+route.LINK, userHandler.OIDCLink)
+			privateGroup.POST(sharedRoute.ME+route.TWO_FACTOR+route.ENROLLMENT, userHandler.StartTwoFactorEnrollment)
+			privateGroup.POST(sharedRoute.ME+route.TWO_FACTOR+route.ENROLLMENT+route.CONFIRM, userHandler.ConfirmTwoFactorEnrollment)
+			privateGroup.POST(sharedRoute.ME+route.TWO_FACTOR+route.DISABLE, userHandler.DisableTwoFactor)
+			privateGroup.GET(sharedRoute.ME+route.SESSIONS, userHandler.GetSessions)
+			privateGroup.DELETE(sharedRoute.ME+route.SESSIONS+"/:sessionID", userHandler.DeleteSession)
 		}
 	}
 
+	// Internal routes are called by api-gateway only and aren't exposed through it
+	internalGroup := r.Group(route.INTERNAL)
+	{
+		internalGroup.POST(route.SESSIONS+"/:sessionID"+route.ACTIVITY, userHandler.TouchSession)
+	}
+
 	return r
 }
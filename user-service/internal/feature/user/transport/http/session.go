@@ -0,0 +1,123 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// GetSessions godoc
+//
+//	@Summary		Get active sessions
+//	@Description	Returns devices which are currently signed in, most recently active first. The session of the request is marked as current
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dto.Session
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/sessions [get]
+func (h *userHandler) GetSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	sessionID, err := header.GetSessionID(c)
+	if err != nil {
+		sessionID = 0
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.GetSessions")
+	defer span.End()
+
+	sessionDomains, err := h.userService.GetSessions(ctx, uint(userID), uint(sessionID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Get sessions error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.SessionDomainsToDTOs(sessionDomains))
+}
+
+// DeleteSession godoc
+//
+//	@Summary		Sign out a device
+//	@Description	Revokes the session, so its access token is rejected by the gateway
+//	@Tags			user
+//	@Security		BearerAuth
+//	@Param			sessionID	path	uint	true	"Session ID"
+//	@Success		204
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/sessions/{sessionID} [delete]
+func (h *userHandler) DeleteSession(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	sessionIDString := c.Param("sessionID")
+	sessionID, err := strconv.ParseUint(sessionIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DeleteSession")
+	defer span.End()
+
+	if err := h.userService.DeleteSession(ctx, uint(userID), uint(sessionID)); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Delete session error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
+
+// TouchSession is called by api-gateway on every authorized request.
+// Responds with 404 when the session was revoked or has expired.
+// Not documented in swagger because it isn't routed through the gateway
+func (h *userHandler) TouchSession(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	sessionIDString := c.Param("sessionID")
+	sessionID, err := strconv.ParseUint(sessionIDString, 10, 64)
+	if err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.TouchSession")
+	defer span.End()
+
+	if err := h.userService.TouchSession(ctx, uint(sessionID), c.ClientIP()); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Touch session error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
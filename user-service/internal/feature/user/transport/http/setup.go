@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/dto"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http"
+	"github.com/gin-gonic/gin"
+)
+
+// Setup godoc
+//
+//	@Summary		Create the first admin
+//	@Description	Consumes the one-time setup token printed at startup when no admin exists and no admin password is configured. Sign up is closed until then
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		dto.SetupRequest	true	"Setup token and admin account"
+//	@Success		201	{object}	dto.User
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/setup [post]
+func (h *userHandler) Setup(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var setupRequestDTO dto.SetupRequest
+	if err := c.ShouldBindJSON(&setupRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userDomain := mapper.SetupRequestDTOToDomain(&setupRequestDTO)
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.Setup")
+	defer span.End()
+
+	if err := h.userService.Setup(ctx, setupRequestDTO.SetupToken, &userDomain); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Setup error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.UserDomainToDTO(&userDomain))
+}
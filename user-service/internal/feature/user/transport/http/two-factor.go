@@ -0,0 +1,211 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/dto"
+	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/transport/http/mapper"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/tracing"
+	httpInfrastructure "github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/transport/http/header"
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyTwoFactor godoc
+//
+//	@Summary		Pass two-factor authentication
+//	@Description	Exchanges a sign in challenge token and a TOTP or recovery code for an access token. Completes enrollment when it was required at sign in. A challenge token is redeemed once, 5 wrong codes in a row lock two-factor verification for 15 minutes and drop pending challenges
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		dto.VerifyTwoFactorRequest	true	"Challenge token and code"
+//	@Success		200	{object}	dto.Token
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/sign-in/2fa [post]
+func (h *userHandler) VerifyTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var verifyTwoFactorRequestDTO dto.VerifyTwoFactorRequest
+	if err := c.ShouldBindJSON(&verifyTwoFactorRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.VerifyTwoFactor")
+	defer span.End()
+
+	tokenDomain, err := h.userService.VerifyTwoFactor(ctx, verifyTwoFactorRequestDTO.ChallengeToken, verifyTwoFactorRequestDTO.Code, mapper.GinContextToClientDomain(c))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Verify two-factor error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TokenDomainToDTO(tokenDomain))
+}
+
+// StartTwoFactorEnrollmentByChallenge godoc
+//
+//	@Summary		Start required two-factor enrollment
+//	@Description	Generates a TOTP secret for a user whose sign in returned the two_factor_enrollment challenge
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		dto.ChallengeRequest	true	"Challenge token"
+//	@Success		200	{object}	dto.TwoFactorEnrollment
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/sign-in/2fa/enrollment [post]
+func (h *userHandler) StartTwoFactorEnrollmentByChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var challengeRequestDTO dto.ChallengeRequest
+	if err := c.ShouldBindJSON(&challengeRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.StartTwoFactorEnrollmentByChallenge")
+	defer span.End()
+
+	twoFactorEnrollmentDomain, err := h.userService.StartTwoFactorEnrollmentByChallenge(ctx, challengeRequestDTO.ChallengeToken)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Start two-factor enrollment by challenge error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TwoFactorEnrollmentDomainToDTO(twoFactorEnrollmentDomain))
+}
+
+// StartTwoFactorEnrollment godoc
+//
+//	@Summary		Start two-factor enrollment
+//	@Description	Generates a TOTP secret and otpauth URI for the authorized user
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.TwoFactorEnrollment
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/2fa/enrollment [post]
+func (h *userHandler) StartTwoFactorEnrollment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.StartTwoFactorEnrollment")
+	defer span.End()
+
+	twoFactorEnrollmentDomain, err := h.userService.StartTwoFactorEnrollment(ctx, uint(userID))
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Start two-factor enrollment error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.TwoFactorEnrollmentDomainToDTO(twoFactorEnrollmentDomain))
+}
+
+// ConfirmTwoFactorEnrollment godoc
+//
+//	@Summary		Confirm two-factor enrollment
+//	@Description	Enables two-factor authentication after the first valid TOTP code and returns one-time recovery codes
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			payload	body		dto.TwoFactorCodeRequest	true	"TOTP code"
+//	@Success		200	{object}	dto.RecoveryCodes
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		409 {object} 	dto.Error "Entity already exists"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/2fa/enrollment/confirm [post]
+func (h *userHandler) ConfirmTwoFactorEnrollment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var twoFactorCodeRequestDTO dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&twoFactorCodeRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.ConfirmTwoFactorEnrollment")
+	defer span.End()
+
+	recoveryCodes, err := h.userService.ConfirmTwoFactorEnrollment(ctx, uint(userID), twoFactorCodeRequestDTO.Code)
+	if err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Confirm two-factor enrollment error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.RecoveryCodesToDTO(recoveryCodes))
+}
+
+// DisableTwoFactor godoc
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disables two-factor authentication and drops recovery codes. Not allowed for admins when two-factor authentication is required for them
+//	@Tags			user
+//	@Accept			json
+//	@Security		BearerAuth
+//	@Param			payload	body		dto.TwoFactorCodeRequest	true	"TOTP or recovery code"
+//	@Success		204
+//	@Failure		400 {object} 	dto.Error "Bad request"
+//	@Failure		401 {object} 	dto.Error "The token is missing, invalid or expired"
+//	@Failure		404 {object} 	dto.Error "Entity not found"
+//	@Failure		500	{object} 	dto.Error "Internal server error"
+//	@Router			/me/2fa/disable [post]
+func (h *userHandler) DisableTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := header.GetUserID(c)
+	if err != nil {
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	var twoFactorCodeRequestDTO dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&twoFactorCodeRequestDTO); err != nil {
+		httpInfrastructure.RenderError(c, errs.NewBadRequestError(err.Error()))
+		return
+	}
+
+	ctx, span := tracing.Span(ctx, h.config.ServiceName, "service.DisableTwoFactor")
+	defer span.End()
+
+	if err := h.userService.DisableTwoFactor(ctx, uint(userID), twoFactorCodeRequestDTO.Code); err != nil {
+		tracing.Error(span, err)
+		h.logger.Error(ctx, "Disable two-factor error", logging.Error(err))
+		httpInfrastructure.RenderError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Abort()
+}
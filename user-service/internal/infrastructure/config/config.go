@@ -3,15 +3,32 @@ package config
 import "github.com/ilyakaznacheev/cleanenv"
 
 type Config struct {
-	Env                      string `env:"ENV"`
-	ServiceName              string `env:"SERVICE_NAME"`
-	HTTPServerPort           string `env:"HTTP_SERVER_PORT"`
-	GRPCServerPort           string `env:"GRPC_SERVER_PORT"`
-	PostgresURL              string `env:"POSTGRES_URL"`
-	Mail                     string `env:"MAIL"`
-	JWTSecret                string `env:"JWT_SECRET"`
-	JWTExpirationSeconds     uint   `env:"JWT_EXPIRATION_SECONDS"`
-	OTelExporterOTLPEndpoint string `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	Env                          string `env:"ENV"`
+	ServiceName                  string `env:"SERVICE_NAME"`
+	HTTPServerPort               string `env:"HTTP_SERVER_PORT"`
+	GRPCServerPort               string `env:"GRPC_SERVER_PORT"`
+	PostgresURL                  string `env:"POSTGRES_URL"`
+	Mail                         string `env:"MAIL"`
+	AdminPassword                string `env:"ADMIN_PASSWORD"`
+	AdminPasswordFile            string `env:"ADMIN_PASSWORD_FILE"`
+	JWTSecret                    string `env:"JWT_SECRET"`
+	JWTExpirationSeconds         uint   `env:"JWT_EXPIRATION_SECONDS"`
+	PasswordHashAlgorithm        string `env:"PASSWORD_HASH_ALGORITHM" env-default:"argon2id"`
+	PasswordBcryptCost           int    `env:"PASSWORD_BCRYPT_COST" env-default:"12"`
+	PasswordArgon2MemoryKiB      uint32 `env:"PASSWORD_ARGON2_MEMORY_KIB" env-default:"19456"`
+	PasswordArgon2Iterations     uint32 `env:"PASSWORD_ARGON2_ITERATIONS" env-default:"2"`
+	PasswordArgon2Parallelism    uint8  `env:"PASSWORD_ARGON2_PARALLELISM" env-default:"1"`
+	PasswordMinLength            int    `env:"PASSWORD_MIN_LENGTH" env-default:"8"`
+	PasswordMaxLength            int    `env:"PASSWORD_MAX_LENGTH" env-default:"64"`
+	PasswordBreachedListFile     string `env:"PASSWORD_BREACHED_LIST_FILE"`
+	PasswordRejectSimilarToEmail bool   `env:"PASSWORD_REJECT_SIMILAR_TO_EMAIL" env-default:"true"`
+	RequireAdminTwoFactor        bool   `env:"REQUIRE_ADMIN_TWO_FACTOR"`
+	TOTPIssuer                   string `env:"TOTP_ISSUER" env-default:"Library"`
+	OIDCIssuerURL                string `env:"OIDC_ISSUER_URL"`
+	OIDCClientID                 string `env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret             string `env:"OIDC_CLIENT_SECRET"`
+	OIDCRedirectURL              string `env:"OIDC_REDIRECT_URL"`
+	OTelExporterOTLPEndpoint     string `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
 }
 
 func Parse() (*Config, error) {
@@ -0,0 +1,55 @@
+package jwt
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const CHALLENGE_EXPIRATION = 5 * time.Minute
+
+// Challenge tokens are signed with a key derived from the JWT secret,
+// so the gateway never accepts them as access tokens
+func challengeKey(config *config.Config) []byte {
+	return []byte(config.JWTSecret + ":challenge")
+}
+
+// CreateChallenge signs the challenge with challengeID as its jti, so the stored challenge can be redeemed only once
+func CreateChallenge(config *config.Config, challengeID string, userID uint, challenge string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ID:        challengeID,
+		Subject:   strconv.FormatInt(int64(userID), 10),
+		Audience:  jwt.ClaimStrings{challenge},
+		ExpiresAt: &jwt.NumericDate{Time: time.Now().Add(CHALLENGE_EXPIRATION)},
+	})
+
+	return token.SignedString(challengeKey(config))
+}
+
+// VerifyChallenge returns the user ID and the challenge ID of a valid challenge token
+func VerifyChallenge(config *config.Config, tokenString string, challenge string) (uint, string, error) {
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, &claims,
+		func(token *jwt.Token) (any, error) {
+			return challengeKey(config), nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(challenge),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return 0, "", err
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		return 0, "", errors.New("invalid challenge subject")
+	}
+	if claims.ID == "" {
+		return 0, "", errors.New("missing challenge ID")
+	}
+	return uint(userID), claims.ID, nil
+}
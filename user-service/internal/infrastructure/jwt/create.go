@@ -8,11 +8,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func Create(config *config.Config, userID uint, isAdmin bool) (string, error) {
+func Create(config *config.Config, userID uint, isAdmin bool, sessionID uint, expiresAt time.Time) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ID:        strconv.FormatUint(uint64(sessionID), 10),
 		Subject:   strconv.FormatInt(int64(userID), 10),
 		Audience:  jwt.ClaimStrings{strconv.FormatBool(isAdmin)},
-		ExpiresAt: &jwt.NumericDate{Time: time.Now().Add(time.Second * time.Duration(config.JWTExpirationSeconds))},
+		ExpiresAt: &jwt.NumericDate{Time: expiresAt},
 	})
 
 	tokenString, err := token.SignedString([]byte(config.JWTSecret))
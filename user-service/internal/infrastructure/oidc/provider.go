@@ -0,0 +1,247 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrNotConfigured = errors.New("OIDC provider is not configured")
+
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+// Provider talks to a single OpenID Connect issuer. Discovery document and signing keys
+// are fetched lazily, so the service starts even if the provider is temporarily unavailable.
+type Provider struct {
+	issuerURL    string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]any
+}
+
+func NewProvider(config *config.Config) *Provider {
+	return &Provider{
+		issuerURL:    strings.TrimSuffix(config.OIDCIssuerURL, "/"),
+		clientID:     config.OIDCClientID,
+		clientSecret: config.OIDCClientSecret,
+		redirectURL:  config.OIDCRedirectURL,
+		httpClient:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (p *Provider) Issuer() string {
+	return p.issuerURL
+}
+
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.clientID)
+	query.Set("redirect_uri", p.redirectURL)
+	query.Set("scope", "openid email profile")
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", CodeChallenge(codeVerifier))
+	query.Set("code_challenge_method", CODE_CHALLENGE_METHOD)
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange redeems the authorization code and returns verified ID token claims.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*IDTokenClaims, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.redirectURL)
+	form.Set("client_id", p.clientID)
+	form.Set("code_verifier", codeVerifier)
+	if p.clientSecret != "" {
+		form.Set("client_secret", p.clientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var token tokenResponse
+	if err := p.doJSON(req, &token); err != nil {
+		return nil, fmt.Errorf("token exchange: %w", err)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	claims, err := p.verifyIDToken(ctx, discovery, token.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("ID token nonce mismatch")
+	}
+	return claims, nil
+}
+
+func (p *Provider) verifyIDToken(ctx context.Context, discovery *discoveryDocument, idToken string) (*IDTokenClaims, error) {
+	claims := &IDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims,
+		func(token *jwt.Token) (any, error) {
+			keyID, _ := token.Header["kid"].(string)
+			return p.getKey(ctx, discovery, keyID)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ID token verification: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	return claims, nil
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	if p.issuerURL == "" || p.clientID == "" || p.redirectURL == "" {
+		return nil, ErrNotConfigured
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.issuerURL+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var discovery discoveryDocument
+	if err := p.doJSON(req, &discovery); err != nil {
+		return nil, fmt.Errorf("discovery: %w", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != p.issuerURL {
+		return nil, fmt.Errorf("discovery: issuer mismatch %q", discovery.Issuer)
+	}
+
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+// getKey looks the key up in the cached key set and refetches the set once on a miss to follow key rotation.
+func (p *Provider) getKey(ctx context.Context, discovery *discoveryDocument, keyID string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.findKey(keyID); ok {
+		return key, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keySet jsonWebKeySet
+	if err := p.doJSON(req, &keySet); err != nil {
+		return nil, fmt.Errorf("JWKS: %w", err)
+	}
+
+	keys := make(map[string]any, len(keySet.Keys))
+	for i := range keySet.Keys {
+		if keySet.Keys[i].Use != "" && keySet.Keys[i].Use != "sig" {
+			continue
+		}
+		key, err := keySet.Keys[i].publicKey()
+		if err != nil {
+			continue
+		}
+		keys[keySet.Keys[i].KeyID] = key
+	}
+	p.keys = keys
+
+	if key, ok := p.findKey(keyID); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("signing key %q not found", keyID)
+}
+
+func (p *Provider) findKey(keyID string) (any, bool) {
+	if key, ok := p.keys[keyID]; ok {
+		return key, true
+	}
+	if keyID == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func (p *Provider) doJSON(req *http.Request, target any) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, req.URL.Redacted())
+	}
+	return json.NewDecoder(resp.Body).Decode(target)
+}
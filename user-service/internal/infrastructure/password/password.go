@@ -1,15 +1,136 @@
 package password
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
 
-const HASH_COST = 14
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
 
-func GenerateHash(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), HASH_COST)
-	return string(bytes), err
+const (
+	ALGORITHM_ARGON2ID = "argon2id"
+	ALGORITHM_BCRYPT   = "bcrypt"
+)
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// Hasher hashes new passwords with the configured algorithm and verifies hashes of both supported algorithms,
+// so existing bcrypt hashes keep working and can be upgraded on sign in
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     argon2Params
+}
+
+func NewHasher(config *config.Config) (*Hasher, error) {
+	if config.PasswordHashAlgorithm != ALGORITHM_ARGON2ID && config.PasswordHashAlgorithm != ALGORITHM_BCRYPT {
+		return nil, fmt.Errorf("unsupported password hash algorithm %q", config.PasswordHashAlgorithm)
+	}
+
+	return &Hasher{
+		algorithm:  config.PasswordHashAlgorithm,
+		bcryptCost: config.PasswordBcryptCost,
+		argon2: argon2Params{
+			memory:      config.PasswordArgon2MemoryKiB,
+			iterations:  config.PasswordArgon2Iterations,
+			parallelism: config.PasswordArgon2Parallelism,
+		},
+	}, nil
+}
+
+func (h *Hasher) GenerateHash(password string) (string, error) {
+	if h.algorithm == ALGORITHM_BCRYPT {
+		bytes, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		return string(bytes), err
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.argon2.iterations, h.argon2.memory, h.argon2.parallelism, argon2KeyLength)
+	return encodeArgon2(h.argon2, salt, key), nil
+}
+
+func (h *Hasher) CompareHashAndRaw(hash string, password string) bool {
+	if !strings.HasPrefix(hash, "$"+ALGORITHM_ARGON2ID+"$") {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		return err == nil
+	}
+
+	params, salt, key, err := decodeArgon2(hash)
+	if err != nil {
+		return false
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, otherKey) == 1
+}
+
+// NeedsRehash reports whether the hash was produced by another algorithm or with other parameters than configured
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if h.algorithm == ALGORITHM_BCRYPT {
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.bcryptCost
+	}
+
+	params, _, _, err := decodeArgon2(hash)
+	return err != nil || params != h.argon2
 }
 
-func CompareHashAndRaw(hash string, password string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
+func encodeArgon2(params argon2Params, salt, key []byte) string {
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		ALGORITHM_ARGON2ID,
+		argon2.Version,
+		params.memory,
+		params.iterations,
+		params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))
+}
+
+func decodeArgon2(hash string) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != ALGORITHM_ARGON2ID {
+		return params, nil, nil, fmt.Errorf("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, err
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, err
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, err
+	}
+	return params, salt, key, nil
 }
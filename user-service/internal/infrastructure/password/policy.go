@@ -0,0 +1,138 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/errs"
+)
+
+type Policy struct {
+	minLength            int
+	maxLength            int
+	rejectSimilarToEmail bool
+	breachedPasswords    map[string]struct{}
+	breachedSHA1Hashes   map[string]struct{}
+}
+
+func NewPolicy(config *config.Config) (*Policy, error) {
+	policy := &Policy{
+		minLength:            config.PasswordMinLength,
+		maxLength:            config.PasswordMaxLength,
+		rejectSimilarToEmail: config.PasswordRejectSimilarToEmail,
+		breachedPasswords:    map[string]struct{}{},
+		breachedSHA1Hashes:   map[string]struct{}{},
+	}
+
+	if config.PasswordBreachedListFile != "" {
+		if err := policy.loadBreachedList(config.PasswordBreachedListFile); err != nil {
+			return nil, fmt.Errorf("load breached passwords list: %w", err)
+		}
+	}
+	return policy, nil
+}
+
+// Validate returns a bad request error describing the first violated rule
+func (p *Policy) Validate(rawPassword, email string) error {
+	length := utf8.RuneCountInString(rawPassword)
+	if length < p.minLength {
+		return errs.NewBadRequestError(fmt.Sprintf("Password must be at least %d characters long", p.minLength))
+	}
+	if length > p.maxLength {
+		return errs.NewBadRequestError(fmt.Sprintf("Password must be at most %d characters long", p.maxLength))
+	}
+
+	if p.isBreached(rawPassword) {
+		return errs.NewBadRequestError("Password was found in a list of breached passwords")
+	}
+
+	if p.rejectSimilarToEmail && isSimilarToEmail(rawPassword, email) {
+		return errs.NewBadRequestError("Password is too similar to the email")
+	}
+	return nil
+}
+
+// loadBreachedList reads one password per line. Lines in "SHA1[:count]" format (as in Have I Been Pwned dumps) are matched by hash
+func (p *Policy) loadBreachedList(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, ":")
+		if isSHA1Hex(hash) {
+			p.breachedSHA1Hashes[strings.ToUpper(hash)] = struct{}{}
+			continue
+		}
+		p.breachedPasswords[strings.ToLower(line)] = struct{}{}
+	}
+	return scanner.Err()
+}
+
+func (p *Policy) isBreached(rawPassword string) bool {
+	if _, ok := p.breachedPasswords[strings.ToLower(rawPassword)]; ok {
+		return true
+	}
+
+	hash := sha1.Sum([]byte(rawPassword))
+	_, ok := p.breachedSHA1Hashes[strings.ToUpper(hex.EncodeToString(hash[:]))]
+	return ok
+}
+
+func isSHA1Hex(value string) bool {
+	if len(value) != 2*sha1.Size {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
+
+func isSimilarToEmail(rawPassword, email string) bool {
+	password := strings.ToLower(rawPassword)
+	localPart, _, _ := strings.Cut(strings.ToLower(email), "@")
+
+	if len(localPart) < 3 {
+		return password == localPart
+	}
+	if strings.Contains(password, localPart) || strings.Contains(localPart, password) {
+		return true
+	}
+	return levenshteinDistance(password, localPart) <= len(localPart)/3
+}
+
+func levenshteinDistance(a, b string) int {
+	first, second := []rune(a), []rune(b)
+
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current[0] = i
+		for j := 1; j <= len(second); j++ {
+			substitutionCost := 1
+			if first[i-1] == second[j-1] {
+				substitutionCost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+substitutionCost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(second)]
+}
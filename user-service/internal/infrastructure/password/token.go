@@ -0,0 +1,12 @@
+package password
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashToken hashes high-entropy one-time tokens, which don't need a slow password hash
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -14,7 +14,7 @@ func Connect(config *config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	if err = db.AutoMigrate(&model.User{}); err != nil {
+	if err = db.AutoMigrate(&model.User{}, &model.ExternalIdentity{}, &model.OIDCAuthorization{}, &model.RecoveryCode{}, &model.SetupToken{}, &model.Session{}, &model.Challenge{}); err != nil {
 		return nil, err
 	}
 
@@ -2,40 +2,89 @@ package seed
 
 import (
 	"context"
+	"crypto/rand"
+	"os"
+	"strings"
 
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/config"
+	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/observability/logging"
 	"github.com/Yarik7610/library-backend/user-service/internal/infrastructure/password"
 
 	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres"
 	"github.com/Yarik7610/library-backend/user-service/internal/feature/user/repository/postgres/model"
 )
 
-func Admin(config *config.Config, userRepository postgres.UserRepository) error {
+// Admin bootstraps the first admin when there is no admin yet, users who signed up meanwhile don't count.
+// The password is taken from ADMIN_PASSWORD_FILE or ADMIN_PASSWORD and must be changed on first sign in.
+// Without a configured password a one-time setup token is logged, which is consumed by POST /setup
+func Admin(
+	config *config.Config,
+	logger *logging.Logger,
+	passwordHasher *password.Hasher,
+	userRepository postgres.UserRepository,
+	setupTokenRepository postgres.SetupTokenRepository) error {
 	ctx := context.Background()
 
-	usersCount, err := userRepository.Count(ctx)
+	adminsCount, err := userRepository.CountAdmins(ctx)
 	if err != nil {
 		return err
 	}
 
-	if usersCount != 0 {
-		return nil
+	if adminsCount != 0 {
+		return setupTokenRepository.DeleteAll(ctx)
 	}
 
-	return seedAdmin(ctx, config, userRepository)
+	adminPassword, err := readAdminPassword(config)
+	if err != nil {
+		return err
+	}
+
+	if adminPassword != "" {
+		return seedAdmin(ctx, config, passwordHasher, adminPassword, userRepository)
+	}
+	return seedSetupToken(ctx, logger, setupTokenRepository)
+}
+
+func readAdminPassword(config *config.Config) (string, error) {
+	if config.AdminPasswordFile != "" {
+		data, err := os.ReadFile(config.AdminPasswordFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return config.AdminPassword, nil
 }
 
-func seedAdmin(ctx context.Context, config *config.Config, userRepository postgres.UserRepository) error {
-	hashedPassword, err := password.GenerateHash("admin")
+func seedAdmin(
+	ctx context.Context,
+	config *config.Config,
+	passwordHasher *password.Hasher,
+	adminPassword string,
+	userRepository postgres.UserRepository) error {
+	hashedPassword, err := passwordHasher.GenerateHash(adminPassword)
 	if err != nil {
 		return err
 	}
 
 	admin := model.User{
-		Name:           "admin",
-		Email:          config.Mail,
-		HashedPassword: hashedPassword,
-		IsAdmin:        true,
+		Name:               "admin",
+		Email:              config.Mail,
+		HashedPassword:     hashedPassword,
+		IsAdmin:            true,
+		MustChangePassword: true,
 	}
 	return userRepository.Create(ctx, &admin)
 }
+
+func seedSetupToken(ctx context.Context, logger *logging.Logger, setupTokenRepository postgres.SetupTokenRepository) error {
+	setupToken := rand.Text()
+
+	if err := setupTokenRepository.Replace(ctx, password.HashToken(setupToken)); err != nil {
+		return err
+	}
+
+	logger.Warn(ctx, "No admin found and no admin password configured. Create the admin via POST /setup, sign up is closed until then",
+		logging.String("setupToken", setupToken))
+	return nil
+}
@@ -0,0 +1,27 @@
+package totp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const RECOVERY_CODES_COUNT = 10
+
+// GenerateRecoveryCodes returns one-time codes formatted as xxxxx-xxxxx
+func GenerateRecoveryCodes() []string {
+	codes := make([]string, RECOVERY_CODES_COUNT)
+	for i := range codes {
+		text := strings.ToLower(rand.Text())
+		codes[i] = text[:5] + "-" + text[5:10]
+	}
+	return codes
+}
+
+// HashRecoveryCode hashes a normalized code. Codes are random enough for a fast hash
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
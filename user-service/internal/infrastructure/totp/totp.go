@@ -0,0 +1,84 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	DIGITS        = 6
+	PERIOD        = 30 * time.Second
+	ALLOWED_SKEW  = 1
+	SECRET_LENGTH = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateSecret() (string, error) {
+	secret := make([]byte, SECRET_LENGTH)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+// URI builds the otpauth:// link which authenticator apps import from a QR code
+func URI(issuer, accountName, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(DIGITS))
+	query.Set("period", fmt.Sprint(int(PERIOD.Seconds())))
+
+	label := url.PathEscape(issuer + ":" + accountName)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Validate checks the code against the current time step and its neighbours.
+// It returns the matched step, so callers can reject a code which was already used
+func Validate(secret, code string, now time.Time) (int64, bool) {
+	if len(code) != DIGITS {
+		return 0, false
+	}
+
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	currentStep := now.Unix() / int64(PERIOD.Seconds())
+	for skew := -ALLOWED_SKEW; skew <= ALLOWED_SKEW; skew++ {
+		step := currentStep + int64(skew)
+		if subtle.ConstantTimeCompare([]byte(generate(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func generate(key []byte, step int64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range DIGITS {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", DIGITS, value%modulo)
+}
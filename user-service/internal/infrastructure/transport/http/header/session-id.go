@@ -0,0 +1,19 @@
+package header
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SESSION_ID is injected by the gateway next to the shared user headers
+const SESSION_ID = "X-Session-ID"
+
+func GetSessionID(ctx *gin.Context) (uint64, error) {
+	sessionIDString := ctx.GetHeader(SESSION_ID)
+	sessionID, err := strconv.ParseUint(sessionIDString, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,21 @@
+package route
+
+// Routes which are specific to user-service and aren't shared through library-backend-common
+const (
+	OIDC     = "/oidc"
+	LOGIN    = "/login"
+	CALLBACK = "/callback"
+	LINK     = "/link"
+
+	TWO_FACTOR = "/2fa"
+	ENROLLMENT = "/enrollment"
+	CONFIRM    = "/confirm"
+	DISABLE    = "/disable"
+
+	SETUP    = "/setup"
+	PASSWORD = "/password"
+
+	SESSIONS = "/sessions"
+	INTERNAL = "/internal"
+	ACTIVITY = "/activity"
+)